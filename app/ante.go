@@ -17,6 +17,7 @@ import (
 	"github.com/oldfurya/furya/x/poe"
 	poekeeper "github.com/oldfurya/furya/x/poe/keeper"
 	poetypes "github.com/oldfurya/furya/x/poe/types"
+	"github.com/oldfurya/furya/x/twasm"
 )
 
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
@@ -33,6 +34,7 @@ type HandlerOptions struct {
 	TXCounterStoreKey sdk.StoreKey
 	GlobalFeeSubspace paramtypes.Subspace
 	ContractSource    poekeeper.ContractSource
+	TxAnteHookKeeper  twasm.TxAnteHookKeeper
 }
 
 // NewAnteHandler constructor that setup the full ante handler chain for the application
@@ -64,6 +66,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.IBCCoreKeeper == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "ibc core keeper is required for ante builder")
 	}
+	if options.TxAnteHookKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "tx ante hook keeper is required for ante builder")
+	}
 
 	sigGasConsumer := options.SigGasConsumer
 	if sigGasConsumer == nil {
@@ -89,6 +94,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		twasm.NewTxAnteHookDecorator(options.TxAnteHookKeeper), // after signature verification
+		twasm.NewTxSignersDecorator(),                          // after signature verification
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewAnteDecorator(options.IBCCoreKeeper),
 	}
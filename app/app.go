@@ -10,6 +10,7 @@ import (
 	"github.com/oldfurya/furya/app/upgrades"
 	v2 "github.com/oldfurya/furya/app/upgrades/v2"
 	v3 "github.com/oldfurya/furya/app/upgrades/v3"
+	v4 "github.com/oldfurya/furya/app/upgrades/v4"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -87,6 +88,7 @@ import (
 	poekeeper "github.com/oldfurya/furya/x/poe/keeper"
 	poestakingadapter "github.com/oldfurya/furya/x/poe/stakingadapter"
 	poetypes "github.com/oldfurya/furya/x/poe/types"
+	poewasm "github.com/oldfurya/furya/x/poe/wasm"
 	"github.com/oldfurya/furya/x/twasm"
 	twasmkeeper "github.com/oldfurya/furya/x/twasm/keeper"
 
@@ -155,7 +157,7 @@ var (
 		poetypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
 	}
 
-	Upgrades = []upgrades.Upgrade{v2.Upgrade, v3.Upgrade}
+	Upgrades = []upgrades.Upgrade{v2.Upgrade, v3.Upgrade, v4.Upgrade}
 )
 
 var (
@@ -205,6 +207,11 @@ type PetriApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// consensus params store with the version params that are not covered by the baseapp
+	consensusParamsSubspace paramstypes.Subspace
+	// version params read in the EndBlocker and passed to tendermint
+	endBlockVersionParams *tmproto.VersionParams
 }
 
 // NewPetriApp returns a reference to an initialized PetriApp.
@@ -257,7 +264,8 @@ func NewPetriApp(
 	)
 
 	// set the BaseApp's parameter store
-	bApp.SetParamStore(app.paramsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramskeeper.ConsensusParamsKeyTable()))
+	app.consensusParamsSubspace = app.paramsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(consensusParamsKeyTable())
+	bApp.SetParamStore(app.consensusParamsSubspace)
 
 	// add capability keeper and ScopeToModule for ibc module
 	app.capabilityKeeper = capabilitykeeper.NewKeeper(
@@ -281,13 +289,15 @@ func NewPetriApp(
 		authtypes.ProtoBaseAccount,
 		maccPerms,
 	)
-	app.bankKeeper = bankkeeper.NewBaseKeeper(
+	bankBaseKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.accountKeeper,
 		app.getSubspace(banktypes.ModuleName),
 		app.ModuleAccountAddrs(),
 	)
+	// bank sends are intercepted by the contracts registered for the bank send hook privilege
+	app.bankKeeper = twasmkeeper.NewHookedBankKeeper(bankBaseKeeper, &app.twasmKeeper)
 	app.authzKeeper = authzkeeper.NewKeeper(
 		keys[authzkeeper.StoreKey],
 		appCodec,
@@ -368,7 +378,10 @@ func NewPetriApp(
 	// if we want to allow any custom callbacks
 	availableCapabilities := "staking,stargate,iterator,furya,cosmwasm_1_1"
 
-	wasmOpts = append(SetupWasmHandlers(appCodec, app.bankKeeper, govRouter, &app.twasmKeeper, &app.poeKeeper, app), wasmOpts...)
+	// app specific privileges can be registered together with their handlers here
+	privileges := twasmkeeper.NewPrivilegeRegistry(poewasm.PetriQuery{})
+
+	wasmOpts = append(SetupWasmHandlers(appCodec, app.bankKeeper, govRouter, &app.twasmKeeper, &app.poeKeeper, app, &app.twasmKeeper, privileges), wasmOpts...)
 
 	stakingAdapter := stakingKeeper
 	app.twasmKeeper = twasmkeeper.NewKeeper(
@@ -391,6 +404,7 @@ func NewPetriApp(
 		availableCapabilities,
 		wasmOpts...,
 	)
+	app.twasmKeeper.SetConsensusParamsUpdater(app)
 
 	govRouter.AddRoute(twasm.RouterKey, twasmkeeper.NewProposalHandler(app.twasmKeeper))
 
@@ -429,10 +443,14 @@ func NewPetriApp(
 		),
 		auth.NewAppModule(appCodec, app.accountKeeper, nil),
 		vesting.NewAppModule(app.accountKeeper, app.bankKeeper),
-		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
+		hookedBankModule{
+			AppModule:  bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
+			msgKeeper:  app.bankKeeper,
+			baseKeeper: bankBaseKeeper,
+		},
 		capability.NewAppModule(appCodec, *app.capabilityKeeper),
 		upgrade.NewAppModule(app.upgradeKeeper),
-		twasm.NewAppModule(appCodec, &app.twasmKeeper, stakingKeeper, app.accountKeeper, app.bankKeeper),
+		twasm.NewAppModule(appCodec, &app.twasmKeeper, stakingKeeper, app.accountKeeper, app.bankKeeper, privileges),
 		feegrantmodule.NewAppModule(appCodec, app.accountKeeper, app.bankKeeper, app.feeGrantKeeper, app.interfaceRegistry),
 		authzmodule.NewAppModule(appCodec, app.authzKeeper, app.accountKeeper, app.bankKeeper, app.interfaceRegistry),
 		ibc.NewAppModule(app.ibcKeeper),
@@ -535,7 +553,7 @@ func NewPetriApp(
 		feegrantmodule.NewAppModule(appCodec, app.accountKeeper, app.bankKeeper, app.feeGrantKeeper, app.interfaceRegistry),
 		// has hard coded "stake" token: authzmodule.NewAppModule(appCodec, app.authzKeeper, app.accountKeeper, app.bankKeeper, app.interfaceRegistry),
 		params.NewAppModule(app.paramsKeeper),
-		twasm.NewAppModule(appCodec, &app.twasmKeeper, stakingKeeper, app.accountKeeper, app.bankKeeper),
+		twasm.NewAppModule(appCodec, &app.twasmKeeper, stakingKeeper, app.accountKeeper, app.bankKeeper, privileges),
 		poe.NewAppModule(
 			&app.poeKeeper,
 			&app.twasmKeeper,
@@ -560,7 +578,7 @@ func NewPetriApp(
 	anteHandler, err := NewAnteHandler(
 		HandlerOptions{
 			AccountKeeper:     app.accountKeeper,
-			BankKeeper:        app.bankKeeper,
+			BankKeeper:        bankBaseKeeper, // fees are not subject to bank send hooks
 			FeegrantKeeper:    app.feeGrantKeeper,
 			SignModeHandler:   encodingConfig.TxConfig.SignModeHandler(),
 			SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
@@ -569,6 +587,7 @@ func NewPetriApp(
 			TXCounterStoreKey: keys[twasm.StoreKey],
 			GlobalFeeSubspace: app.getSubspace(globalfee.ModuleName),
 			ContractSource:    &app.poeKeeper,
+			TxAnteHookKeeper:  &app.twasmKeeper,
 		},
 	)
 	if err != nil {
@@ -623,7 +642,9 @@ func (app *PetriApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) a
 
 // EndBlocker application updates every end block
 func (app *PetriApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	return app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
+	app.endBlockVersionParams = app.getVersionParams(ctx)
+	return res
 }
 
 // InitChainer application update at chain initialization
@@ -634,6 +655,7 @@ func (app *PetriApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abc
 	}
 
 	app.upgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	app.storeVersionParams(ctx, req.ConsensusParams)
 
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
@@ -169,3 +169,24 @@ func TestIBCKeeperLazyInitialization(t *testing.T) {
 	assert.Equal(t, []byte("myAppHash"), state.GetRoot().GetHash())
 	assert.Equal(t, uint64(now.UnixNano()), state.GetTimestamp())
 }
+
+func TestInitChainStoresGenesisVersionParams(t *testing.T) {
+	gapp, _ := setup(false, 5)
+	consensusParams := *DefaultConsensusParams
+	consensusParams.Version = &tmproto.VersionParams{AppVersion: 2}
+
+	// when
+	gapp.InitChain(
+		abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: &consensusParams,
+			AppStateBytes:   []byte("{}"),
+		},
+	)
+
+	// then
+	ctx := gapp.BaseApp.NewContext(false, tmproto.Header{})
+	got := gapp.GetConsensusParams(ctx)
+	require.NotNil(t, got)
+	assert.Equal(t, &tmproto.VersionParams{AppVersion: 2}, got.Version)
+}
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// hookedBankModule extends the SDK bank module to process the bank messages with a keeper that calls the
+// bank send hooks. The SDK module requires the base keeper for the store migrations.
+type hookedBankModule struct {
+	bank.AppModule
+	msgKeeper  bankkeeper.Keeper
+	baseKeeper bankkeeper.BaseKeeper
+}
+
+// RegisterServices registers module services.
+func (am hookedBankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.msgKeeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.baseKeeper)
+
+	m := bankkeeper.NewMigrator(am.baseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// ParamStoreKeyVersionParams is the key of the tendermint version params in the baseapp param store.
+// They are not persisted by the SDK baseapp but can be set via the consensus params contract message.
+var ParamStoreKeyVersionParams = []byte("VersionParams")
+
+// consensusParamsKeyTable extends the SDK baseapp key table with the version params
+func consensusParamsKeyTable() paramstypes.KeyTable {
+	return paramskeeper.ConsensusParamsKeyTable().RegisterType(
+		paramstypes.NewParamSetPair(ParamStoreKeyVersionParams, tmproto.VersionParams{}, validateVersionParams),
+	)
+}
+
+func validateVersionParams(i interface{}) error {
+	if _, ok := i.(tmproto.VersionParams); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// GetConsensusParams returns the consensus params stored by the baseapp including the version params when set.
+func (app *PetriApp) GetConsensusParams(ctx sdk.Context) *abci.ConsensusParams {
+	cp := app.BaseApp.GetConsensusParams(ctx)
+	if cp == nil {
+		return nil
+	}
+	cp.Version = app.getVersionParams(ctx)
+	return cp
+}
+
+// StoreConsensusParams persists the consensus params via the baseapp and the version params when set.
+func (app *PetriApp) StoreConsensusParams(ctx sdk.Context, cp *abci.ConsensusParams) {
+	app.BaseApp.StoreConsensusParams(ctx, cp)
+	app.storeVersionParams(ctx, cp)
+}
+
+// storeVersionParams persists the version params when set. The baseapp InitChain does not call the
+// StoreConsensusParams override so that this must be called in the InitChainer for the genesis params.
+func (app *PetriApp) storeVersionParams(ctx sdk.Context, cp *abci.ConsensusParams) {
+	if cp != nil && cp.Version != nil {
+		app.consensusParamsSubspace.Set(ctx, ParamStoreKeyVersionParams, cp.Version)
+	}
+}
+
+// EndBlock extends the baseapp EndBlock to pass the version params to tendermint. They are read within the
+// EndBlocker as the baseapp only reports the block, evidence and validator params.
+func (app *PetriApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	res := app.BaseApp.EndBlock(req)
+	if res.ConsensusParamUpdates != nil && app.endBlockVersionParams != nil {
+		res.ConsensusParamUpdates.Version = app.endBlockVersionParams
+	}
+	return res
+}
+
+func (app *PetriApp) getVersionParams(ctx sdk.Context) *tmproto.VersionParams {
+	if !app.consensusParamsSubspace.Has(ctx, ParamStoreKeyVersionParams) {
+		return nil
+	}
+	var vp tmproto.VersionParams
+	app.consensusParamsSubspace.Get(ctx, ParamStoreKeyVersionParams, &vp)
+	return &vp
+}
@@ -0,0 +1,13 @@
+package v4
+
+import (
+	"github.com/oldfurya/furya/app/upgrades"
+)
+
+// UpgradeName defines the on-chain upgrade name for the Petri v4 upgrade.
+const UpgradeName = "v4"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+}
@@ -22,10 +22,12 @@ func SetupWasmHandlers(
 	twasmKeeper twasmkeeper.PetriWasmHandlerKeeper,
 	poeKeeper poewasm.ViewKeeper,
 	consensusParamsUpdater twasmkeeper.ConsensusParamsUpdater,
+	govProposalSimulator poewasm.GovProposalSimulator,
+	privileges *twasmkeeper.PrivilegeRegistry,
 ) []wasmkeeper.Option {
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Staking: poewasm.StakingQuerier(poeKeeper),
-		Custom:  poewasm.CustomQuerier(poeKeeper),
+		Custom:  privileges.CustomQuerier(poewasm.CustomQuerier(poeKeeper, consensusParamsUpdater, govProposalSimulator)),
 	})
 
 	extMessageHandlerOpt := wasmkeeper.WithMessageHandlerDecorator(func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
@@ -39,7 +41,7 @@ func SetupWasmHandlers(
 			}),
 			nested,
 			// append our custom message handler
-			twasmkeeper.NewPetriHandler(cdc, twasmKeeper, bankKeeper, consensusParamsUpdater, govRouter),
+			twasmkeeper.NewPetriHandler(cdc, twasmKeeper, bankKeeper, consensusParamsUpdater, govRouter, privileges),
 		)
 	})
 	return []wasm.Option{
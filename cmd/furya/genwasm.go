@@ -43,3 +43,7 @@ func GenesisWasmFlagsCmd(defaultNodeHome string) *cobra.Command {
 	)
 	return txCmd
 }
+
+func AddGenesisPrivilegedContractCmd(defaultNodeHome string) *cobra.Command {
+	return cli.GenesisAddPrivilegedContract(defaultNodeHome, cli.NewGenesisIO())
+}
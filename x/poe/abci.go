@@ -1,44 +1,82 @@
 package poe
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
 
 	"github.com/oldfurya/furya/x/poe/contract"
 	"github.com/oldfurya/furya/x/poe/keeper"
 	"github.com/oldfurya/furya/x/poe/types"
 	"github.com/oldfurya/furya/x/twasm"
+	twasmcontract "github.com/oldfurya/furya/x/twasm/contract"
 	twasmtypes "github.com/oldfurya/furya/x/twasm/types"
 )
 
 type endBlockKeeper interface {
-	types.Sudoer
-	IteratePrivilegedContractsByType(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	twasm.PrivilegedCallbackKeeper
+	types.SmartQuerier
+}
+
+// validatorOperatorIndex stores the operator addresses of the validators by tendermint pubkey
+type validatorOperatorIndex interface {
+	GetValidatorOperator(ctx sdk.Context, pubKey crypto.PublicKey) (string, bool)
+	SetValidatorOperator(ctx sdk.Context, pubKey crypto.PublicKey, operator string)
+	DeleteValidatorOperator(ctx sdk.Context, pubKey crypto.PublicKey)
+}
+
+// validatorSetTracker is the subset of the poe keeper that is updated with the validator set changes
+type validatorSetTracker interface {
+	validatorOperatorIndex
+	SetValidatorSetChanged(ctx sdk.Context)
 }
 
 type abciKeeper interface {
 	UpdateValidatorVotes(validatorVotes []abci.VoteInfo)
 	TrackHistoricalInfo(ctx sdk.Context)
+	TrackLiveness(ctx sdk.Context, votes []abci.VoteInfo)
 }
 
 // EndBlocker calls the Valset contract for the validator diff.
-func EndBlocker(parentCtx sdk.Context, k endBlockKeeper) []abci.ValidatorUpdate {
+func EndBlocker(parentCtx sdk.Context, k endBlockKeeper, tracker validatorSetTracker) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 	logger := keeper.ModuleLogger(parentCtx)
 
-	var diff []abci.ValidatorUpdate
+	var (
+		diff       []abci.ValidatorUpdate
+		valsetAddr sdk.AccAddress
+	)
 	// allow validator set updates for this group only
 	k.IteratePrivilegedContractsByType(parentCtx, twasmtypes.PrivilegeTypeValidatorSetUpdate, func(pos uint8, contractAddr sdk.AccAddress) bool {
 		logger.Info("privileged contract callback", "type", twasmtypes.PrivilegeTypeValidatorSetUpdate.String())
+		var succeeded bool
+		// track result after any panic was recovered. The privilege is never quarantined as the chain would be
+		// left without validator set updates.
+		defer func() {
+			if succeeded {
+				k.ResetCallbackFailures(parentCtx, twasmtypes.PrivilegeTypeValidatorSetUpdate, contractAddr)
+				return
+			}
+			k.TrackCallbackFailure(parentCtx, twasmtypes.PrivilegeTypeValidatorSetUpdate, contractAddr)
+		}()
 		ctx, commit := parentCtx.CacheContext()
 		defer twasm.RecoverToLog(logger, contractAddr)()
 
-		var err error
-		diff, err = contract.CallEndBlockWithValidatorUpdate(ctx, contractAddr, k)
+		gasLimit := k.CallbackGasLimit(parentCtx, twasmtypes.PrivilegeTypeValidatorSetUpdate, contractAddr)
+		err := twasm.ExecuteWithGasLimit(ctx, gasLimit, func(ctx sdk.Context) error {
+			var err error
+			diff, err = contract.CallEndBlockWithValidatorUpdate(ctx, contractAddr, k)
+			return err
+		})
 		if err != nil {
+			if sdkerrors.ErrOutOfGas.Is(err) {
+				twasm.ReportCallbackOutOfGas(parentCtx, twasmtypes.PrivilegeTypeValidatorSetUpdate, contractAddr, gasLimit)
+			}
 			logger.Error(
 				"contract callback for validator set update failed",
 				"cause", err,
@@ -48,18 +86,101 @@ func EndBlocker(parentCtx sdk.Context, k endBlockKeeper) []abci.ValidatorUpdate
 			return true // stop at first contract, without commit
 		}
 		commit()
+		succeeded = true
 		if len(diff) != 0 {
 			logger.Info("update validator set", "new", diff)
+			valsetAddr = contractAddr
 		}
 		return true // stop at first contract
 	})
+	if len(diff) != 0 {
+		tracker.SetValidatorSetChanged(parentCtx)
+		notifyValidatorSetListeners(parentCtx, k, tracker, valsetAddr, diff)
+	}
 	return diff
 }
 
+// sends the applied validator set changes to all contracts registered for the validator set listener privilege.
+// Without listeners, only the removed validators are dropped from the operator index.
+func notifyValidatorSetListeners(ctx sdk.Context, k endBlockKeeper, index validatorOperatorIndex, valsetAddr sdk.AccAddress, diff []abci.ValidatorUpdate) {
+	var hasListeners bool
+	k.IteratePrivilegedContractsByType(ctx, twasmtypes.PrivilegeTypeValidatorSetListener, func(uint8, sdk.AccAddress) bool {
+		hasListeners = true
+		return true
+	})
+	if !hasListeners {
+		for _, v := range diff {
+			if v.Power == 0 {
+				index.DeleteValidatorOperator(ctx, v.PubKey)
+			}
+		}
+		return
+	}
+	operators := resolveOperators(ctx, k, index, valsetAddr, diff)
+	updates := make([]twasmcontract.ValidatorSetUpdate, len(diff))
+	for i, v := range diff {
+		updates[i] = twasmcontract.ValidatorSetUpdate{
+			Operator: operators[i],
+			PubKey: twasmcontract.ValidatorPubkey{
+				Ed25519:   v.PubKey.GetEd25519(),
+				Secp256k1: v.PubKey.GetSecp256K1(),
+			},
+			Power: uint64(v.Power),
+		}
+	}
+	msgBz, err := json.Marshal(twasmcontract.PetriSudoMsg{ValidatorSetChanged: &twasmcontract.ValidatorSetChanged{Updates: updates}})
+	if err != nil {
+		panic(err) // this will break consensus
+	}
+	twasm.NotifyPrivilegedContracts(ctx, k, twasmtypes.PrivilegeTypeValidatorSetListener, msgBz)
+}
+
+// resolveOperators returns the operator addresses for the validator updates in the same order. Removed validators
+// are resolved from the index. The index is refreshed with the active set from the valset contract when validators
+// were added or updated so that the work is bound by the max number of active validators.
+// The address is empty for validators that can not be resolved.
+func resolveOperators(ctx sdk.Context, k endBlockKeeper, index validatorOperatorIndex, valsetAddr sdk.AccAddress, diff []abci.ValidatorUpdate) []string {
+	result := make([]string, len(diff))
+	var activeOperators map[string]string // lazy loaded
+	for i, v := range diff {
+		if v.Power == 0 {
+			result[i], _ = index.GetValidatorOperator(ctx, v.PubKey)
+			index.DeleteValidatorOperator(ctx, v.PubKey)
+			continue
+		}
+		if activeOperators == nil {
+			activeOperators = indexActiveValidators(ctx, k, index, valsetAddr)
+		}
+		result[i] = activeOperators[v.PubKey.String()]
+	}
+	return result
+}
+
+// indexActiveValidators stores the operator addresses of the active validators in the index and returns them by
+// tendermint pubkey
+func indexActiveValidators(ctx sdk.Context, k endBlockKeeper, index validatorOperatorIndex, valsetAddr sdk.AccAddress) map[string]string {
+	vals, err := contract.QueryActiveValidators(ctx, k, valsetAddr)
+	if err != nil {
+		keeper.ModuleLogger(ctx).Error("failed to resolve validator operators", "cause", err)
+		return map[string]string{}
+	}
+	result := make(map[string]string, len(vals))
+	for _, v := range vals {
+		pk, err := contract.ConvertToTendermintPubKey(v.ValidatorPubkey)
+		if err != nil {
+			continue // not a valid consensus key
+		}
+		index.SetValidatorOperator(ctx, pk, v.Operator)
+		result[pk.String()] = v.Operator
+	}
+	return result
+}
+
 // BeginBlocker ABCI begin block callback
 func BeginBlocker(ctx sdk.Context, k abciKeeper, b abci.RequestBeginBlock) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	k.UpdateValidatorVotes(b.LastCommitInfo.Votes)
 	k.TrackHistoricalInfo(ctx)
+	k.TrackLiveness(ctx, b.LastCommitInfo.Votes)
 }
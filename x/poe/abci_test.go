@@ -2,9 +2,12 @@ package poe
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +18,8 @@ import (
 	"github.com/tendermint/tendermint/proto/tendermint/crypto"
 
 	"github.com/oldfurya/furya/x/poe/contract"
+	v2 "github.com/oldfurya/furya/x/poe/migrations/v2"
+	"github.com/oldfurya/furya/x/poe/types"
 	twasmtypes "github.com/oldfurya/furya/x/twasm/types"
 )
 
@@ -29,6 +34,7 @@ func TestEndBlock(t *testing.T) {
 		expSudoCalls    []tuple
 		expCommitted    []bool
 		expValsetUpdate []abci.ValidatorUpdate
+		expFailed       bool
 	}{
 		"valset update - empty response": {
 			setup: func(m *MockSudoer) {
@@ -68,6 +74,9 @@ func TestEndBlock(t *testing.T) {
 					return bz, err
 				}
 				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr})
+				m.QuerySmartFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+					return []byte(`{"validators":[]}`), nil
+				}
 			},
 			expSudoCalls: []tuple{{addr: myAddr, msg: []byte(`{"end_with_validator_update":{}}`)}},
 			expCommitted: []bool{true},
@@ -89,6 +98,21 @@ func TestEndBlock(t *testing.T) {
 				}
 				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr})
 			},
+			expFailed: true,
+		},
+		"valset update - out of gas should be handled": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					ctx.GasMeter().ConsumeGas(101, "testing")
+					return captureSudos(&capturedSudoCalls)(ctx, contractAddress, msg)
+				}
+				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr})
+				m.CallbackGasLimitFn = func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) sdk.Gas {
+					return 100
+				}
+			},
+			expCommitted: []bool{false},
+			expFailed:    true,
 		},
 	}
 	for name, spec := range specs {
@@ -96,13 +120,27 @@ func TestEndBlock(t *testing.T) {
 			capturedSudoCalls = nil
 			mock := MockSudoer{}
 			spec.setup(&mock)
+			var gotFailed, gotReset []sdk.AccAddress
+			mock.TrackCallbackFailureFn = func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) bool {
+				require.Equal(t, twasmtypes.PrivilegeTypeValidatorSetUpdate, privilegeType)
+				gotFailed = append(gotFailed, contractAddr)
+				return true // never quarantined
+			}
+			mock.ResetCallbackFailuresFn = func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) {
+				require.Equal(t, twasmtypes.PrivilegeTypeValidatorSetUpdate, privilegeType)
+				gotReset = append(gotReset, contractAddr)
+			}
 			commitMultistore := mockCommitMultiStore{}
 			ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
-				WithMultiStore(&commitMultistore)
+				WithMultiStore(&commitMultistore).
+				WithEventManager(sdk.NewEventManager())
+
+			tracker := &validatorSetTrackerMock{operatorIndexMock: operatorIndexMock{}}
 
 			// when
-			gotValsetUpdate := EndBlocker(ctx, &mock)
+			gotValsetUpdate := EndBlocker(ctx, &mock, tracker)
 			assert.Equal(t, spec.expValsetUpdate, gotValsetUpdate)
+			assert.Equal(t, len(spec.expValsetUpdate) != 0, tracker.changed)
 
 			// then
 			require.Len(t, capturedSudoCalls, len(spec.expSudoCalls))
@@ -115,10 +153,232 @@ func TestEndBlock(t *testing.T) {
 			for i, v := range spec.expCommitted {
 				assert.Equal(t, v, commitMultistore.committed[i], "tx number %d", i)
 			}
+			// and failures tracked
+			if spec.expFailed {
+				assert.Equal(t, []sdk.AccAddress{myAddr}, gotFailed)
+				assert.Empty(t, gotReset)
+			} else {
+				assert.Empty(t, gotFailed)
+				assert.Equal(t, []sdk.AccAddress{myAddr}, gotReset)
+			}
 		})
 	}
 }
 
+func TestEndBlockValidatorSetListeners(t *testing.T) {
+	var (
+		valsetAddr    sdk.AccAddress = rand.Bytes(address.Len)
+		listenerAddr  sdk.AccAddress = rand.Bytes(address.Len)
+		otherAddr     sdk.AccAddress = rand.Bytes(address.Len)
+		myOperator                   = sdk.AccAddress(rand.Bytes(address.Len)).String()
+		otherOperator                = sdk.AccAddress(rand.Bytes(address.Len)).String()
+	)
+	valsetResponse := func(t *testing.T) []byte {
+		bz, err := json.Marshal(&contract.EndWithValidatorUpdateResponse{
+			Diffs: []contract.ValidatorUpdate{
+				{PubKey: contract.ValidatorPubkey{Ed25519: []byte("my key")}, Power: 1},
+				{PubKey: contract.ValidatorPubkey{Ed25519: []byte("unknown key")}},
+			},
+		})
+		require.NoError(t, err)
+		return bz
+	}
+
+	specs := map[string]struct {
+		listeners    []sdk.AccAddress
+		indexed      map[string]string
+		querySmartFn func(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+		expNotified  []sdk.AccAddress
+		expMsg       string
+		expIndex     map[string]string
+	}{
+		"operators resolved": {
+			listeners: []sdk.AccAddress{listenerAddr, otherAddr},
+			querySmartFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+				require.Equal(t, valsetAddr, contractAddr)
+				var q contract.ValsetQuery
+				require.NoError(t, json.Unmarshal(req, &q))
+				require.NotNil(t, q.ListActiveValidators)
+				if q.ListActiveValidators.StartAfter != "" {
+					return []byte(`{"validators":[]}`), nil
+				}
+				return json.Marshal(contract.ListActiveValidatorsResponse{Validators: []contract.ValidatorInfo{
+					{Operator: myOperator, ValidatorPubkey: contract.ValidatorPubkey{Ed25519: []byte("my key")}, Power: 1},
+				}})
+			},
+			expNotified: []sdk.AccAddress{listenerAddr, otherAddr},
+			expMsg: fmt.Sprintf(`{"validator_set_changed":{"updates":[
+{"operator":%q,"pubkey":{"ed25519":"bXkga2V5"},"power":1},
+{"pubkey":{"ed25519":"dW5rbm93biBrZXk="},"power":0}
+]}}`, myOperator),
+			expIndex: map[string]string{indexKey("my key"): myOperator},
+		},
+		"removed validator resolved from index": {
+			listeners: []sdk.AccAddress{listenerAddr},
+			indexed:   map[string]string{indexKey("unknown key"): otherOperator},
+			querySmartFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+				return []byte(`{"validators":[]}`), nil
+			},
+			expNotified: []sdk.AccAddress{listenerAddr},
+			expMsg: fmt.Sprintf(`{"validator_set_changed":{"updates":[
+{"pubkey":{"ed25519":"bXkga2V5"},"power":1},
+{"operator":%q,"pubkey":{"ed25519":"dW5rbm93biBrZXk="},"power":0}
+]}}`, otherOperator),
+			expIndex: map[string]string{},
+		},
+		"operator lookup fails": {
+			listeners: []sdk.AccAddress{listenerAddr},
+			querySmartFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+				return nil, errors.New("testing")
+			},
+			expNotified: []sdk.AccAddress{listenerAddr},
+			expMsg: `{"validator_set_changed":{"updates":[
+{"pubkey":{"ed25519":"bXkga2V5"},"power":1},
+{"pubkey":{"ed25519":"dW5rbm93biBrZXk="},"power":0}
+]}}`,
+			expIndex: map[string]string{},
+		},
+		"no listeners": {
+			indexed:  map[string]string{indexKey("my key"): myOperator, indexKey("unknown key"): otherOperator},
+			expIndex: map[string]string{indexKey("my key"): myOperator},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var capturedSudoCalls []tuple
+			mock := MockSudoer{
+				SudoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					if contractAddress.Equals(valsetAddr) {
+						return valsetResponse(t), nil
+					}
+					return captureSudos(&capturedSudoCalls)(ctx, contractAddress, msg)
+				},
+				IteratePrivilegedContractsByTypeFn: endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{valsetAddr}, spec.listeners...),
+				QuerySmartFn:                       spec.querySmartFn,
+			}
+			ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
+				WithMultiStore(&mockCommitMultiStore{}).
+				WithEventManager(sdk.NewEventManager())
+			index := operatorIndexMock{}
+			for k, v := range spec.indexed {
+				index[k] = v
+			}
+
+			// when
+			gotValsetUpdate := EndBlocker(ctx, &mock, &validatorSetTrackerMock{operatorIndexMock: index})
+
+			// then
+			assert.Len(t, gotValsetUpdate, 2)
+			require.Len(t, capturedSudoCalls, len(spec.expNotified))
+			for i, v := range spec.expNotified {
+				assert.Equal(t, v, capturedSudoCalls[i].addr)
+				assert.JSONEq(t, spec.expMsg, string(capturedSudoCalls[i].msg))
+			}
+			assert.Equal(t, spec.expIndex, map[string]string(index))
+		})
+	}
+}
+
+func TestEndBlockRemovesValidatorActiveBeforeUpgrade(t *testing.T) {
+	var (
+		valsetAddr   sdk.AccAddress = rand.Bytes(address.Len)
+		listenerAddr sdk.AccAddress = rand.Bytes(address.Len)
+		myOperator                  = sdk.AccAddress(rand.Bytes(address.Len)).String()
+	)
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test")).
+		WithLogger(log.TestingLogger())
+	var capturedSudoCalls []tuple
+	mock := MockSudoer{
+		SudoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			if contractAddress.Equals(valsetAddr) {
+				return json.Marshal(&contract.EndWithValidatorUpdateResponse{
+					Diffs: []contract.ValidatorUpdate{{PubKey: contract.ValidatorPubkey{Ed25519: []byte("my key")}}},
+				})
+			}
+			return captureSudos(&capturedSudoCalls)(ctx, contractAddress, msg)
+		},
+		IteratePrivilegedContractsByTypeFn: endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{valsetAddr}, listenerAddr),
+		QuerySmartFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+			var q contract.ValsetQuery
+			require.NoError(t, json.Unmarshal(req, &q))
+			if q.ListActiveValidators.StartAfter != "" {
+				return []byte(`{"validators":[]}`), nil
+			}
+			return json.Marshal(contract.ListActiveValidatorsResponse{Validators: []contract.ValidatorInfo{
+				{Operator: myOperator, ValidatorPubkey: contract.ValidatorPubkey{Ed25519: []byte("my key")}, Power: 1},
+			}})
+		},
+	}
+	// the validator was active before the upgrade
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, &mock, valsetAddr))
+	mock.QuerySmartFn = nil // no lookup for removals
+
+	// when
+	gotValsetUpdate := EndBlocker(ctx, &mock, storeOperatorIndex{storeKey: storeKey})
+
+	// then
+	require.Len(t, gotValsetUpdate, 1)
+	require.Len(t, capturedSudoCalls, 1)
+	exp := fmt.Sprintf(`{"validator_set_changed":{"updates":[{"operator":%q,"pubkey":{"ed25519":"bXkga2V5"},"power":0}]}}`, myOperator)
+	assert.JSONEq(t, exp, string(capturedSudoCalls[0].msg))
+	_, found := storeOperatorIndex{storeKey: storeKey}.GetValidatorOperator(ctx, gotValsetUpdate[0].PubKey)
+	assert.False(t, found)
+}
+
+// storeOperatorIndex validator operator index with the same store layout as the poe keeper
+type storeOperatorIndex struct {
+	storeKey sdk.StoreKey
+}
+
+func (s storeOperatorIndex) GetValidatorOperator(ctx sdk.Context, pubKey crypto.PublicKey) (string, bool) {
+	bz := ctx.KVStore(s.storeKey).Get(types.GetValidatorOperatorKey(pubKey))
+	return string(bz), bz != nil
+}
+
+func (s storeOperatorIndex) SetValidatorOperator(ctx sdk.Context, pubKey crypto.PublicKey, operator string) {
+	ctx.KVStore(s.storeKey).Set(types.GetValidatorOperatorKey(pubKey), []byte(operator))
+}
+
+func (s storeOperatorIndex) DeleteValidatorOperator(ctx sdk.Context, pubKey crypto.PublicKey) {
+	ctx.KVStore(s.storeKey).Delete(types.GetValidatorOperatorKey(pubKey))
+}
+
+func (s storeOperatorIndex) SetValidatorSetChanged(ctx sdk.Context) {
+	ctx.KVStore(s.storeKey).Set(types.ValidatorSetChangedKey, []byte{1})
+}
+
+func indexKey(key string) string {
+	pk := crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: []byte(key)}}
+	return pk.String()
+}
+
+// operatorIndexMock in memory validator operator index by pubkey string
+type operatorIndexMock map[string]string
+
+func (m operatorIndexMock) GetValidatorOperator(_ sdk.Context, pubKey crypto.PublicKey) (string, bool) {
+	v, ok := m[pubKey.String()]
+	return v, ok
+}
+
+func (m operatorIndexMock) SetValidatorOperator(_ sdk.Context, pubKey crypto.PublicKey, operator string) {
+	m[pubKey.String()] = operator
+}
+
+func (m operatorIndexMock) DeleteValidatorOperator(_ sdk.Context, pubKey crypto.PublicKey) {
+	delete(m, pubKey.String())
+}
+
+// validatorSetTrackerMock in memory validator operator index that records validator set changes
+type validatorSetTrackerMock struct {
+	operatorIndexMock
+	changed bool
+}
+
+func (m *validatorSetTrackerMock) SetValidatorSetChanged(sdk.Context) {
+	m.changed = true
+}
+
 func iterateContractsFn(t *testing.T, expType twasmtypes.PrivilegeType, addrs ...sdk.AccAddress) func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool) {
 	return func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool) {
 		require.Equal(t, expType, privilegeType)
@@ -131,13 +391,15 @@ func iterateContractsFn(t *testing.T, expType twasmtypes.PrivilegeType, addrs ..
 }
 
 // helper function to handle both types in end block
-func endBlockTypeIterateContractsFn(t *testing.T, end []sdk.AccAddress, valset []sdk.AccAddress) func(sdk.Context, twasmtypes.PrivilegeType, func(uint8, sdk.AccAddress) bool) {
+func endBlockTypeIterateContractsFn(t *testing.T, end []sdk.AccAddress, valset []sdk.AccAddress, listeners ...sdk.AccAddress) func(sdk.Context, twasmtypes.PrivilegeType, func(uint8, sdk.AccAddress) bool) {
 	return func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool) {
 		switch privilegeType {
 		case twasmtypes.PrivilegeTypeEndBlock:
 			iterateContractsFn(t, twasmtypes.PrivilegeTypeEndBlock, end...)(ctx, privilegeType, cb)
 		case twasmtypes.PrivilegeTypeValidatorSetUpdate:
 			iterateContractsFn(t, twasmtypes.PrivilegeTypeValidatorSetUpdate, valset...)(ctx, privilegeType, cb)
+		case twasmtypes.PrivilegeTypeValidatorSetListener:
+			iterateContractsFn(t, twasmtypes.PrivilegeTypeValidatorSetListener, listeners...)(ctx, privilegeType, cb)
 		default:
 			t.Errorf("unexpected privileged type: %q", privilegeType.String())
 		}
@@ -159,6 +421,48 @@ func captureSudos(capturedSudoCalls *[]tuple) func(ctx sdk.Context, contractAddr
 type MockSudoer struct {
 	SudoFn                             func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	IteratePrivilegedContractsByTypeFn func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	CallbackGasLimitFn                 func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) sdk.Gas
+	QuerySmartFn                       func(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+	QuarantinePrivilegeFn              func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) error
+	TrackCallbackFailureFn             func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) bool
+	ResetCallbackFailuresFn            func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress)
+}
+
+func (m MockSudoer) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	if m.QuerySmartFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QuerySmartFn(ctx, contractAddr, req)
+}
+
+// TrackCallbackFailure returns false for no quarantine when no custom function is set
+func (m MockSudoer) TrackCallbackFailure(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) bool {
+	if m.TrackCallbackFailureFn == nil {
+		return false
+	}
+	return m.TrackCallbackFailureFn(ctx, privilegeType, contractAddr)
+}
+
+// ResetCallbackFailures is a noop when no custom function is set
+func (m MockSudoer) ResetCallbackFailures(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) {
+	if m.ResetCallbackFailuresFn != nil {
+		m.ResetCallbackFailuresFn(ctx, privilegeType, contractAddr)
+	}
+}
+
+func (m MockSudoer) QuarantinePrivilege(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) error {
+	if m.QuarantinePrivilegeFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QuarantinePrivilegeFn(ctx, privilegeType, contractAddr)
+}
+
+// CallbackGasLimit returns 0 for no limit when no custom function is set
+func (m MockSudoer) CallbackGasLimit(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) sdk.Gas {
+	if m.CallbackGasLimitFn == nil {
+		return 0
+	}
+	return m.CallbackGasLimitFn(ctx, privilegeType, contractAddr)
 }
 
 func (m MockSudoer) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
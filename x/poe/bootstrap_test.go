@@ -150,6 +150,22 @@ func (m twasmKeeperMock) IteratePrivilegedContractsByType(ctx sdk.Context, privi
 	panic("implement me")
 }
 
+func (m twasmKeeperMock) CallbackGasLimit(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) sdk.Gas {
+	panic("implement me")
+}
+
+func (m twasmKeeperMock) TrackCallbackFailure(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) bool {
+	panic("implement me")
+}
+
+func (m twasmKeeperMock) ResetCallbackFailures(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) {
+	panic("implement me")
+}
+
+func (m twasmKeeperMock) QuarantinePrivilege(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) error {
+	panic("implement me")
+}
+
 func (m twasmKeeperMock) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
 	if m.QuerySmartFn == nil {
 		panic("not expected to be called")
@@ -288,6 +288,26 @@ type ValidatorInfo struct {
 	Power           uint64          `json:"power"`
 }
 
+// ToValidator converts the active validator to a bonded cosmos-sdk validator with tokens matching the power
+func (v ValidatorInfo) ToValidator() (stakingtypes.Validator, error) {
+	pubKey, err := toCosmosPubKey(v.ValidatorPubkey)
+	if err != nil {
+		return stakingtypes.Validator{}, sdkerrors.Wrap(err, "convert to cosmos key")
+	}
+	any, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return stakingtypes.Validator{}, sdkerrors.Wrap(err, "convert to any type")
+	}
+	tokens := sdk.TokensFromConsensusPower(int64(v.Power), sdk.DefaultPowerReduction)
+	return stakingtypes.Validator{
+		OperatorAddress: v.Operator,
+		ConsensusPubkey: any,
+		Tokens:          tokens,
+		DelegatorShares: tokens.ToDec(),
+		Status:          stakingtypes.Bonded,
+	}, nil
+}
+
 type ValidatorResponse struct {
 	Validator *OperatorResponse `json:"validator"`
 }
@@ -341,6 +361,26 @@ func SimulateActiveValidators(ctx sdk.Context, k types.SmartQuerier, valset sdk.
 	return response.Validators, err
 }
 
+// QueryActiveValidators returns all validators of the active set. The number of pages is bound by the max
+// validators of the valset contract.
+func QueryActiveValidators(ctx sdk.Context, k types.SmartQuerier, valset sdk.AccAddress) ([]ValidatorInfo, error) {
+	var (
+		result     []ValidatorInfo
+		startAfter string
+	)
+	for {
+		var rsp ListActiveValidatorsResponse
+		if err := doQuery(ctx, k, valset, ValsetQuery{ListActiveValidators: &ListValidatorsQuery{StartAfter: startAfter}}, &rsp); err != nil {
+			return nil, err
+		}
+		if len(rsp.Validators) == 0 {
+			return result, nil
+		}
+		result = append(result, rsp.Validators...)
+		startAfter = rsp.Validators[len(rsp.Validators)-1].Operator
+	}
+}
+
 type ValsetContractAdapter struct {
 	BaseContractAdapter
 }
@@ -424,6 +464,35 @@ func (v ValsetContractAdapter) IterateActiveValidators(ctx sdk.Context, callback
 	return nil
 }
 
+// IterateValidators iterate through all validators as the contract returns them
+func (v ValsetContractAdapter) IterateValidators(ctx sdk.Context, callback func(OperatorResponse) bool) error {
+	var startAfter string
+	for {
+		var rsp ListValidatorsResponse
+		if err := v.doQuery(ctx, ValsetQuery{ListValidators: &ListValidatorsQuery{StartAfter: startAfter}}, &rsp); err != nil {
+			return sdkerrors.Wrap(err, "contract query")
+		}
+		if len(rsp.Validators) == 0 {
+			return nil
+		}
+		for _, o := range rsp.Validators {
+			if callback(o) {
+				return nil
+			}
+		}
+		startAfter = rsp.Validators[len(rsp.Validators)-1].Operator
+	}
+}
+
+// ConsAddress returns the consensus address for the validator pubkey
+func (o OperatorResponse) ConsAddress() (sdk.ConsAddress, error) {
+	pk, err := toCosmosPubKey(o.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.ConsAddress(pk.Address()), nil
+}
+
 func (v ValsetContractAdapter) ListValidatorSlashing(ctx sdk.Context, opAddr sdk.AccAddress) ([]ValidatorSlashing, error) {
 	query := ValsetQuery{ListValidatorSlashing: &ValidatorQuery{Operator: opAddr.String()}}
 	var rsp ListValidatorSlashingResponse
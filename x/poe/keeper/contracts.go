@@ -28,6 +28,8 @@ type ValsetContract interface {
 	QueryConfig(ctx sdk.Context) (*contract.ValsetConfigResponse, error)
 	UpdateAdmin(ctx sdk.Context, new sdk.AccAddress, sender sdk.AccAddress) error
 	IterateActiveValidators(ctx sdk.Context, callback func(c contract.ValidatorInfo) bool, pagination *contract.Paginator) error
+	IterateValidators(ctx sdk.Context, callback func(o contract.OperatorResponse) bool) error
+	JailValidator(ctx sdk.Context, nodeOperator sdk.AccAddress, duration time.Duration, forever bool, sender sdk.AccAddress) error
 	Address() (sdk.AccAddress, error)
 }
 
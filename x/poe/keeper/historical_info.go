@@ -1,34 +1,47 @@
 package keeper
 
 import (
-	"strconv"
-
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibccoretypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/oldfurya/furya/x/poe/contract"
 	"github.com/oldfurya/furya/x/poe/types"
 )
 
 var _ ibccoretypes.StakingKeeper = &Keeper{}
 
-// GetHistoricalInfo gets the historical info at a given height
+// maxHistoricalEntriesPrunedPerBlock is the max number of historical info entries and validator set snapshots that
+// are deleted in a block. Remaining entries are pruned in the next blocks.
+const maxHistoricalEntriesPrunedPerBlock = 100
+
+// GetHistoricalInfo gets the historical info at a given height. Entries without validators are completed with the
+// validator set snapshot that was active at the height.
 func (k *Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool) {
 	store := ctx.KVStore(k.storeKey)
-	key := getHistoricalInfoKey(height)
+	key := types.GetHistoricalInfoKey(height)
 
 	value := store.Get(key)
 	if value == nil {
 		return stakingtypes.HistoricalInfo{}, false
 	}
 
-	return stakingtypes.MustUnmarshalHistoricalInfo(k.codec, value), true
+	result := stakingtypes.MustUnmarshalHistoricalInfo(k.codec, value)
+	if len(result.Valset) == 0 {
+		if snapshot, ok := k.historicalValset(ctx, height); ok {
+			result.Valset = snapshot
+		}
+	}
+	return result, true
 }
 
 // SetHistoricalInfo sets the historical info at a given height
 func (k *Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi *stakingtypes.HistoricalInfo) {
 	store := ctx.KVStore(k.storeKey)
-	key := getHistoricalInfoKey(height)
+	key := types.GetHistoricalInfoKey(height)
 	value := k.codec.MustMarshal(hi)
 	store.Set(key, value)
 }
@@ -36,16 +49,14 @@ func (k *Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi *stakingtyp
 // DeleteHistoricalInfo deletes the historical info at a given height
 func (k *Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
 	store := ctx.KVStore(k.storeKey)
-	key := getHistoricalInfoKey(height)
+	key := types.GetHistoricalInfoKey(height)
 
 	store.Delete(key)
 }
 
 // iterateHistoricalInfo provides an interator over all stored HistoricalInfo
-//
-//	objects. For each HistoricalInfo object, cb will be called. If the cb returns
-//
-// true, the iterator will close and stop.
+// objects in ascending height order. For each HistoricalInfo object, cb will be called.
+// If the cb returns true, the iterator will close and stop.
 func (k *Keeper) iterateHistoricalInfo(ctx sdk.Context, cb func(stakingtypes.HistoricalInfo) bool) { //nolint:unused
 	store := ctx.KVStore(k.storeKey)
 
@@ -72,25 +83,29 @@ func (k *Keeper) getAllHistoricalInfo(ctx sdk.Context) []stakingtypes.Historical
 	return infos
 }
 
-// TrackHistoricalInfo saves the latest historical-info and deletes the oldest
-// heights that are below pruning height
+// TrackHistoricalInfo saves the latest historical-info and deletes the oldest heights that are below pruning height.
+// The active validator set is only loaded and stored as a snapshot when it was changed since the last snapshot.
 func (k *Keeper) TrackHistoricalInfo(ctx sdk.Context) {
+	k.trackHistoricalInfo(ctx, k.ValsetContract(ctx))
+}
+
+// SetValidatorSetChanged marks the validator set as updated so that a new snapshot is stored with the next
+// historical info entry
+func (k *Keeper) SetValidatorSetChanged(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Set(types.ValidatorSetChangedKey, []byte{1})
+}
+
+func (k *Keeper) trackHistoricalInfo(ctx sdk.Context, valset ValsetContract) {
 	entryNum := k.HistoricalEntries(ctx)
 
 	// Prune store to ensure we only have parameter-defined historical entries.
 	// In most cases, this will involve removing a single historical entry.
 	// In the rare scenario when the historical entries gets reduced to a lower value k'
-	// from the original value k. k - k' entries must be deleted from the store.
-	// Since the entries to be deleted are always in a continuous range, we can iterate
-	// over the historical entries starting from the most recent version to be pruned
-	// and then return at the first empty entry.
-	for i := ctx.BlockHeight() - int64(entryNum); i >= 0; i-- {
-		_, found := k.GetHistoricalInfo(ctx, i)
-		if found {
-			k.DeleteHistoricalInfo(ctx, i)
-		} else {
-			break
-		}
+	// from the original value k. k - k' entries must be deleted from the store which is done in bounded batches
+	// over the next blocks.
+	// Keys are ordered by height so that all entries up to the pruning height can be iterated.
+	if pruneHeight := ctx.BlockHeight() - int64(entryNum); pruneHeight >= 0 {
+		k.pruneHistoricalInfo(ctx, pruneHeight)
 	}
 
 	// if there is no need to persist historicalInfo, return
@@ -98,15 +113,85 @@ func (k *Keeper) TrackHistoricalInfo(ctx sdk.Context) {
 		return
 	}
 
-	// Create HistoricalInfo struct
-	var valSet stakingtypes.Validators // not used by IBC so we keep it empty
-	historicalEntry := stakingtypes.NewHistoricalInfo(ctx.BlockHeader(), valSet, sdk.DefaultPowerReduction)
-
-	// Set latest HistoricalInfo at current height
+	// the header is required by IBC for every height. The validators are stored as snapshot when changed.
+	historicalEntry := stakingtypes.NewHistoricalInfo(ctx.BlockHeader(), nil, sdk.DefaultPowerReduction)
 	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), &historicalEntry)
+
+	store := ctx.KVStore(k.storeKey)
+	if _, exists := k.historicalValset(ctx, ctx.BlockHeight()); exists && !store.Has(types.ValidatorSetChangedKey) {
+		return
+	}
+	valSet, err := activeValidatorSet(ctx, valset)
+	if err != nil {
+		// the snapshot is retried in the next block
+		ModuleLogger(ctx).Error("failed to load active validator set for historical info", "cause", err)
+		return
+	}
+	// snapshots are stored as historical info without header which sorts the validators by power
+	snapshot := stakingtypes.NewHistoricalInfo(tmproto.Header{}, valSet, sdk.DefaultPowerReduction)
+	store.Set(types.GetHistoricalValsetKey(ctx.BlockHeight()), k.codec.MustMarshal(&snapshot))
+	store.Delete(types.ValidatorSetChangedKey)
 }
 
-// getHistoricalInfoKey returns a key prefix for indexing HistoricalInfo objects.
-func getHistoricalInfoKey(height int64) []byte {
-	return append(types.HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
+// historicalValset returns the latest validator set snapshot taken at or before the given height
+func (k *Keeper) historicalValset(ctx sdk.Context, height int64) (stakingtypes.Validators, bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.HistoricalValsetKeyPrefix)
+	iter := prefixStore.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(height+1)))
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, false
+	}
+	return stakingtypes.MustUnmarshalHistoricalInfo(k.codec, iter.Value()).Valset, true
+}
+
+// pruneHistoricalInfo deletes the entries up to and including the given height and the validator set snapshots that
+// are not active at the remaining heights anymore. At most maxHistoricalEntriesPrunedPerBlock elements are deleted
+// for each.
+func (k *Keeper) pruneHistoricalInfo(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.Uint64ToBigEndian(uint64(height + 1))
+	deleteKeys(prefix.NewStore(store, types.HistoricalInfoKey), end, 0)
+	// the latest snapshot up to the pruning height is still active for the following heights
+	deleteKeys(prefix.NewStore(store, types.HistoricalValsetKeyPrefix), end, 1)
+}
+
+// deleteKeys deletes the keys before the exclusive end key in ascending order but keeps the last n of them. At most
+// maxHistoricalEntriesPrunedPerBlock keys are deleted.
+func deleteKeys(store prefix.Store, end []byte, keep int) {
+	iter := store.Iterator(nil, end)
+	var keys [][]byte
+	for ; iter.Valid() && len(keys) < maxHistoricalEntriesPrunedPerBlock+keep; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	if len(keys) <= keep {
+		return
+	}
+	for _, key := range keys[:len(keys)-keep] {
+		store.Delete(key)
+	}
+}
+
+// activeValidatorSet returns the active validators from the valset contract
+func activeValidatorSet(ctx sdk.Context, valset ValsetContract) (stakingtypes.Validators, error) {
+	var (
+		valSet     stakingtypes.Validators
+		convertErr error
+	)
+	err := valset.IterateActiveValidators(ctx, func(v contract.ValidatorInfo) bool {
+		val, err := v.ToValidator()
+		if err != nil {
+			convertErr = sdkerrors.Wrapf(err, "validator %s", v.Operator)
+			return true
+		}
+		valSet = append(valSet, val)
+		return false
+	}, nil)
+	switch {
+	case err != nil:
+		return nil, sdkerrors.Wrap(err, "active validators")
+	case convertErr != nil:
+		return nil, convertErr
+	}
+	return valSet, nil
 }
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	fuzz "github.com/google/gofuzz"
@@ -11,6 +13,8 @@ import (
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/oldfurya/furya/x/poe/contract"
+	"github.com/oldfurya/furya/x/poe/keeper/poetesting"
 	"github.com/oldfurya/furya/x/poe/types"
 )
 
@@ -65,3 +69,167 @@ func TestTrackHistoricalInfo(t *testing.T) {
 	expEntries = append(expEntries, stakingtypes.NewHistoricalInfo(header, nil, sdk.DefaultPowerReduction))
 	assert.Equal(t, expEntries[1:], keeper.getAllHistoricalInfo(ctx))
 }
+
+func TestTrackHistoricalInfoValidators(t *testing.T) {
+	myPubKey, otherPubKey := ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()
+	myPk, err := contract.NewValidatorPubkey(myPubKey)
+	require.NoError(t, err)
+	otherPk, err := contract.NewValidatorPubkey(otherPubKey)
+	require.NoError(t, err)
+	myOperator, otherOperator := RandomAddress(t).String(), RandomAddress(t).String()
+
+	specs := map[string]struct {
+		validators   []contract.ValidatorInfo
+		iterErr      error
+		expOperators []string
+	}{
+		"sorted by power": {
+			validators: []contract.ValidatorInfo{
+				{Operator: myOperator, ValidatorPubkey: myPk, Power: 1},
+				{Operator: otherOperator, ValidatorPubkey: otherPk, Power: 2},
+			},
+			expOperators: []string{otherOperator, myOperator},
+		},
+		"no active validators": {},
+		"iteration fails": {
+			validators: []contract.ValidatorInfo{{Operator: myOperator, ValidatorPubkey: myPk, Power: 1}},
+			iterErr:    errors.New("testing"),
+		},
+		"invalid pubkey": {
+			validators: []contract.ValidatorInfo{
+				{Operator: myOperator, ValidatorPubkey: myPk, Power: 1},
+				{Operator: otherOperator, Power: 2},
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, example := CreateDefaultTestInput(t)
+			keeper := example.PoEKeeper
+			ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now().UTC())
+			valset := poetesting.ValsetContractMock{
+				IterateActiveValidatorsFn: func(ctx sdk.Context, callback func(c contract.ValidatorInfo) bool, pagination *contract.Paginator) error {
+					for _, v := range spec.validators {
+						if callback(v) {
+							break
+						}
+					}
+					return spec.iterErr
+				},
+			}
+			// when
+			keeper.trackHistoricalInfo(ctx, valset)
+			// then
+			got, exists := keeper.GetHistoricalInfo(ctx, 1)
+			require.True(t, exists)
+			assert.Equal(t, ctx.BlockHeader(), got.Header)
+			gotOperators := make([]string, len(got.Valset))
+			for i, v := range got.Valset {
+				gotOperators[i] = v.OperatorAddress
+				assert.Equal(t, stakingtypes.Bonded, v.Status)
+			}
+			if len(spec.expOperators) == 0 {
+				assert.Empty(t, gotOperators)
+				return
+			}
+			assert.Equal(t, spec.expOperators, gotOperators)
+			gotPubKey, err := got.Valset[0].ConsPubKey()
+			require.NoError(t, err)
+			assert.Equal(t, otherPubKey, gotPubKey)
+			assert.Equal(t, int64(2), got.Valset[0].ConsensusPower(sdk.DefaultPowerReduction))
+		})
+	}
+}
+
+func TestHistoricalInfoPruningOrder(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	keeper := example.PoEKeeper
+	const maxEntries = 3
+	keeper.setParams(ctx, types.Params{HistoricalEntries: maxEntries})
+
+	// heights with a different number of digits to ensure entries are not ordered lexically
+	for _, h := range []int64{8, 9, 10, 11} {
+		header := tmproto.Header{Height: h, Time: time.Now().UTC()}
+		keeper.TrackHistoricalInfo(ctx.WithBlockHeader(header))
+	}
+	var gotHeights []int64
+	for _, v := range keeper.getAllHistoricalInfo(ctx) {
+		gotHeights = append(gotHeights, v.Header.Height)
+	}
+	assert.Equal(t, []int64{9, 10, 11}, gotHeights)
+}
+
+func TestTrackHistoricalInfoValidatorSnapshots(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	keeper := example.PoEKeeper
+	keeper.setParams(ctx, types.Params{HistoricalEntries: 10})
+	myPk, err := contract.NewValidatorPubkey(ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	myOperator, otherOperator := RandomAddress(t).String(), RandomAddress(t).String()
+
+	var queries int
+	activeOperator := myOperator
+	valset := poetesting.ValsetContractMock{
+		IterateActiveValidatorsFn: func(ctx sdk.Context, callback func(c contract.ValidatorInfo) bool, pagination *contract.Paginator) error {
+			queries++
+			callback(contract.ValidatorInfo{Operator: activeOperator, ValidatorPubkey: myPk, Power: 1})
+			return nil
+		},
+	}
+	for h := int64(1); h <= 4; h++ {
+		if h == 3 {
+			activeOperator = otherOperator
+			keeper.SetValidatorSetChanged(ctx)
+		}
+		keeper.trackHistoricalInfo(ctx.WithBlockHeader(tmproto.Header{Height: h, Time: time.Now().UTC()}), valset)
+	}
+	// then only loaded for the first entry and after the change
+	assert.Equal(t, 2, queries)
+	for h, expOperator := range map[int64]string{1: myOperator, 2: myOperator, 3: otherOperator, 4: otherOperator} {
+		got, exists := keeper.GetHistoricalInfo(ctx, h)
+		require.True(t, exists)
+		assert.Equal(t, h, got.Header.Height)
+		require.Len(t, got.Valset, 1)
+		assert.Equal(t, expOperator, got.Valset[0].OperatorAddress, "height %d", h)
+	}
+}
+
+func TestHistoricalInfoPruningBounded(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	keeper := example.PoEKeeper
+	keeper.setParams(ctx, types.Params{HistoricalEntries: 10})
+	valset := poetesting.ValsetContractMock{
+		IterateActiveValidatorsFn: func(ctx sdk.Context, callback func(c contract.ValidatorInfo) bool, pagination *contract.Paginator) error {
+			return nil
+		},
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	for h := int64(1); h <= 250; h++ {
+		entry := stakingtypes.NewHistoricalInfo(tmproto.Header{Height: h}, nil, sdk.DefaultPowerReduction)
+		keeper.SetHistoricalInfo(ctx, h, &entry)
+		store.Set(types.GetHistoricalValsetKey(h), keeper.codec.MustMarshal(&entry))
+	}
+
+	// when
+	keeper.trackHistoricalInfo(ctx.WithBlockHeader(tmproto.Header{Height: 251, Time: time.Now().UTC()}), valset)
+
+	// then at most 100 entries are pruned per block
+	infos := keeper.getAllHistoricalInfo(ctx)
+	require.Len(t, infos, 151)
+	assert.Equal(t, int64(101), infos[0].Header.Height)
+	_, exists := keeper.historicalValset(ctx, 100)
+	assert.False(t, exists)
+
+	// and when all remaining entries are pruned in the next blocks
+	keeper.trackHistoricalInfo(ctx.WithBlockHeader(tmproto.Header{Height: 252, Time: time.Now().UTC()}), valset)
+	keeper.trackHistoricalInfo(ctx.WithBlockHeader(tmproto.Header{Height: 253, Time: time.Now().UTC()}), valset)
+
+	// then the snapshot active at the first remaining height is kept
+	infos = keeper.getAllHistoricalInfo(ctx)
+	require.Len(t, infos, 10)
+	assert.Equal(t, int64(244), infos[0].Header.Height)
+	_, exists = keeper.historicalValset(ctx, 242)
+	assert.False(t, exists)
+	_, exists = keeper.historicalValset(ctx, 243)
+	assert.True(t, exists)
+}
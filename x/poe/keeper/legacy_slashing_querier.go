@@ -2,56 +2,115 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/oldfurya/furya/x/poe/contract"
+	"github.com/oldfurya/furya/x/poe/types"
 )
 
 var _ slashingtypes.QueryServer = &LegacySlashingGRPCQuerier{}
 
+type slashingQuerierKeeper interface {
+	GetValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool)
+	ValidatorSigningInfos(ctx sdk.Context, pagination *query.PageRequest) ([]slashingtypes.ValidatorSigningInfo, *query.PageResponse, error)
+	ValsetContract(ctx sdk.Context) ValsetContract
+	GetParams(ctx sdk.Context) types.Params
+}
+
 type LegacySlashingGRPCQuerier struct {
-	keeper ContractSource
+	keeper slashingQuerierKeeper
 }
 
-func NewLegacySlashingGRPCQuerier(keeper ContractSource) *LegacySlashingGRPCQuerier { //nolint:golint
+func NewLegacySlashingGRPCQuerier(keeper slashingQuerierKeeper) *LegacySlashingGRPCQuerier { //nolint:golint
 	return &LegacySlashingGRPCQuerier{keeper: keeper}
 }
 
-// SigningInfo legacy support for cosmos-sdk signing info. Note that not all field are available on furya
+// SigningInfo legacy support for cosmos-sdk signing info. The jailed until time is taken from the valset contract.
+// Returns NotFound error code when no signing info exists for the given consensus address
 func (g LegacySlashingGRPCQuerier) SigningInfo(c context.Context, req *slashingtypes.QuerySigningInfoRequest) (*slashingtypes.QuerySigningInfoResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
-	valAddr, err := sdk.AccAddressFromBech32(req.ConsAddress)
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "validator address")
-	}
-	return &slashingtypes.QuerySigningInfoResponse{
-		ValSigningInfo: slashingtypes.ValidatorSigningInfo{
-			Address:             valAddr.String(),
-			StartHeight:         0,
-			IndexOffset:         0,
-			Tombstoned:          false,
-			MissedBlocksCounter: 0,
-		},
-	}, nil
+		return nil, status.Error(codes.InvalidArgument, "consensus address")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	info, found := g.keeper.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return nil, status.Error(codes.NotFound, "signing info")
+	}
+	infos := []slashingtypes.ValidatorSigningInfo{info}
+	if err := g.setJailedUntil(ctx, infos); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &slashingtypes.QuerySigningInfoResponse{ValSigningInfo: infos[0]}, nil
 }
 
-// SigningInfos is not supported and will return unimplemented error
+// SigningInfos legacy support for cosmos-sdk signing infos. The jailed until time is taken from the valset contract.
 func (g LegacySlashingGRPCQuerier) SigningInfos(c context.Context, req *slashingtypes.QuerySigningInfosRequest) (*slashingtypes.QuerySigningInfosResponse, error) {
-	logNotImplemented(sdk.UnwrapSDKContext(c), "SigningInfos")
-	return nil, status.Error(codes.Unimplemented, "not available, yet")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	infos, pageRes, err := g.keeper.ValidatorSigningInfos(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := g.setJailedUntil(ctx, infos); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &slashingtypes.QuerySigningInfosResponse{Info: infos, Pagination: pageRes}, nil
+}
+
+// setJailedUntil sets the jailing status from the valset contract. Validators that are jailed forever are returned
+// as tombstoned.
+func (g LegacySlashingGRPCQuerier) setJailedUntil(ctx sdk.Context, infos []slashingtypes.ValidatorSigningInfo) error {
+	if len(infos) == 0 {
+		return nil
+	}
+	positions := make(map[string]int, len(infos))
+	for i, info := range infos {
+		positions[info.Address] = i
+	}
+	return g.keeper.ValsetContract(ctx).IterateValidators(ctx, func(o contract.OperatorResponse) bool {
+		consAddr, err := o.ConsAddress()
+		if err != nil {
+			return false
+		}
+		i, ok := positions[consAddr.String()]
+		if !ok {
+			return false
+		}
+		switch {
+		case o.JailedUntil == nil:
+			infos[i].JailedUntil = time.Unix(0, 0).UTC()
+		case o.JailedUntil.End.Forever:
+			infos[i].JailedUntil = evidencetypes.DoubleSignJailEndTime
+			infos[i].Tombstoned = true
+		default:
+			infos[i].JailedUntil = o.JailedUntil.End.Until
+		}
+		delete(positions, consAddr.String())
+		return len(positions) == 0
+	})
 }
 
-// Params is not supported. Method returns default slashing module params.
+// Params returns the liveness parameters of the poe module. Slashing fractions are not supported and returned as zero.
 func (g LegacySlashingGRPCQuerier) Params(c context.Context, req *slashingtypes.QueryParamsRequest) (*slashingtypes.QueryParamsResponse, error) {
+	params := g.keeper.GetParams(sdk.UnwrapSDKContext(c))
 	return &slashingtypes.QueryParamsResponse{
 		Params: slashingtypes.Params{
-			SignedBlocksWindow:      0,
-			MinSignedPerWindow:      sdk.ZeroDec(),
-			DowntimeJailDuration:    0,
+			SignedBlocksWindow:      params.SignedBlocksWindow,
+			MinSignedPerWindow:      params.MinSignedPerWindow,
+			DowntimeJailDuration:    params.DowntimeJailDuration,
 			SlashFractionDoubleSign: sdk.ZeroDec(),
 			SlashFractionDowntime:   sdk.ZeroDec(),
 		},
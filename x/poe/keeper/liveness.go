@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/oldfurya/furya/x/poe/contract"
+	"github.com/oldfurya/furya/x/poe/types"
+)
+
+// TrackLiveness updates the missed blocks of the validators that were part of the last commit. Validators that
+// signed less than the min ratio of blocks in the window are jailed in the valset contract.
+func (k *Keeper) TrackLiveness(ctx sdk.Context, votes []abcitypes.VoteInfo) {
+	window := k.SignedBlocksWindow(ctx)
+	if window == 0 {
+		return
+	}
+	minSigned := k.MinSignedPerWindow(ctx)
+	for _, v := range votes {
+		consAddr := sdk.ConsAddress(v.Validator.Address)
+		if !k.handleValidatorSignature(ctx, consAddr, v.SignedLastBlock, window, minSigned) {
+			continue
+		}
+		k.jailForDowntime(ctx, consAddr)
+	}
+}
+
+// handleValidatorSignature updates the signing info and missed blocks of the validator. Returns true when the
+// validator missed more blocks in the window than allowed.
+func (k *Keeper) handleValidatorSignature(ctx sdk.Context, consAddr sdk.ConsAddress, signed bool, window int64, minSigned sdk.Dec) bool {
+	height := ctx.BlockHeight()
+	info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		info = slashingtypes.NewValidatorSigningInfo(consAddr, height, 0, time.Unix(0, 0).UTC(), false, 0)
+	}
+	index := info.IndexOffset % window
+	info.IndexOffset++
+
+	previous := k.getValidatorMissedBlock(ctx, consAddr, index)
+	missed := !signed
+	switch {
+	case !previous && missed:
+		k.setValidatorMissedBlock(ctx, consAddr, index, true)
+		info.MissedBlocksCounter++
+	case previous && !missed:
+		k.setValidatorMissedBlock(ctx, consAddr, index, false)
+		info.MissedBlocksCounter--
+	}
+	if missed {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeLiveness,
+			sdk.NewAttribute(types.AttributeKeyConsAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMissedBlocks, strconv.FormatInt(info.MissedBlocksCounter, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+		))
+	}
+	k.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	if minSigned.IsNil() || !minSigned.IsPositive() {
+		return false
+	}
+	maxMissed := window - minSigned.MulInt64(window).RoundInt64()
+	return height > info.StartHeight+window && info.MissedBlocksCounter > maxMissed
+}
+
+// jailForDowntime jails the validator in the valset contract and resets the missed blocks so that the validator
+// has to miss a full window again before it is jailed next. The oversight community gov proposals contract is the
+// admin of the valset contract and is used as sender. When jailing fails, an event is emitted and the missed blocks
+// are kept so that it is retried with the next missed block.
+func (k *Keeper) jailForDowntime(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	logger := ModuleLogger(ctx)
+	duration := k.DowntimeJailDuration(ctx)
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.jailValidator(cacheCtx, k.ValsetContract(ctx), consAddr, duration); err != nil {
+		logger.Error("failed to jail validator for downtime", "cause", err, "address", consAddr.String())
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeDowntimeJailFailed,
+			sdk.NewAttribute(types.AttributeKeyConsAddress, consAddr.String()),
+		))
+		return
+	}
+	commit()
+
+	info, _ := k.GetValidatorSigningInfo(ctx, consAddr)
+	info.StartHeight = ctx.BlockHeight()
+	info.IndexOffset = 0
+	info.MissedBlocksCounter = 0
+	info.JailedUntil = ctx.BlockTime().Add(duration)
+	k.SetValidatorSigningInfo(ctx, consAddr, info)
+	k.clearValidatorMissedBlocks(ctx, consAddr)
+
+	logger.Info("jailed validator for downtime", "address", consAddr.String(), "until", info.JailedUntil)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDowntimeJail,
+		sdk.NewAttribute(types.AttributeKeyConsAddress, consAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyJailedUntil, info.JailedUntil.String()),
+	))
+}
+
+// jailValidator resolves the operator for the consensus address and jails it in the valset contract
+func (k *Keeper) jailValidator(ctx sdk.Context, valset ValsetContract, consAddr sdk.ConsAddress, duration time.Duration) error {
+	sender, err := k.GetPoEContractAddress(ctx, types.PoEContractTypeOversightCommunityGovProposals)
+	if err != nil {
+		return sdkerrors.Wrap(err, "valset admin")
+	}
+	var operator string
+	err = valset.IterateValidators(ctx, func(o contract.OperatorResponse) bool {
+		if addr, err := o.ConsAddress(); err != nil || !addr.Equals(consAddr) {
+			return false
+		}
+		operator = o.Operator
+		return true
+	})
+	switch {
+	case err != nil:
+		return sdkerrors.Wrap(err, "operator lookup")
+	case operator == "":
+		return sdkerrors.Wrap(wasmtypes.ErrNotFound, "operator")
+	}
+	operatorAddr, err := sdk.AccAddressFromBech32(operator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "operator")
+	}
+	return valset.JailValidator(ctx, operatorAddr, duration, false, sender)
+}
+
+// GetValidatorSigningInfo returns the signing info for the consensus address
+func (k *Keeper) GetValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(validatorSigningInfoKey(consAddr))
+	if bz == nil {
+		return slashingtypes.ValidatorSigningInfo{}, false
+	}
+	var info slashingtypes.ValidatorSigningInfo
+	k.codec.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// SetValidatorSigningInfo stores the signing info for the consensus address
+func (k *Keeper) SetValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress, info slashingtypes.ValidatorSigningInfo) {
+	ctx.KVStore(k.storeKey).Set(validatorSigningInfoKey(consAddr), k.codec.MustMarshal(&info))
+}
+
+// IterateValidatorSigningInfos iterates through all stored signing infos.
+// When the callback returns true, the loop is aborted early.
+func (k *Keeper) IterateValidatorSigningInfos(ctx sdk.Context, cb func(consAddr sdk.ConsAddress, info slashingtypes.ValidatorSigningInfo) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSigningInfoKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info slashingtypes.ValidatorSigningInfo
+		k.codec.MustUnmarshal(iter.Value(), &info)
+		// key is length prefixed address
+		if cb(iter.Key()[1:], info) {
+			return
+		}
+	}
+}
+
+// ValidatorSigningInfos returns a page of the stored signing infos
+func (k *Keeper) ValidatorSigningInfos(ctx sdk.Context, pagination *query.PageRequest) ([]slashingtypes.ValidatorSigningInfo, *query.PageResponse, error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSigningInfoKeyPrefix)
+	var infos []slashingtypes.ValidatorSigningInfo
+	pageRes, err := query.Paginate(prefixStore, pagination, func(key []byte, value []byte) error {
+		var info slashingtypes.ValidatorSigningInfo
+		if err := k.codec.Unmarshal(value, &info); err != nil {
+			return err
+		}
+		infos = append(infos, info)
+		return nil
+	})
+	return infos, pageRes, err
+}
+
+func (k *Keeper) getValidatorMissedBlock(ctx sdk.Context, consAddr sdk.ConsAddress, index int64) bool {
+	return ctx.KVStore(k.storeKey).Has(validatorMissedBlockKey(consAddr, index))
+}
+
+func (k *Keeper) setValidatorMissedBlock(ctx sdk.Context, consAddr sdk.ConsAddress, index int64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !missed {
+		store.Delete(validatorMissedBlockKey(consAddr, index))
+		return
+	}
+	store.Set(validatorMissedBlockKey(consAddr, index), []byte{1})
+}
+
+func (k *Keeper) clearValidatorMissedBlocks(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), validatorMissedBlockPrefixKey(consAddr))
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+// validatorSigningInfoKey returns the store key `<prefix><len(consAddr)><consAddr>`
+func validatorSigningInfoKey(consAddr sdk.ConsAddress) []byte {
+	return append(types.ValidatorSigningInfoKeyPrefix, address.MustLengthPrefix(consAddr)...)
+}
+
+// validatorMissedBlockPrefixKey returns the store key prefix `<prefix><len(consAddr)><consAddr>`
+func validatorMissedBlockPrefixKey(consAddr sdk.ConsAddress) []byte {
+	return append(types.ValidatorMissedBlockKeyPrefix, address.MustLengthPrefix(consAddr)...)
+}
+
+// validatorMissedBlockKey returns the store key `<prefix><len(consAddr)><consAddr><index>` with a big endian index
+func validatorMissedBlockKey(consAddr sdk.ConsAddress, index int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(index))
+	return append(validatorMissedBlockPrefixKey(consAddr), bz...)
+}
@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/oldfurya/furya/x/poe/contract"
+	"github.com/oldfurya/furya/x/poe/keeper/poetesting"
+	"github.com/oldfurya/furya/x/poe/types"
+)
+
+func TestHandleValidatorSignature(t *testing.T) {
+	const window = 4
+	specs := map[string]struct {
+		signed    []bool
+		minSigned sdk.Dec
+		expMissed int64
+		expJail   bool
+	}{
+		"all signed": {
+			signed:    []bool{true, true, true, true, true, true},
+			minSigned: sdk.NewDecWithPrec(5, 1),
+		},
+		"missed within window": {
+			signed:    []bool{true, false, false, true},
+			minSigned: sdk.NewDecWithPrec(5, 1),
+			expMissed: 2,
+		},
+		"missed blocks leave window": {
+			signed:    []bool{false, false, true, true, true, true},
+			minSigned: sdk.NewDecWithPrec(5, 1),
+		},
+		"below min signed after window": {
+			signed:    []bool{true, false, false, false, false, false},
+			minSigned: sdk.NewDecWithPrec(5, 1),
+			expMissed: 4,
+			expJail:   true,
+		},
+		"below min signed within first window": {
+			signed:    []bool{false, false, false, false},
+			minSigned: sdk.NewDecWithPrec(5, 1),
+			expMissed: 4,
+		},
+		"jailing disabled": {
+			signed:    []bool{false, false, false, false, false, false},
+			minSigned: sdk.ZeroDec(),
+			expMissed: 4,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, example := CreateDefaultTestInput(t)
+			k := example.PoEKeeper
+			consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+			var gotJail bool
+			for i, signed := range spec.signed {
+				ctx = ctx.WithBlockHeight(int64(i + 1))
+				gotJail = k.handleValidatorSignature(ctx, consAddr, signed, window, spec.minSigned)
+			}
+			assert.Equal(t, spec.expJail, gotJail)
+			info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+			require.True(t, found)
+			assert.Equal(t, spec.expMissed, info.MissedBlocksCounter)
+			assert.Equal(t, int64(len(spec.signed)), info.IndexOffset)
+			assert.Equal(t, int64(1), info.StartHeight)
+		})
+	}
+}
+
+func TestTrackLiveness(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	k := example.PoEKeeper
+	myConsAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherConsAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	votes := []abcitypes.VoteInfo{
+		{Validator: abcitypes.Validator{Address: myConsAddr}, SignedLastBlock: false},
+		{Validator: abcitypes.Validator{Address: otherConsAddr}, SignedLastBlock: true},
+	}
+	// when tracking disabled
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 0
+	k.setParams(ctx, params)
+	k.TrackLiveness(ctx, votes)
+	_, found := k.GetValidatorSigningInfo(ctx, myConsAddr)
+	assert.False(t, found)
+
+	// when enabled
+	k.setParams(ctx, types.DefaultParams())
+	em := sdk.NewEventManager()
+	k.TrackLiveness(ctx.WithEventManager(em), votes)
+	info, found := k.GetValidatorSigningInfo(ctx, myConsAddr)
+	require.True(t, found)
+	assert.Equal(t, int64(1), info.MissedBlocksCounter)
+	info, found = k.GetValidatorSigningInfo(ctx, otherConsAddr)
+	require.True(t, found)
+	assert.Equal(t, int64(0), info.MissedBlocksCounter)
+	require.Len(t, em.Events(), 1)
+	assert.Equal(t, types.EventTypeLiveness, em.Events()[0].Type)
+}
+
+func TestJailForDowntimeFails(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	k := example.PoEKeeper
+	myConsAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	info := slashingtypes.NewValidatorSigningInfo(myConsAddr, 1, 2, time.Unix(0, 0).UTC(), false, 2)
+	k.SetValidatorSigningInfo(ctx, myConsAddr, info)
+	em := sdk.NewEventManager()
+
+	// when no valset admin contract is set
+	k.jailForDowntime(ctx.WithEventManager(em), myConsAddr)
+
+	// then
+	require.Len(t, em.Events(), 1)
+	assert.Equal(t, types.EventTypeDowntimeJailFailed, em.Events()[0].Type)
+	gotInfo, found := k.GetValidatorSigningInfo(ctx, myConsAddr)
+	require.True(t, found)
+	assert.Equal(t, info, gotInfo, "signing info must not be reset")
+}
+
+func TestJailValidator(t *testing.T) {
+	myPubKey := ed25519.GenPrivKey().PubKey()
+	myConsAddr := sdk.ConsAddress(myPubKey.Address())
+	myOperator := RandomAddress(t)
+	myAdmin := RandomAddress(t)
+	otherValidator := contract.OperatorResponse{
+		Operator: RandomAddress(t).String(),
+		Pubkey:   contract.ValidatorPubkey{Ed25519: ed25519.GenPrivKey().PubKey().Bytes()},
+	}
+	myValidator := contract.OperatorResponse{
+		Operator: myOperator.String(),
+		Pubkey:   contract.ValidatorPubkey{Ed25519: myPubKey.Bytes()},
+	}
+	specs := map[string]struct {
+		validators []contract.OperatorResponse
+		iterErr    error
+		jailErr    error
+		expJailed  bool
+		expErr     bool
+	}{
+		"all good": {
+			validators: []contract.OperatorResponse{otherValidator, myValidator},
+			expJailed:  true,
+		},
+		"unknown validator": {
+			validators: []contract.OperatorResponse{otherValidator},
+			expErr:     true,
+		},
+		"iteration fails": {
+			iterErr: errors.New("testing"),
+			expErr:  true,
+		},
+		"jailing fails": {
+			validators: []contract.OperatorResponse{myValidator},
+			jailErr:    errors.New("testing"),
+			expJailed:  true,
+			expErr:     true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, example := CreateDefaultTestInput(t)
+			k := example.PoEKeeper
+			k.SetPoEContractAddress(ctx, types.PoEContractTypeOversightCommunityGovProposals, myAdmin)
+			var jailed bool
+			valset := poetesting.ValsetContractMock{
+				IterateValidatorsFn: func(ctx sdk.Context, callback func(o contract.OperatorResponse) bool) error {
+					for _, v := range spec.validators {
+						if callback(v) {
+							break
+						}
+					}
+					return spec.iterErr
+				},
+				JailValidatorFn: func(ctx sdk.Context, nodeOperator sdk.AccAddress, duration time.Duration, forever bool, sender sdk.AccAddress) error {
+					jailed = true
+					assert.Equal(t, myOperator, nodeOperator)
+					assert.Equal(t, time.Minute, duration)
+					assert.False(t, forever)
+					assert.Equal(t, myAdmin, sender)
+					return spec.jailErr
+				},
+			}
+			// when
+			gotErr := k.jailValidator(ctx, valset, myConsAddr, time.Minute)
+			// then
+			assert.Equal(t, spec.expJailed, jailed)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestSlashingSigningInfos(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	k := example.PoEKeeper
+
+	jailedPubKey, foreverPubKey, activePubKey := ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()
+	jailedUntil := time.Unix(1000, 0).UTC()
+	validators := []contract.OperatorResponse{
+		{
+			Operator:    RandomAddress(t).String(),
+			Pubkey:      contract.ValidatorPubkey{Ed25519: jailedPubKey.Bytes()},
+			JailedUntil: &contract.JailingPeriod{End: contract.JailingEnd{Until: jailedUntil}},
+		},
+		{
+			Operator:    RandomAddress(t).String(),
+			Pubkey:      contract.ValidatorPubkey{Ed25519: foreverPubKey.Bytes()},
+			JailedUntil: &contract.JailingPeriod{End: contract.JailingEnd{Forever: true}},
+		},
+		{
+			Operator: RandomAddress(t).String(),
+			Pubkey:   contract.ValidatorPubkey{Ed25519: activePubKey.Bytes()},
+		},
+	}
+	for _, pk := range []sdk.ConsAddress{
+		sdk.ConsAddress(jailedPubKey.Address()),
+		sdk.ConsAddress(foreverPubKey.Address()),
+		sdk.ConsAddress(activePubKey.Address()),
+	} {
+		k.SetValidatorSigningInfo(ctx, pk, slashingtypes.NewValidatorSigningInfo(pk, 1, 2, time.Unix(0, 0).UTC(), false, 1))
+	}
+	q := NewLegacySlashingGRPCQuerier(valsetOverride{
+		Keeper: k,
+		valset: poetesting.ValsetContractMock{
+			IterateValidatorsFn: func(ctx sdk.Context, callback func(o contract.OperatorResponse) bool) error {
+				for _, v := range validators {
+					if callback(v) {
+						break
+					}
+				}
+				return nil
+			},
+		},
+	})
+	expInfos := map[string]slashingtypes.ValidatorSigningInfo{
+		sdk.ConsAddress(jailedPubKey.Address()).String():  slashingtypes.NewValidatorSigningInfo(jailedPubKey.Address().Bytes(), 1, 2, jailedUntil, false, 1),
+		sdk.ConsAddress(foreverPubKey.Address()).String(): slashingtypes.NewValidatorSigningInfo(foreverPubKey.Address().Bytes(), 1, 2, evidencetypes.DoubleSignJailEndTime, true, 1),
+		sdk.ConsAddress(activePubKey.Address()).String():  slashingtypes.NewValidatorSigningInfo(activePubKey.Address().Bytes(), 1, 2, time.Unix(0, 0).UTC(), false, 1),
+	}
+
+	t.Run("all", func(t *testing.T) {
+		gotRsp, gotErr := q.SigningInfos(sdk.WrapSDKContext(ctx), &slashingtypes.QuerySigningInfosRequest{})
+		require.NoError(t, gotErr)
+		require.Len(t, gotRsp.Info, 3)
+		for _, info := range gotRsp.Info {
+			assert.Equal(t, expInfos[info.Address], info)
+		}
+	})
+	t.Run("paginated", func(t *testing.T) {
+		gotRsp, gotErr := q.SigningInfos(sdk.WrapSDKContext(ctx), &slashingtypes.QuerySigningInfosRequest{Pagination: &query.PageRequest{Limit: 2}})
+		require.NoError(t, gotErr)
+		assert.Len(t, gotRsp.Info, 2)
+		assert.NotEmpty(t, gotRsp.Pagination.NextKey)
+	})
+	for addr, exp := range expInfos {
+		t.Run("single "+addr, func(t *testing.T) {
+			gotRsp, gotErr := q.SigningInfo(sdk.WrapSDKContext(ctx), &slashingtypes.QuerySigningInfoRequest{ConsAddress: addr})
+			require.NoError(t, gotErr)
+			assert.Equal(t, exp, gotRsp.ValSigningInfo)
+		})
+	}
+	t.Run("unknown", func(t *testing.T) {
+		unknownAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+		_, gotErr := q.SigningInfo(sdk.WrapSDKContext(ctx), &slashingtypes.QuerySigningInfoRequest{ConsAddress: unknownAddr.String()})
+		assert.Equal(t, codes.NotFound, status.Code(gotErr))
+	})
+	t.Run("invalid address", func(t *testing.T) {
+		_, gotErr := q.SigningInfo(sdk.WrapSDKContext(ctx), &slashingtypes.QuerySigningInfoRequest{ConsAddress: "invalid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(gotErr))
+	})
+}
+
+type valsetOverride struct {
+	*Keeper
+	valset ValsetContract
+}
+
+func (v valsetOverride) ValsetContract(sdk.Context) ValsetContract {
+	return v.valset
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	v2 "github.com/oldfurya/furya/x/poe/migrations/v2"
+	"github.com/oldfurya/furya/x/poe/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	valset, err := m.keeper.GetPoEContractAddress(ctx, types.PoEContractTypeValset)
+	if err != nil && !wasmtypes.ErrNotFound.Is(err) {
+		return sdkerrors.Wrap(err, "valset contract address")
+	}
+	if err := v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.twasmKeeper, valset); err != nil {
+		return err
+	}
+	v2.MigrateParams(ctx, m.keeper.paramStore)
+	return nil
+}
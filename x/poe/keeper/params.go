@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/oldfurya/furya/x/poe/types"
@@ -24,13 +26,37 @@ func (k *Keeper) MinimumDelegationAmounts(ctx sdk.Context) (res sdk.Coins) {
 	return
 }
 
+// SignedBlocksWindow number of blocks for missed block tracking. The liveness params are set by the v2 store
+// migration on chains that were started before they were introduced. Returns zero when not set.
+func (k *Keeper) SignedBlocksWindow(ctx sdk.Context) (res int64) {
+	k.paramStore.GetIfExists(ctx, types.KeySignedBlocksWindow, &res)
+	return
+}
+
+// MinSignedPerWindow min ratio of blocks in the window that a validator has to sign. Returns zero when not set.
+func (k *Keeper) MinSignedPerWindow(ctx sdk.Context) sdk.Dec {
+	res := sdk.ZeroDec()
+	k.paramStore.GetIfExists(ctx, types.KeyMinSignedPerWindow, &res)
+	return res
+}
+
+// DowntimeJailDuration duration a validator is jailed for downtime
+func (k *Keeper) DowntimeJailDuration(ctx sdk.Context) time.Duration {
+	res := types.DefaultDowntimeJailDuration
+	k.paramStore.GetIfExists(ctx, types.KeyDowntimeJailDuration, &res)
+	return res
+}
+
 // GetParams returns all parameters as types.Params
 func (k *Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(
-		k.HistoricalEntries(ctx),
-		k.GetInitialValidatorEngagementPoints(ctx),
-		k.MinimumDelegationAmounts(ctx),
-	)
+	return types.Params{
+		HistoricalEntries:          k.HistoricalEntries(ctx),
+		InitialValEngagementPoints: k.GetInitialValidatorEngagementPoints(ctx),
+		MinDelegationAmounts:       k.MinimumDelegationAmounts(ctx),
+		SignedBlocksWindow:         k.SignedBlocksWindow(ctx),
+		MinSignedPerWindow:         k.MinSignedPerWindow(ctx),
+		DowntimeJailDuration:       k.DowntimeJailDuration(ctx),
+	}
 }
 
 // SetParams set the params
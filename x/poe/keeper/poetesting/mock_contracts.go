@@ -39,6 +39,8 @@ type ValsetContractMock struct {
 	ListValidatorSlashingFn   func(ctx sdk.Context, opAddr sdk.AccAddress) ([]contract.ValidatorSlashing, error)
 	UpdateAdminFn             func(ctx sdk.Context, new sdk.AccAddress, sender sdk.AccAddress) error
 	IterateActiveValidatorsFn func(ctx sdk.Context, callback func(c contract.ValidatorInfo) bool, pagination *contract.Paginator) error
+	IterateValidatorsFn       func(ctx sdk.Context, callback func(o contract.OperatorResponse) bool) error
+	JailValidatorFn           func(ctx sdk.Context, nodeOperator sdk.AccAddress, duration time.Duration, forever bool, sender sdk.AccAddress) error
 	AddressFn                 func() (sdk.AccAddress, error)
 }
 
@@ -84,6 +86,20 @@ func (m ValsetContractMock) ListValidatorSlashing(ctx sdk.Context, opAddr sdk.Ac
 	return m.ListValidatorSlashingFn(ctx, opAddr)
 }
 
+func (m ValsetContractMock) IterateValidators(ctx sdk.Context, callback func(o contract.OperatorResponse) bool) error {
+	if m.IterateValidatorsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.IterateValidatorsFn(ctx, callback)
+}
+
+func (m ValsetContractMock) JailValidator(ctx sdk.Context, nodeOperator sdk.AccAddress, duration time.Duration, forever bool, sender sdk.AccAddress) error {
+	if m.JailValidatorFn == nil {
+		panic("not expected to be called")
+	}
+	return m.JailValidatorFn(ctx, nodeOperator, duration, forever, sender)
+}
+
 func (m ValsetContractMock) Address() (sdk.AccAddress, error) {
 	if m.AddressFn == nil {
 		panic("not expected to be called")
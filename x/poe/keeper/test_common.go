@@ -281,7 +281,7 @@ func createTestInput(
 			}),
 			nested,
 			// append our custom message handler
-			twasmkeeper.NewPetriHandler(appCodec, &twasmKeeper, bankKeeper, consensusParamsUpdater, govRouter),
+			twasmkeeper.NewPetriHandler(appCodec, &twasmKeeper, bankKeeper, consensusParamsUpdater, govRouter, nil),
 		)
 	})
 
@@ -307,9 +307,10 @@ func createTestInput(
 		supportedFeatures,
 		opts...,
 	)
+	twasmKeeper.SetConsensusParamsUpdater(consensusParamsUpdater)
 	twasmKeeper.SetParams(ctx, twasmtypes.DefaultParams())
 
-	twasm.NewAppModule(appCodec, &twasmKeeper, poestakingadapter.StakingAdapter{}, accountKeeper, bankKeeper).RegisterServices(configurator)
+	twasm.NewAppModule(appCodec, &twasmKeeper, poestakingadapter.StakingAdapter{}, accountKeeper, bankKeeper, nil).RegisterServices(configurator)
 	govRouter.AddRoute(twasm.RouterKey, twasmkeeper.NewProposalHandler(twasmKeeper))
 
 	faucet := wasmkeeper.NewTestFaucet(t, ctx, bankKeeper, types.ModuleName, sdk.NewCoin("ufury", sdk.NewInt(100_000_000_000)))
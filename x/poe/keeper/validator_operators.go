@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	"github.com/oldfurya/furya/x/poe/types"
+)
+
+// GetValidatorOperator returns the operator address that was stored for the tendermint pubkey
+func (k *Keeper) GetValidatorOperator(ctx sdk.Context, pubKey crypto.PublicKey) (string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetValidatorOperatorKey(pubKey))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetValidatorOperator stores the operator address for the tendermint pubkey
+func (k *Keeper) SetValidatorOperator(ctx sdk.Context, pubKey crypto.PublicKey, operator string) {
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorOperatorKey(pubKey), []byte(operator))
+}
+
+// DeleteValidatorOperator removes the operator address for the tendermint pubkey
+func (k *Keeper) DeleteValidatorOperator(ctx sdk.Context, pubKey crypto.PublicKey) {
+	ctx.KVStore(k.storeKey).Delete(types.GetValidatorOperatorKey(pubKey))
+}
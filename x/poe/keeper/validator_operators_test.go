@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+func TestSetGetDeleteValidatorOperator(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	k := example.PoEKeeper
+	myPubKey := crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: ed25519.GenPrivKey().PubKey().Bytes()}}
+	otherPubKey := crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: ed25519.GenPrivKey().PubKey().Bytes()}}
+	myOperator := RandomAddress(t).String()
+
+	// when not set
+	_, found := k.GetValidatorOperator(ctx, myPubKey)
+	assert.False(t, found)
+
+	// when set
+	k.SetValidatorOperator(ctx, myPubKey, myOperator)
+	gotOperator, found := k.GetValidatorOperator(ctx, myPubKey)
+	assert.True(t, found)
+	assert.Equal(t, myOperator, gotOperator)
+	_, found = k.GetValidatorOperator(ctx, otherPubKey)
+	assert.False(t, found)
+
+	// when deleted
+	k.DeleteValidatorOperator(ctx, myPubKey)
+	_, found = k.GetValidatorOperator(ctx, myPubKey)
+	assert.False(t, found)
+}
@@ -0,0 +1,90 @@
+package v2
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/oldfurya/furya/x/poe/contract"
+	"github.com/oldfurya/furya/x/poe/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2:
+// - the historical info keys are migrated from decimal string heights to big endian encoded heights
+// - the validator operator index is filled with the active set of the valset contract, when set
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, querier types.SmartQuerier, valset sdk.AccAddress) error {
+	store := ctx.KVStore(storeKey)
+	if err := migrateHistoricalInfoKeys(store); err != nil {
+		return err
+	}
+	if valset.Empty() {
+		return nil
+	}
+	return indexValidatorOperators(ctx, store, querier, valset)
+}
+
+// indexValidatorOperators stores the operator addresses of the active validators by tendermint pubkey so that
+// validators that were active before the upgrade can be resolved when they are removed
+func indexValidatorOperators(ctx sdk.Context, store sdk.KVStore, querier types.SmartQuerier, valset sdk.AccAddress) error {
+	vals, err := contract.QueryActiveValidators(ctx, querier, valset)
+	if err != nil {
+		return sdkerrors.Wrap(err, "active validators")
+	}
+	for _, v := range vals {
+		pk, err := contract.ConvertToTendermintPubKey(v.ValidatorPubkey)
+		if err != nil {
+			continue // not a valid consensus key
+		}
+		store.Set(types.GetValidatorOperatorKey(pk), []byte(v.Operator))
+	}
+	return nil
+}
+
+// MigrateParams sets the liveness params that were introduced with v2 to their defaults when not set. Downtime
+// jailing stays disabled via a zero MinSignedPerWindow until changed by governance.
+func MigrateParams(ctx sdk.Context, paramStore paramtypes.Subspace) {
+	defaults := types.DefaultParams()
+	for _, p := range []struct {
+		key   []byte
+		value interface{}
+	}{
+		{key: types.KeySignedBlocksWindow, value: defaults.SignedBlocksWindow},
+		{key: types.KeyMinSignedPerWindow, value: defaults.MinSignedPerWindow},
+		{key: types.KeyDowntimeJailDuration, value: defaults.DowntimeJailDuration},
+	} {
+		if !paramStore.Has(ctx, p.key) {
+			paramStore.Set(ctx, p.key, p.value)
+		}
+	}
+}
+
+func migrateHistoricalInfoKeys(store sdk.KVStore) error {
+	prefixStore := prefix.NewStore(store, types.HistoricalInfoKey)
+	type entry struct {
+		oldKey []byte
+		height int64
+		value  []byte
+	}
+	var entries []entry
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		height, err := strconv.ParseInt(string(iter.Key()), 10, 64)
+		if err != nil {
+			iter.Close()
+			return sdkerrors.Wrapf(types.ErrInvalidHistoricalInfo, "height key %X", iter.Key())
+		}
+		entries = append(entries, entry{oldKey: iter.Key(), height: height, value: iter.Value()})
+	}
+	iter.Close()
+	// delete all old keys first so that no new key can be overwritten
+	for _, e := range entries {
+		prefixStore.Delete(e.oldKey)
+	}
+	for _, e := range entries {
+		store.Set(types.GetHistoricalInfoKey(e.height), e.value)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	"github.com/oldfurya/furya/x/poe/contract"
+	v2 "github.com/oldfurya/furya/x/poe/migrations/v2"
+	"github.com/oldfurya/furya/x/poe/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	specs := map[string]struct {
+		heights []int64
+		rawKeys [][]byte
+		expErr  bool
+	}{
+		"all good": {
+			heights: []int64{9, 10, 11, 12345678},
+		},
+		"empty store": {},
+		"invalid key": {
+			heights: []int64{1},
+			rawKeys: [][]byte{[]byte("invalid")},
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			storeKey := sdk.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+			store := ctx.KVStore(storeKey)
+			otherKey := append(append([]byte{}, types.ContractPrefix...), []byte("other")...)
+			store.Set(otherKey, []byte("other"))
+			for _, h := range spec.heights {
+				legacyKey := append(append([]byte{}, types.HistoricalInfoKey...), []byte(strconv.FormatInt(h, 10))...)
+				store.Set(legacyKey, []byte(strconv.FormatInt(h, 10)))
+			}
+			for _, k := range spec.rawKeys {
+				store.Set(append(append([]byte{}, types.HistoricalInfoKey...), k...), []byte("invalid"))
+			}
+
+			// when
+			gotErr := v2.MigrateStore(ctx, storeKey, nil, nil)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotValues []string
+			iter := sdk.KVStorePrefixIterator(store, types.HistoricalInfoKey)
+			defer iter.Close()
+			for ; iter.Valid(); iter.Next() {
+				gotValues = append(gotValues, string(iter.Value()))
+			}
+			var expValues []string
+			for _, h := range spec.heights {
+				expValues = append(expValues, strconv.FormatInt(h, 10))
+				assert.Equal(t, []byte(strconv.FormatInt(h, 10)), store.Get(types.GetHistoricalInfoKey(h)))
+			}
+			assert.Equal(t, expValues, gotValues)
+			assert.Equal(t, []byte("other"), store.Get(otherKey))
+		})
+	}
+}
+
+func TestMigrateStoreIndexesValidatorOperators(t *testing.T) {
+	valsetAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	myOperator := sdk.AccAddress(rand.Bytes(address.Len)).String()
+	myPubKey := crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: []byte("my key")}}
+	specs := map[string]struct {
+		queryErr error
+		expErr   bool
+	}{
+		"all good": {},
+		"query fails": {
+			queryErr: errors.New("testing"),
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			storeKey := sdk.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+			querier := smartQuerierFn(func(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+				require.Equal(t, valsetAddr, contractAddr)
+				if spec.queryErr != nil {
+					return nil, spec.queryErr
+				}
+				var q contract.ValsetQuery
+				require.NoError(t, json.Unmarshal(req, &q))
+				require.NotNil(t, q.ListActiveValidators)
+				if q.ListActiveValidators.StartAfter != "" {
+					return []byte(`{"validators":[]}`), nil
+				}
+				return json.Marshal(contract.ListActiveValidatorsResponse{Validators: []contract.ValidatorInfo{
+					{Operator: myOperator, ValidatorPubkey: contract.ValidatorPubkey{Ed25519: []byte("my key")}, Power: 1},
+				}})
+			})
+
+			// when
+			gotErr := v2.MigrateStore(ctx, storeKey, querier, valsetAddr)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, []byte(myOperator), ctx.KVStore(storeKey).Get(types.GetValidatorOperatorKey(myPubKey)))
+		})
+	}
+}
+
+type smartQuerierFn func(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+
+func (f smartQuerierFn) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	return f(ctx, contractAddr, req)
+}
+
+func TestMigrateParams(t *testing.T) {
+	specs := map[string]struct {
+		setup     func(ctx sdk.Context, s paramtypes.Subspace)
+		expWindow int64
+	}{
+		"defaults set": {
+			expWindow: types.DefaultSignedBlocksWindow,
+		},
+		"existing value kept": {
+			setup: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.Set(ctx, types.KeySignedBlocksWindow, int64(7))
+			},
+			expWindow: 7,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			storeKey, tStoreKey := sdk.NewKVStoreKey(paramtypes.StoreKey), sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+			ctx := testutil.DefaultContext(storeKey, tStoreKey)
+			subspace := paramtypes.NewSubspace(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName).
+				WithKeyTable(types.ParamKeyTable())
+			if spec.setup != nil {
+				spec.setup(ctx, subspace)
+			}
+
+			// when
+			v2.MigrateParams(ctx, subspace)
+
+			// then
+			var gotWindow int64
+			subspace.Get(ctx, types.KeySignedBlocksWindow, &gotWindow)
+			assert.Equal(t, spec.expWindow, gotWindow)
+			var gotMinSigned sdk.Dec
+			subspace.Get(ctx, types.KeyMinSignedPerWindow, &gotMinSigned)
+			assert.True(t, gotMinSigned.IsZero())
+			var gotDuration time.Duration
+			subspace.Get(ctx, types.KeyDowntimeJailDuration, &gotDuration)
+			assert.Equal(t, types.DefaultDowntimeJailDuration, gotDuration)
+		})
+	}
+}
@@ -159,6 +159,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	stakingtypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewLegacyStakingGRPCQuerier(am.poeKeeper))
 	slashingtypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewLegacySlashingGRPCQuerier(am.poeKeeper))
 	distributiontypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewLegacyDistributionGRPCQuerier(am.poeKeeper))
+
+	m := keeper.NewMigrator(am.poeKeeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/poe from version 1 to 2: %v", err))
+	}
 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, block abci.RequestBeginBlock) {
@@ -167,7 +172,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, block abci.RequestBeginBlock) {
 
 func (am AppModule) EndBlock(ctx sdk.Context, block abci.RequestEndBlock) []abci.ValidatorUpdate {
 	ClearEmbeddedContracts() // release memory
-	return EndBlocker(ctx, am.twasmKeeper)
+	return EndBlocker(ctx, am.twasmKeeper, am.poeKeeper)
 }
 
 // InitGenesis performs genesis initialization for the genutil module. It returns
@@ -244,7 +249,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
 func (am AppModule) ConsensusVersion() uint64 {
-	return 1
+	return 2
 }
 
 // GenerateGenesisState creates a randomized GenState of the PoE module.
@@ -6,9 +6,18 @@ const (
 	EventTypeUpdateValidator = "update_validator"
 	EventTypeDelegate        = "delegate"
 	EventTypeUndelegate      = "undelegate"
+	EventTypeLiveness        = "liveness"
+	EventTypeDowntimeJail    = "downtime_jail"
+	// EventTypeDowntimeJailFailed is emitted when a validator could not be jailed for downtime
+	EventTypeDowntimeJailFailed = "downtime_jail_failed"
 
 	AttributeKeyValOperator = "operator"
 	AttributeKeyMoniker     = "moniker"
 	AttributeKeyPubKeyHex   = "pubkey"
 	AttributeValueCategory  = ModuleName
+
+	AttributeKeyConsAddress  = "address"
+	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyHeight       = "height"
+	AttributeKeyJailedUntil  = "jailed_until"
 )
@@ -24,6 +24,31 @@ func TestValidateGenesis(t *testing.T) {
 		"all good": {
 			source: GenesisStateFixture(),
 		},
+		"negative signed blocks window": {
+			source: GenesisStateFixture(func(m *GenesisState) {
+				m.Params.SignedBlocksWindow = -1
+			}),
+			expErr: true,
+		},
+		"min signed per window > 1": {
+			source: GenesisStateFixture(func(m *GenesisState) {
+				m.Params.MinSignedPerWindow = sdk.NewDecWithPrec(11, 1)
+			}),
+			expErr: true,
+		},
+		"downtime jail duration not in seconds": {
+			source: GenesisStateFixture(func(m *GenesisState) {
+				m.Params.DowntimeJailDuration = time.Second + time.Millisecond
+			}),
+			expErr: true,
+		},
+		"downtime jailing without duration": {
+			source: GenesisStateFixture(func(m *GenesisState) {
+				m.Params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+				m.Params.DowntimeJailDuration = 0
+			}),
+			expErr: true,
+		},
 		"seed with empty engagement group": {
 			source: GenesisStateFixture(func(m *GenesisState) {
 				m.GetSeedContracts().Engagement = []TG4Member{}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
 const (
 	// ModuleName is the name of the gentx module
 	ModuleName = "poe"
@@ -21,4 +26,35 @@ const (
 var (
 	ContractPrefix    = []byte{0x01}
 	HistoricalInfoKey = []byte{0x02}
+	// ValidatorSigningInfoKeyPrefix prefix for signing info by consensus address
+	ValidatorSigningInfoKeyPrefix = []byte{0x03}
+	// ValidatorMissedBlockKeyPrefix prefix for missed blocks by consensus address and window index
+	ValidatorMissedBlockKeyPrefix = []byte{0x04}
+	// ValidatorOperatorKeyPrefix prefix for validator operator addresses by tendermint pubkey
+	ValidatorOperatorKeyPrefix = []byte{0x05}
+	// HistoricalValsetKeyPrefix prefix for the active validator set snapshots by the height they were taken at
+	HistoricalValsetKeyPrefix = []byte{0x06}
+	// ValidatorSetChangedKey marks that the validator set was updated since the last snapshot
+	ValidatorSetChangedKey = []byte{0x07}
 )
+
+// GetHistoricalInfoKey returns the store key `<prefix><height>` with a big endian height so that entries are
+// ordered by height
+func GetHistoricalInfoKey(height int64) []byte {
+	return append(HistoricalInfoKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetHistoricalValsetKey returns the store key `<prefix><height>` with a big endian height so that snapshots are
+// ordered by height
+func GetHistoricalValsetKey(height int64) []byte {
+	return append(HistoricalValsetKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetValidatorOperatorKey returns the store key `<prefix><pubkey>` with the proto encoded tendermint pubkey
+func GetValidatorOperatorKey(pubKey crypto.PublicKey) []byte {
+	bz, err := pubKey.Marshal()
+	if err != nil {
+		panic(err) // can not happen for proto types
+	}
+	return append(ValidatorOperatorKeyPrefix, bz...)
+}
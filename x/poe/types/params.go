@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,12 +19,20 @@ const (
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries                uint32 = 10000
 	DefaultInitialValidatorEngagementPoints uint64 = 1
+	// DefaultSignedBlocksWindow is the number of blocks for missed block tracking
+	DefaultSignedBlocksWindow int64 = 100
+	// DefaultDowntimeJailDuration is the jail duration for downtime. Jailing is disabled by default
+	// via MinSignedPerWindow
+	DefaultDowntimeJailDuration = 10 * time.Minute
 )
 
 var (
 	KeyHistoricalEntries          = []byte("HistoricalEntries")
 	KeyInitialValEngagementPoints = []byte("InitialValidatorEngagementPoints")
 	KeyMinDelegationAmounts       = []byte("MinDelegationAmounts")
+	KeySignedBlocksWindow         = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow         = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration       = []byte("DowntimeJailDuration")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -33,12 +42,15 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-// NewParams creates a new Params instance
+// NewParams creates a new Params instance with default liveness parameters
 func NewParams(historicalEntries uint32, engagementPoints uint64, min sdk.Coins) Params {
 	return Params{
 		HistoricalEntries:          historicalEntries,
 		InitialValEngagementPoints: engagementPoints,
 		MinDelegationAmounts:       min,
+		SignedBlocksWindow:         DefaultSignedBlocksWindow,
+		MinSignedPerWindow:         sdk.ZeroDec(),
+		DowntimeJailDuration:       DefaultDowntimeJailDuration,
 	}
 }
 
@@ -48,6 +60,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateUint32),
 		paramtypes.NewParamSetPair(KeyInitialValEngagementPoints, &p.InitialValEngagementPoints, validateUint64),
 		paramtypes.NewParamSetPair(KeyMinDelegationAmounts, &p.MinDelegationAmounts, validateSDKCoins),
+		paramtypes.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateSignedBlocksWindow),
+		paramtypes.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow, validateMinSignedPerWindow),
+		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 	}
 }
 
@@ -71,7 +86,27 @@ func (p Params) String() string {
 
 // Validate validate a set of params
 func (p Params) Validate() error {
-	return sdkerrors.Wrap(p.MinDelegationAmounts.Validate(), "min delegation amounts")
+	if err := p.MinDelegationAmounts.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "min delegation amounts")
+	}
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return sdkerrors.Wrap(err, "signed blocks window")
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return sdkerrors.Wrap(err, "min signed per window")
+	}
+	if err := validateDowntimeJailDuration(p.DowntimeJailDuration); err != nil {
+		return sdkerrors.Wrap(err, "downtime jail duration")
+	}
+	if p.DowntimeJailingEnabled() && p.DowntimeJailDuration == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "downtime jail duration")
+	}
+	return nil
+}
+
+// DowntimeJailingEnabled returns true when validators are jailed for missing blocks
+func (p Params) DowntimeJailingEnabled() bool {
+	return p.SignedBlocksWindow > 0 && !p.MinSignedPerWindow.IsNil() && p.MinSignedPerWindow.IsPositive()
 }
 
 func validateUint64(i interface{}) error {
@@ -97,3 +132,39 @@ func validateSDKCoins(i interface{}) error {
 	}
 	return c.Validate()
 }
+
+func validateSignedBlocksWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("must not be negative: %d", v)
+	}
+	return nil
+}
+
+func validateMinSignedPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() { // not set
+		return nil
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 || v.Truncate(time.Second) != v {
+		return fmt.Errorf("must be a non negative number of seconds: %s", v)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "github.com/tendermint/tendermint/proto/tendermint/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
@@ -27,6 +29,7 @@ var _ = proto.Marshal
 var (
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -102,6 +105,15 @@ type Params struct {
 	// needs to self delegate to receive any engagement points. One must be
 	// exceeded. No minimum condition set when empty.
 	MinDelegationAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_delegation_amounts,json=minDelegationAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_delegation_amounts" yaml:"min_delegation_amounts"`
+	// SignedBlocksWindow is the number of blocks in the sliding window for
+	// missed block tracking. Tracking is disabled when zero.
+	SignedBlocksWindow int64 `protobuf:"varint,4,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
+	// MinSignedPerWindow is the min ratio of blocks in the window that a
+	// validator has to sign. Validators are not jailed for downtime when zero.
+	MinSignedPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	// DowntimeJailDuration is the duration a validator is jailed for when it
+	// signed less than the min ratio of blocks in the window.
+	DowntimeJailDuration time.Duration `protobuf:"bytes,6,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -162,6 +174,20 @@ func (m *Params) GetMinDelegationAmounts() github_com_cosmos_cosmos_sdk_types.Co
 	return nil
 }
 
+func (m *Params) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.DowntimeJailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("confio.poe.v1beta1.PoEContractType", PoEContractType_name, PoEContractType_value)
 	proto.RegisterType((*Params)(nil), "confio.poe.v1beta1.Params")
@@ -170,58 +196,67 @@ func init() {
 func init() { proto.RegisterFile("confio/poe/v1beta1/poe.proto", fileDescriptor_df6d9ea68813554a) }
 
 var fileDescriptor_df6d9ea68813554a = []byte{
-	// 806 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
-	0x1c, 0x4d, 0xfa, 0x6f, 0x77, 0x67, 0x17, 0x30, 0xde, 0xb2, 0x6a, 0x86, 0xc6, 0x1e, 0x42, 0x57,
-	0x44, 0xa0, 0x26, 0x14, 0x38, 0xa0, 0x95, 0x58, 0xc9, 0x69, 0x4c, 0xb0, 0x48, 0xe2, 0xe0, 0xb8,
-	0x11, 0x70, 0xb1, 0x26, 0xc9, 0xd4, 0x1d, 0x35, 0x9e, 0x89, 0x3c, 0x93, 0x6a, 0xf3, 0x0d, 0x90,
-	0x4f, 0x88, 0x13, 0x17, 0x4b, 0x2b, 0xb8, 0xf1, 0x49, 0xf6, 0xb8, 0x47, 0x4e, 0x05, 0xb5, 0x17,
-	0xce, 0xfd, 0x04, 0xc8, 0x7f, 0xd2, 0x2e, 0xde, 0x02, 0x17, 0x7b, 0x7e, 0xf3, 0xde, 0xef, 0xbd,
-	0xd1, 0xfb, 0x8d, 0x06, 0xec, 0x4e, 0x38, 0x3b, 0xa6, 0xbc, 0x39, 0xe7, 0xa4, 0x79, 0x76, 0x30,
-	0x26, 0x12, 0x1f, 0x24, 0xeb, 0xc6, 0x3c, 0xe4, 0x92, 0xab, 0x6a, 0x86, 0x36, 0x92, 0x9d, 0x1c,
-	0x85, 0xdb, 0x3e, 0xf7, 0x79, 0x0a, 0x37, 0x93, 0x55, 0xc6, 0x84, 0x15, 0x9f, 0x73, 0x7f, 0x46,
-	0x9a, 0x69, 0x35, 0x5e, 0x1c, 0x37, 0x31, 0x5b, 0xe6, 0x90, 0x56, 0x84, 0xa6, 0x8b, 0x10, 0x4b,
-	0xca, 0x59, 0x8e, 0xeb, 0x45, 0x5c, 0xd2, 0x80, 0x08, 0x89, 0x83, 0xf9, 0x4a, 0x7b, 0xc2, 0x45,
-	0xc0, 0x85, 0x97, 0x99, 0x66, 0xc5, 0x4a, 0x3b, 0xab, 0x9a, 0x63, 0x2c, 0x6e, 0xce, 0x3f, 0xe1,
-	0x74, 0xa5, 0xbd, 0x97, 0xe3, 0x42, 0xe2, 0x53, 0xca, 0xfc, 0x6b, 0x4a, 0x5e, 0xe7, 0xac, 0x5d,
-	0x49, 0xd8, 0x94, 0x84, 0x01, 0x65, 0xb2, 0x29, 0x97, 0x73, 0x22, 0xb2, 0x6f, 0x86, 0xd6, 0xae,
-	0xd6, 0xc0, 0xd6, 0x00, 0x87, 0x38, 0x10, 0x6a, 0x17, 0xa8, 0x27, 0x54, 0x48, 0x1e, 0xd2, 0x09,
-	0x9e, 0x79, 0x84, 0xc9, 0x90, 0x12, 0xb1, 0x53, 0x46, 0xe5, 0xfa, 0x1b, 0xad, 0xea, 0xd5, 0xb9,
-	0x5e, 0x59, 0xe2, 0x60, 0xf6, 0xa4, 0xf6, 0x3a, 0xa7, 0xe6, 0xbc, 0x7d, 0xb3, 0x69, 0x66, 0x7b,
-	0xea, 0x29, 0xa8, 0x52, 0x46, 0x25, 0xc5, 0x33, 0xef, 0x2c, 0xa5, 0xfa, 0xd8, 0x27, 0x01, 0x61,
-	0xd2, 0x9b, 0x73, 0xca, 0xa4, 0xd8, 0x59, 0x43, 0xe5, 0xfa, 0x46, 0xab, 0x7e, 0x75, 0xae, 0xef,
-	0x65, 0xc2, 0xff, 0x49, 0xaf, 0x39, 0x30, 0xc7, 0x47, 0x89, 0xc7, 0x0a, 0x1d, 0xa4, 0xa0, 0xfa,
-	0x4b, 0x19, 0x3c, 0x0a, 0x28, 0xf3, 0xa6, 0x64, 0x46, 0xfc, 0x34, 0x7e, 0x0f, 0x07, 0x7c, 0x91,
-	0xd8, 0xac, 0xa3, 0xf5, 0xfa, 0xfd, 0x4f, 0x2a, 0x8d, 0x3c, 0xd9, 0x24, 0xcb, 0xd5, 0xb4, 0x1b,
-	0x87, 0x9c, 0xb2, 0xd6, 0x37, 0x2f, 0xce, 0xf5, 0xd2, 0xd5, 0xb9, 0x5e, 0xcd, 0x4e, 0x71, 0xbb,
-	0x4c, 0xed, 0xb7, 0x3f, 0xf4, 0xba, 0x4f, 0xe5, 0xc9, 0x62, 0xdc, 0x98, 0xf0, 0x20, 0x9f, 0x53,
-	0xfe, 0xdb, 0x17, 0xd3, 0xd3, 0x3c, 0xd4, 0x44, 0x51, 0x38, 0xdb, 0x01, 0x65, 0xed, 0x6b, 0x0d,
-	0x23, 0x93, 0x78, 0x72, 0xf7, 0xe7, 0xe7, 0x7a, 0xe9, 0xaf, 0xe7, 0x7a, 0xf9, 0xc3, 0x9f, 0x36,
-	0xc1, 0x5b, 0x03, 0x6e, 0x1e, 0x72, 0x26, 0x43, 0x3c, 0x91, 0xee, 0x72, 0x4e, 0xd4, 0x8f, 0xc0,
-	0xbd, 0xa3, 0x7e, 0xdb, 0xfc, 0xd2, 0xea, 0x9b, 0x6d, 0xa5, 0x04, 0x77, 0xa3, 0x18, 0xed, 0x14,
-	0x38, 0x47, 0x6c, 0x4a, 0x8e, 0x29, 0x23, 0x53, 0xf5, 0x03, 0x70, 0x67, 0xe8, 0x1a, 0x5f, 0x5b,
-	0xfd, 0x8e, 0x52, 0x86, 0x30, 0x8a, 0xd1, 0xa3, 0x02, 0x75, 0x98, 0x5d, 0x01, 0xf5, 0x31, 0xd8,
-	0x1a, 0x19, 0xdd, 0xa1, 0xe9, 0x2a, 0x6b, 0xb0, 0x12, 0xc5, 0xe8, 0x9d, 0x02, 0x6f, 0x84, 0x67,
-	0x82, 0x48, 0x75, 0x1f, 0x00, 0xb3, 0xdf, 0x31, 0x3a, 0x66, 0xcf, 0xec, 0xbb, 0xca, 0x3a, 0xac,
-	0x46, 0x31, 0xaa, 0x14, 0xa8, 0x37, 0xa1, 0xab, 0xef, 0x83, 0xcd, 0x9e, 0xf5, 0xad, 0xe9, 0x28,
-	0x1b, 0x70, 0x27, 0x8a, 0xd1, 0x76, 0x81, 0xd9, 0xa3, 0xcf, 0x48, 0xa8, 0x1e, 0x80, 0x07, 0x6d,
-	0x6b, 0xe8, 0x3a, 0x56, 0xeb, 0xc8, 0xb5, 0xec, 0xbe, 0xb2, 0x09, 0xf5, 0x28, 0x46, 0xef, 0x16,
-	0xb8, 0x6d, 0x2a, 0x64, 0x48, 0xc7, 0x8b, 0x24, 0x28, 0xf5, 0x29, 0x78, 0x68, 0x8f, 0x4c, 0x67,
-	0x68, 0x75, 0xbe, 0x72, 0xbd, 0x43, 0xbb, 0xd7, 0x3b, 0xea, 0x5b, 0xee, 0x77, 0xca, 0x16, 0x7c,
-	0x1c, 0xc5, 0xe8, 0xbd, 0x42, 0xa7, 0x7d, 0x46, 0x42, 0x41, 0xfd, 0x13, 0x79, 0xc8, 0x83, 0x60,
-	0xc1, 0xa8, 0x5c, 0xaa, 0x2e, 0xa8, 0xde, 0xd2, 0xef, 0x0d, 0x1c, 0x7b, 0x60, 0x0f, 0x8d, 0xee,
-	0x50, 0xb9, 0x03, 0x0f, 0xa2, 0x18, 0xed, 0xff, 0xaf, 0x52, 0x87, 0x9f, 0x0d, 0x42, 0x3e, 0xe7,
-	0x02, 0xcf, 0x84, 0xfa, 0x19, 0x78, 0xf3, 0x15, 0x2d, 0xdb, 0xee, 0x2a, 0x77, 0x21, 0x8a, 0x62,
-	0xb4, 0x5b, 0x90, 0xb9, 0xee, 0x1e, 0x70, 0x3e, 0x53, 0x3f, 0x07, 0xca, 0xc8, 0xe8, 0x5a, 0x6d,
-	0xc3, 0xb5, 0x1d, 0x6f, 0x64, 0xbb, 0xc9, 0xac, 0xee, 0xc1, 0x5a, 0x14, 0x23, 0xed, 0xf5, 0x19,
-	0xd0, 0x29, 0x96, 0x3c, 0x1c, 0x71, 0x99, 0xcc, 0xec, 0x63, 0xf0, 0xc0, 0x70, 0x5a, 0x96, 0x6b,
-	0x3a, 0x99, 0x1b, 0x80, 0x5a, 0x14, 0x23, 0x58, 0xe8, 0x32, 0xc2, 0x31, 0x95, 0x24, 0x4c, 0xbd,
-	0xbe, 0x00, 0x0f, 0x5f, 0xed, 0x58, 0xd9, 0xdd, 0x87, 0x7b, 0x51, 0x8c, 0xd0, 0xbf, 0x37, 0x66,
-	0x86, 0x70, 0xe3, 0x87, 0x5f, 0xb5, 0x52, 0xeb, 0xe9, 0x8b, 0x0b, 0xad, 0xfc, 0xf2, 0x42, 0x2b,
-	0xff, 0x79, 0xa1, 0x95, 0x7f, 0xbc, 0xd4, 0x4a, 0x2f, 0x2f, 0xb5, 0xd2, 0xef, 0x97, 0x5a, 0xe9,
-	0xfb, 0xbd, 0x7f, 0x5c, 0xfc, 0xf4, 0x45, 0x95, 0x7e, 0x88, 0xa7, 0xa4, 0xf9, 0x2c, 0x7d, 0x5a,
-	0xd3, 0xab, 0x3f, 0xde, 0x4a, 0x1f, 0x94, 0x4f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x74,
-	0x0b, 0xa9, 0x75, 0x05, 0x00, 0x00,
+	// 953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xb7, 0x3f, 0x76, 0x77, 0xb6, 0x40, 0x98, 0xed, 0x56, 0xa9, 0xb7, 0x8d, 0x87, 0xd0,
+	0x42, 0x00, 0x35, 0xa1, 0xc0, 0x01, 0xad, 0x04, 0x28, 0x69, 0x4c, 0x09, 0xb4, 0x71, 0xd6, 0x71,
+	0xc3, 0x8f, 0x8b, 0x35, 0x89, 0xa7, 0xee, 0x50, 0x7b, 0x26, 0xb2, 0x27, 0xed, 0x86, 0x1b, 0x37,
+	0xe4, 0x13, 0xe2, 0xb4, 0x17, 0x4b, 0x2b, 0xb8, 0xf1, 0x7f, 0x20, 0xed, 0x71, 0x8f, 0x88, 0x43,
+	0x16, 0xb5, 0x17, 0xce, 0xfd, 0x0b, 0x90, 0x7f, 0xb5, 0xc5, 0x2d, 0xb0, 0x17, 0xc7, 0xf3, 0xbe,
+	0xef, 0x7d, 0xef, 0xe5, 0x7b, 0xf3, 0x64, 0xb0, 0x32, 0xe4, 0x6c, 0x9f, 0xf2, 0xfa, 0x88, 0x93,
+	0xfa, 0xd1, 0xe6, 0x80, 0x08, 0xbc, 0x19, 0xbd, 0xd7, 0x46, 0x1e, 0x17, 0x1c, 0xc2, 0x04, 0xad,
+	0x45, 0x91, 0x14, 0x95, 0x17, 0x6d, 0x6e, 0xf3, 0x18, 0xae, 0x47, 0x6f, 0x09, 0x53, 0x5e, 0xb6,
+	0x39, 0xb7, 0x1d, 0x52, 0x8f, 0x4f, 0x83, 0xf1, 0x7e, 0x1d, 0xb3, 0x49, 0x0a, 0x95, 0xf3, 0x90,
+	0x35, 0xf6, 0xb0, 0xa0, 0x9c, 0xa5, 0xb8, 0x92, 0xc7, 0x05, 0x75, 0x89, 0x2f, 0xb0, 0x3b, 0xca,
+	0xb4, 0x87, 0xdc, 0x77, 0xb9, 0x6f, 0x26, 0x45, 0x93, 0x43, 0xa6, 0x9d, 0x9c, 0xea, 0x03, 0xec,
+	0x5f, 0xf4, 0x3f, 0xe4, 0x34, 0xd3, 0x5e, 0x4b, 0x71, 0x5f, 0xe0, 0x43, 0xca, 0xec, 0x73, 0x4a,
+	0x7a, 0x4e, 0x59, 0x2b, 0x82, 0x30, 0x8b, 0x78, 0x2e, 0x65, 0xa2, 0x2e, 0x26, 0x23, 0xe2, 0x27,
+	0xcf, 0x04, 0xad, 0xfc, 0x36, 0x07, 0xe6, 0xbb, 0xd8, 0xc3, 0xae, 0x0f, 0x77, 0x00, 0x3c, 0xa0,
+	0xbe, 0xe0, 0x1e, 0x1d, 0x62, 0xc7, 0x24, 0x4c, 0x78, 0x94, 0xf8, 0x25, 0x09, 0x49, 0xd5, 0x97,
+	0x9a, 0xab, 0x67, 0x53, 0x65, 0x79, 0x82, 0x5d, 0xe7, 0x41, 0xe5, 0x2a, 0xa7, 0xa2, 0xbf, 0x7a,
+	0x11, 0x54, 0x93, 0x18, 0x3c, 0x04, 0xab, 0x94, 0x51, 0x41, 0xb1, 0x63, 0x1e, 0xc5, 0x54, 0x1b,
+	0xdb, 0xc4, 0x25, 0x4c, 0x98, 0x23, 0x4e, 0x99, 0xf0, 0x4b, 0x37, 0x90, 0x54, 0x9d, 0x6d, 0x56,
+	0xcf, 0xa6, 0xca, 0x5a, 0x22, 0xfc, 0x9f, 0xf4, 0x8a, 0x2e, 0xa7, 0x78, 0x3f, 0xaa, 0x91, 0xa1,
+	0xdd, 0x18, 0x84, 0x3f, 0x4b, 0x60, 0xc9, 0xa5, 0xcc, 0xb4, 0x88, 0x43, 0xec, 0xd8, 0x7e, 0x13,
+	0xbb, 0x7c, 0x1c, 0x95, 0x99, 0x41, 0x33, 0xd5, 0x3b, 0xef, 0x2d, 0xd7, 0x52, 0x67, 0x23, 0x2f,
+	0xb3, 0x69, 0xd7, 0xb6, 0x38, 0x65, 0xcd, 0x87, 0x4f, 0xa7, 0x4a, 0xe1, 0x6c, 0xaa, 0xac, 0x26,
+	0x5d, 0x5c, 0x2f, 0x53, 0xf9, 0xf5, 0xb9, 0x52, 0xb5, 0xa9, 0x38, 0x18, 0x0f, 0x6a, 0x43, 0xee,
+	0xa6, 0x73, 0x4a, 0x7f, 0x36, 0x7c, 0xeb, 0x30, 0x35, 0x35, 0x52, 0xf4, 0xf5, 0x45, 0x97, 0xb2,
+	0xd6, 0xb9, 0x46, 0x23, 0x91, 0x80, 0x0f, 0xc1, 0xa2, 0x4f, 0x6d, 0x46, 0x2c, 0x73, 0xe0, 0xf0,
+	0xe1, 0xa1, 0x6f, 0x1e, 0x53, 0x66, 0xf1, 0xe3, 0xd2, 0x2c, 0x92, 0xaa, 0x33, 0x4d, 0xe5, 0x6c,
+	0xaa, 0xdc, 0x4f, 0x5a, 0xb8, 0x8e, 0x55, 0xd1, 0x61, 0x12, 0x6e, 0xc6, 0xd1, 0x2f, 0xe3, 0x20,
+	0xfc, 0x5e, 0x02, 0xf7, 0xa2, 0x86, 0xd3, 0x8c, 0x11, 0xf1, 0x32, 0xd1, 0x39, 0x24, 0x55, 0x17,
+	0x9a, 0x9d, 0xe8, 0xbf, 0xfd, 0x31, 0x55, 0xde, 0x78, 0x81, 0xd6, 0x5b, 0x64, 0x78, 0x36, 0x55,
+	0x56, 0x2e, 0x5c, 0xb8, 0x22, 0x5a, 0xd1, 0xa1, 0x4b, 0x59, 0x2f, 0x0e, 0x77, 0x89, 0x97, 0xf6,
+	0xf0, 0x1d, 0x58, 0xb2, 0xf8, 0x31, 0x8b, 0xee, 0xb5, 0xf9, 0x2d, 0xa6, 0x8e, 0x99, 0x6d, 0x40,
+	0x69, 0x1e, 0x49, 0xb1, 0xf5, 0xc9, 0x0a, 0xd4, 0xb2, 0x15, 0xa8, 0xb5, 0x52, 0x42, 0xf3, 0xad,
+	0x7f, 0x5a, 0x7f, 0xbd, 0x4c, 0xe5, 0xf1, 0x73, 0x45, 0xd2, 0x17, 0x33, 0xf0, 0x73, 0x4c, 0x9d,
+	0x4c, 0xe0, 0xc1, 0xad, 0xc7, 0x4f, 0x94, 0xc2, 0x5f, 0x4f, 0x14, 0xe9, 0xed, 0x9f, 0xe6, 0xc0,
+	0x2b, 0x5d, 0xae, 0x6e, 0x71, 0x26, 0x3c, 0x3c, 0x14, 0xc6, 0x64, 0x44, 0xe0, 0x3b, 0xe0, 0xf6,
+	0x5e, 0xa7, 0xa5, 0x7e, 0xda, 0xee, 0xa8, 0xad, 0x62, 0x41, 0x5e, 0x09, 0x42, 0x54, 0xca, 0x71,
+	0xf6, 0x98, 0x45, 0xf6, 0x29, 0x23, 0x16, 0x7c, 0x13, 0xdc, 0xec, 0x19, 0x8d, 0x2f, 0xda, 0x9d,
+	0xed, 0xa2, 0x24, 0xcb, 0x41, 0x88, 0x96, 0x72, 0xd4, 0x5e, 0xb2, 0x55, 0x70, 0x1d, 0xcc, 0xf7,
+	0x1b, 0x3b, 0x3d, 0xd5, 0x28, 0xde, 0x90, 0x97, 0x83, 0x10, 0xdd, 0xcb, 0xf1, 0xfa, 0xd8, 0xf1,
+	0x89, 0x80, 0x1b, 0x00, 0xa8, 0x9d, 0xed, 0xc6, 0xb6, 0xba, 0xab, 0x76, 0x8c, 0xe2, 0x8c, 0xbc,
+	0x1a, 0x84, 0x68, 0x39, 0x47, 0xbd, 0xb8, 0xc7, 0xf0, 0x75, 0x30, 0xb7, 0xdb, 0xfe, 0x4a, 0xd5,
+	0x8b, 0xb3, 0x72, 0x29, 0x08, 0xd1, 0x62, 0x8e, 0xb9, 0x4b, 0x1f, 0x11, 0x0f, 0x6e, 0x82, 0x85,
+	0x56, 0xbb, 0x67, 0xe8, 0xed, 0xe6, 0x9e, 0xd1, 0xd6, 0x3a, 0xc5, 0x39, 0x59, 0x09, 0x42, 0x74,
+	0x3f, 0xc7, 0x6d, 0x51, 0x5f, 0x78, 0x74, 0x30, 0x8e, 0x1c, 0x82, 0x1f, 0x83, 0xbb, 0x5a, 0x5f,
+	0xd5, 0x7b, 0xed, 0xed, 0xcf, 0x0c, 0x73, 0x4b, 0xdb, 0xdd, 0xdd, 0xeb, 0xb4, 0x8d, 0xaf, 0x8b,
+	0xf3, 0xf2, 0x7a, 0x10, 0xa2, 0xd7, 0x72, 0x99, 0xda, 0x11, 0xf1, 0x7c, 0x6a, 0x1f, 0x88, 0x2d,
+	0xee, 0xba, 0x63, 0x46, 0xc5, 0x04, 0x1a, 0x60, 0xf5, 0x9a, 0x7c, 0xb3, 0xab, 0x6b, 0x5d, 0xad,
+	0xd7, 0xd8, 0xe9, 0x15, 0x6f, 0xca, 0x9b, 0x41, 0x88, 0x36, 0xfe, 0x57, 0x69, 0x9b, 0x1f, 0x75,
+	0x3d, 0x3e, 0xe2, 0x3e, 0x76, 0x7c, 0xf8, 0x01, 0x78, 0xf9, 0x92, 0x96, 0xa6, 0xed, 0x14, 0x6f,
+	0xc9, 0x28, 0x08, 0xd1, 0x4a, 0x4e, 0xe6, 0x3c, 0xbb, 0xcb, 0xb9, 0x03, 0x3f, 0x04, 0xc5, 0x7e,
+	0x63, 0xa7, 0xdd, 0x6a, 0x18, 0x9a, 0x6e, 0xf6, 0x35, 0x23, 0x9a, 0xd5, 0x6d, 0xb9, 0x12, 0x84,
+	0xa8, 0x7c, 0x75, 0x06, 0xd4, 0xc2, 0x82, 0x7b, 0x7d, 0x2e, 0xa2, 0x99, 0xbd, 0x0b, 0x16, 0x1a,
+	0x7a, 0xb3, 0x6d, 0xa8, 0x7a, 0x52, 0x0d, 0xc8, 0xe5, 0x20, 0x44, 0x72, 0x2e, 0xab, 0xe1, 0x0d,
+	0xa8, 0x20, 0x5e, 0x5c, 0xeb, 0x23, 0x70, 0xf7, 0x72, 0x46, 0x56, 0xee, 0x8e, 0xbc, 0x16, 0x84,
+	0x08, 0xfd, 0x7b, 0x62, 0x52, 0x50, 0x9e, 0xfd, 0xe1, 0x97, 0x72, 0xa1, 0xf9, 0xc9, 0xd3, 0x93,
+	0xb2, 0xf4, 0xec, 0xa4, 0x2c, 0xfd, 0x79, 0x52, 0x96, 0x7e, 0x3c, 0x2d, 0x17, 0x9e, 0x9d, 0x96,
+	0x0b, 0xbf, 0x9f, 0x96, 0x0b, 0xdf, 0xac, 0x5f, 0x5a, 0x48, 0xee, 0x58, 0xfb, 0x63, 0x6f, 0x82,
+	0xeb, 0xc9, 0xf3, 0x51, 0xfc, 0xb9, 0x8a, 0x77, 0x72, 0x30, 0x1f, 0xef, 0xcc, 0xfb, 0x7f, 0x0f,
+	0x00, 0x08, 0x5c, 0x11, 0x1c, 0xc9, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -257,6 +292,15 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
+		return false
+	}
+	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+		return false
+	}
+	if this.DowntimeJailDuration != that1.DowntimeJailDuration {
+		return false
+	}
 	return true
 }
 
@@ -280,6 +324,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPoe(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPoe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintPoe(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.MinDelegationAmounts) > 0 {
 		for iNdEx := len(m.MinDelegationAmounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -337,6 +404,13 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovPoe(uint64(l))
 		}
 	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovPoe(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovPoe(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovPoe(uint64(l))
 	return n
 }
 
@@ -449,6 +523,91 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPoe(dAtA[iNdEx:])
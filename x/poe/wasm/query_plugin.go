@@ -2,6 +2,9 @@ package wasm
 
 import (
 	"encoding/json"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +14,7 @@ import (
 	"github.com/oldfurya/furya/x/poe/contract"
 	"github.com/oldfurya/furya/x/poe/keeper"
 	"github.com/oldfurya/furya/x/poe/types"
+	twasmcontract "github.com/oldfurya/furya/x/twasm/contract"
 )
 
 type ViewKeeper interface {
@@ -22,6 +26,16 @@ type ViewKeeper interface {
 	GetValidatorVotes() []abcitypes.VoteInfo
 }
 
+// ConsensusParamsReader is a subset of the app to read the consensus params
+type ConsensusParamsReader interface {
+	GetConsensusParams(ctx sdk.Context) *abcitypes.ConsensusParams
+}
+
+// GovProposalSimulator is a subset of the twasm keeper to dry-run gov proposals of contracts
+type GovProposalSimulator interface {
+	SimulateGovProposal(ctx sdk.Context, sender sdk.AccAddress, exec twasmcontract.ExecuteGovProposal) error
+}
+
 func StakingQuerier(poeKeeper ViewKeeper) func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error) {
 		if request.BondedDenom != nil {
@@ -147,9 +161,19 @@ type PoEContractAddressQuery struct {
 	ContractType string `json:"contract_type"`
 }
 
+// SimulateGovProposalQuery contains the gov proposal in the format of the `execute_gov_proposal` message and the
+// address of the contract that would send it. Proposals that call into the wasm VM, like store code or migrations,
+// are not supported.
+type SimulateGovProposalQuery struct {
+	Sender string `json:"sender"`
+	twasmcontract.ExecuteGovProposal
+}
+
 type PetriQuery struct {
-	PoEContractAddress *PoEContractAddressQuery `json:"poe_contract_address,omitempty"`
-	ValidatorVotes     *struct{}                `json:"validator_votes,omitempty"`
+	PoEContractAddress  *PoEContractAddressQuery  `json:"poe_contract_address,omitempty"`
+	ValidatorVotes      *struct{}                 `json:"validator_votes,omitempty"`
+	ConsensusParams     *struct{}                 `json:"consensus_params,omitempty"`
+	SimulateGovProposal *SimulateGovProposalQuery `json:"simulate_gov_proposal,omitempty"`
 }
 
 type ContractAddrResponse struct {
@@ -166,7 +190,23 @@ type ValidatorVote struct {
 	Voted bool           `json:"voted"`
 }
 
-func CustomQuerier(poeKeeper ViewKeeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+// ConsensusParamsResponse contains the current consensus params in the format of the consensus params
+// contract message. Durations are in seconds.
+type ConsensusParamsResponse struct {
+	Block     *twasmcontract.BlockParams     `json:"block,omitempty"`
+	Evidence  *twasmcontract.EvidenceParams  `json:"evidence,omitempty"`
+	Validator *twasmcontract.ValidatorParams `json:"validator,omitempty"`
+	Version   *twasmcontract.VersionParams   `json:"version,omitempty"`
+}
+
+// SimulateGovProposalResponse contains the result of the dry-run. The error is set when the proposal would fail on
+// execution.
+type SimulateGovProposalResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+func CustomQuerier(poeKeeper ViewKeeper, consensusParamsReader ConsensusParamsReader, govProposalSimulator GovProposalSimulator) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
 	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
 		var contractQuery PetriQuery
 		if err := json.Unmarshal(request, &contractQuery); err != nil {
@@ -178,6 +218,10 @@ func CustomQuerier(poeKeeper ViewKeeper) func(ctx sdk.Context, request json.RawM
 			return handlePoEContractAddressQuery(ctx, contractQuery, poeKeeper)
 		case contractQuery.ValidatorVotes != nil:
 			return handleValidatorVotesQuery(poeKeeper)
+		case contractQuery.ConsensusParams != nil:
+			return handleConsensusParamsQuery(ctx, consensusParamsReader)
+		case contractQuery.SimulateGovProposal != nil:
+			return handleSimulateGovProposalQuery(ctx, contractQuery.SimulateGovProposal, govProposalSimulator)
 		}
 		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown poe query variant"}
 	}
@@ -205,6 +249,55 @@ func handleValidatorVotesQuery(poeKeeper ViewKeeper) ([]byte, error) {
 	return bz, nil
 }
 
+func handleConsensusParamsQuery(ctx sdk.Context, consensusParamsReader ConsensusParamsReader) ([]byte, error) {
+	cp := consensusParamsReader.GetConsensusParams(ctx)
+	if cp == nil {
+		return nil, sdkerrors.Wrap(wasmtypes.ErrNotFound, "consensus params")
+	}
+	var res ConsensusParamsResponse
+	if cp.Block != nil {
+		res.Block = &twasmcontract.BlockParams{
+			MaxBytes: &cp.Block.MaxBytes,
+			MaxGas:   &cp.Block.MaxGas,
+		}
+	}
+	if cp.Evidence != nil {
+		maxAgeDuration := int64(cp.Evidence.MaxAgeDuration / time.Second)
+		res.Evidence = &twasmcontract.EvidenceParams{
+			MaxAgeNumBlocks: &cp.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  &maxAgeDuration,
+			MaxBytes:        &cp.Evidence.MaxBytes,
+		}
+	}
+	if cp.Validator != nil {
+		res.Validator = &twasmcontract.ValidatorParams{PubKeyTypes: cp.Validator.PubKeyTypes}
+	}
+	if cp.Version != nil {
+		res.Version = &twasmcontract.VersionParams{AppVersion: &cp.Version.AppVersion}
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "consensus params query response")
+	}
+	return bz, nil
+}
+
+func handleSimulateGovProposalQuery(ctx sdk.Context, query *SimulateGovProposalQuery, govProposalSimulator GovProposalSimulator) ([]byte, error) {
+	sender, err := sdk.AccAddressFromBech32(query.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender")
+	}
+	res := SimulateGovProposalResponse{Success: true}
+	if err := govProposalSimulator.SimulateGovProposal(ctx, sender, query.ExecuteGovProposal); err != nil {
+		res = SimulateGovProposalResponse{Error: err.Error()}
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "simulate gov proposal query response")
+	}
+	return bz, nil
+}
+
 func handlePoEContractAddressQuery(ctx sdk.Context, contractQuery PetriQuery, poeKeeper ViewKeeper) ([]byte, error) {
 	ctype := types.PoEContractTypeFrom(contractQuery.PoEContractAddress.ContractType)
 
@@ -12,11 +12,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/oldfurya/furya/x/poe/contract"
 	"github.com/oldfurya/furya/x/poe/keeper"
 	"github.com/oldfurya/furya/x/poe/keeper/poetesting"
 	poetypes "github.com/oldfurya/furya/x/poe/types"
+	twasmcontract "github.com/oldfurya/furya/x/twasm/contract"
+	twasmtypes "github.com/oldfurya/furya/x/twasm/types"
 )
 
 func TestStakingQuerier(t *testing.T) {
@@ -338,6 +341,16 @@ func TestCustomQuerier(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"consensus params query": {
+			src:  []byte(`{ "consensus_params": {} }`),
+			mock: ViewKeeperMock{},
+			expJSON: `{
+				"block":{"max_bytes":200000,"max_gas":2000000},
+				"evidence":{"max_age_num_blocks":302400,"max_age_duration":1814400,"max_bytes":10000},
+				"validator":{"pub_key_types":["ed25519"]},
+				"version":{"app_version":1}
+			}`,
+		},
 		"validator votes query": {
 			src: []byte(`{ "validator_votes": {} }`),
 			mock: ViewKeeperMock{
@@ -358,18 +371,96 @@ func TestCustomQuerier(t *testing.T) {
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			q := CustomQuerier(spec.mock)
+			consensusParamsMock := ConsensusParamsReaderMock{
+				GetConsensusParamsFn: func(ctx sdk.Context) *abcitypes.ConsensusParams {
+					return twasmtypes.ConsensusParamsFixture(func(c *abcitypes.ConsensusParams) {
+						c.Version = &tmproto.VersionParams{AppVersion: 1}
+					})
+				},
+			}
+			q := CustomQuerier(spec.mock, consensusParamsMock, GovProposalSimulatorMock{})
+			gotRsp, gotErr := q(sdk.Context{}, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.JSONEq(t, spec.expJSON, string(gotRsp), string(gotRsp))
+		})
+	}
+}
+
+func TestCustomQuerierSimulateGovProposal(t *testing.T) {
+	mySenderAddr := sdk.AccAddress("my_sender_addr")
+	specs := map[string]struct {
+		src     json.RawMessage
+		simErr  error
+		expJSON string
+		expErr  bool
+	}{
+		"success": {
+			src:     []byte(`{"simulate_gov_proposal":{"sender":"` + mySenderAddr.String() + `","title":"foo","description":"bar","proposal":{"text":{}}}}`),
+			expJSON: `{"success":true}`,
+		},
+		"failure": {
+			src:     []byte(`{"simulate_gov_proposal":{"sender":"` + mySenderAddr.String() + `","title":"foo","description":"bar","proposal":{"text":{}}}}`),
+			simErr:  sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "testing"),
+			expJSON: `{"success":false,"error":"testing: invalid request"}`,
+		},
+		"invalid sender": {
+			src:    []byte(`{"simulate_gov_proposal":{"sender":"invalid","title":"foo","description":"bar","proposal":{"text":{}}}}`),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotSender sdk.AccAddress
+			var gotProposal twasmcontract.ExecuteGovProposal
+			simulatorMock := GovProposalSimulatorMock{
+				SimulateGovProposalFn: func(ctx sdk.Context, sender sdk.AccAddress, exec twasmcontract.ExecuteGovProposal) error {
+					gotSender, gotProposal = sender, exec
+					return spec.simErr
+				},
+			}
+			q := CustomQuerier(ViewKeeperMock{}, ConsensusParamsReaderMock{}, simulatorMock)
 			gotRsp, gotErr := q(sdk.Context{}, spec.src)
 			if spec.expErr {
 				require.Error(t, gotErr)
+				assert.Nil(t, gotSender)
 				return
 			}
 			require.NoError(t, gotErr)
 			assert.JSONEq(t, spec.expJSON, string(gotRsp), string(gotRsp))
+			assert.Equal(t, mySenderAddr, gotSender)
+			assert.Equal(t, "foo", gotProposal.Title)
+			assert.Equal(t, "bar", gotProposal.Description)
+			assert.NotNil(t, gotProposal.Proposal.Text)
 		})
 	}
 }
 
+type GovProposalSimulatorMock struct {
+	SimulateGovProposalFn func(ctx sdk.Context, sender sdk.AccAddress, exec twasmcontract.ExecuteGovProposal) error
+}
+
+func (m GovProposalSimulatorMock) SimulateGovProposal(ctx sdk.Context, sender sdk.AccAddress, exec twasmcontract.ExecuteGovProposal) error {
+	if m.SimulateGovProposalFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SimulateGovProposalFn(ctx, sender, exec)
+}
+
+type ConsensusParamsReaderMock struct {
+	GetConsensusParamsFn func(ctx sdk.Context) *abcitypes.ConsensusParams
+}
+
+func (m ConsensusParamsReaderMock) GetConsensusParams(ctx sdk.Context) *abcitypes.ConsensusParams {
+	if m.GetConsensusParamsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetConsensusParamsFn(ctx)
+}
+
 type ViewKeeperMock struct {
 	GetBondDenomFn          func(ctx sdk.Context) string
 	DistributionContractFn  func(ctx sdk.Context) keeper.DistributionContract
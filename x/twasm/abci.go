@@ -12,16 +12,42 @@ import (
 	"github.com/oldfurya/furya/x/twasm/keeper"
 	"github.com/oldfurya/furya/x/twasm/types"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-type abciKeeper interface {
+// PrivilegedCallbackKeeper defines a subset of the twasm keeper to notify privileged contracts
+type PrivilegedCallbackKeeper interface {
 	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	IteratePrivilegedContractsByType(ctx sdk.Context, privilegeType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	CallbackGasLimit(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) sdk.Gas
+	TrackCallbackFailure(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool
+	ResetCallbackFailures(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress)
+	QuarantinePrivilege(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error
+}
+
+type abciKeeper interface {
+	PrivilegedCallbackKeeper
+	GetTWasmParams(ctx sdk.Context) types.TWasmParams
+	HasPrivilegedContract(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType types.PrivilegeType) (bool, error)
+	PopDueScheduledCallbacks(ctx sdk.Context, max int) []types.ScheduledCallback
+	PopDueTimelockedGovProposals(ctx sdk.Context, max int) []types.TimelockedGovProposal
+	ExecuteTimelockedGovProposal(ctx sdk.Context, proposal types.TimelockedGovProposal) error
 }
 
+const (
+	// MaxScheduledCallbacksPerBlock is the max number of scheduled callbacks executed in a block. Remaining due
+	// callbacks are executed in the next blocks.
+	MaxScheduledCallbacksPerBlock = 100
+	// MaxTimelockedGovProposalsPerBlock is the max number of queued gov proposals executed in a block. Remaining due
+	// proposals are executed in the next blocks.
+	MaxTimelockedGovProposalsPerBlock = 10
+)
+
 func BeginBlocker(ctx sdk.Context, k abciKeeper, b abci.RequestBeginBlock) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	logger := keeper.ModuleLogger(ctx)
@@ -54,12 +80,36 @@ func BeginBlocker(ctx sdk.Context, k abciKeeper, b abci.RequestBeginBlock) {
 	msg := contract.PetriSudoMsg{BeginBlock: &contract.BeginBlock{
 		Evidence: evidence,
 	}}
+	if k.GetTWasmParams(ctx).BeginBlockLiveness {
+		msg.BeginBlock.Liveness = newLiveness(b)
+	}
 
 	msgBz, err := json.Marshal(msg)
 	if err != nil {
 		panic(err) // this will crash the node as panics are not recovered
 	}
-	k.IteratePrivilegedContractsByType(ctx, types.PrivilegeTypeBeginBlock, abciContractCallback(ctx, k, msgBz))
+	NotifyPrivilegedContracts(ctx, k, types.PrivilegeTypeBeginBlock, msgBz)
+}
+
+// newLiveness converts the votes of the last commit and the block proposer
+func newLiveness(b abci.RequestBeginBlock) *contract.Liveness {
+	result := contract.Liveness{
+		ProposerAddress: b.Header.ProposerAddress,
+		Signers:         make([]contract.Validator, 0, len(b.LastCommitInfo.Votes)),
+		NonSigners:      make([]contract.Validator, 0),
+	}
+	for _, v := range b.LastCommitInfo.Votes {
+		val := contract.Validator{
+			Address: v.Validator.Address,
+			Power:   convUint64(v.Validator.Power),
+		}
+		if v.SignedLastBlock {
+			result.Signers = append(result.Signers, val)
+		} else {
+			result.NonSigners = append(result.NonSigners, val)
+		}
+	}
+	return &result
 }
 
 // EndBlocker ABCI end block callback. Does not modify the validator set
@@ -70,24 +120,153 @@ func EndBlocker(ctx sdk.Context, k abciKeeper) []abci.ValidatorUpdate {
 	if err != nil {
 		panic(err) // this will break consensus
 	}
-	k.IteratePrivilegedContractsByType(ctx, types.PrivilegeTypeEndBlock, abciContractCallback(ctx, k, msgBz))
+	NotifyPrivilegedContracts(ctx, k, types.PrivilegeTypeEndBlock, msgBz)
+	executeScheduledCallbacks(ctx, k)
+	executeTimelockedGovProposals(ctx, k)
 	return nil
 }
 
+// executes the queued gov proposals with a passed timelock. Failures are logged and do not affect other proposals.
+func executeTimelockedGovProposals(ctx sdk.Context, k abciKeeper) {
+	logger := keeper.ModuleLogger(ctx)
+	for _, p := range k.PopDueTimelockedGovProposals(ctx, MaxTimelockedGovProposalsPerBlock) {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					logger.Error("panic executing timelocked gov proposal", "cause", r, "id", p.ID, "stacktrace", string(debug.Stack()))
+				}
+			}()
+			if err := k.ExecuteTimelockedGovProposal(ctx, p); err != nil {
+				logger.Error("failed to execute timelocked gov proposal", "cause", err, "id", p.ID, "sender", p.Sender)
+				return
+			}
+			logger.Info("executed timelocked gov proposal", "id", p.ID, "sender", p.Sender)
+		}()
+	}
+}
+
+// sends the due scheduled callbacks to the contracts that still have the privilege registered
+func executeScheduledCallbacks(ctx sdk.Context, k abciKeeper) {
+	logger := keeper.ModuleLogger(ctx)
+	for _, c := range k.PopDueScheduledCallbacks(ctx, MaxScheduledCallbacksPerBlock) {
+		contractAddr, err := sdk.AccAddressFromBech32(c.ContractAddress)
+		if err != nil {
+			panic(err) // this will break consensus
+		}
+		switch ok, err := k.HasPrivilegedContract(ctx, contractAddr, types.PrivilegeTypeScheduledCallback); {
+		case err != nil:
+			logger.Error("failed to read privileges", "cause", err, "contract-address", contractAddr)
+			continue
+		case !ok:
+			logger.Info("skipped scheduled callback", "id", c.ID, "contract-address", contractAddr)
+			continue
+		}
+		msgBz, err := json.Marshal(contract.PetriSudoMsg{ScheduledCallback: &contract.ScheduledCallback{
+			ID:      c.ID,
+			Payload: c.Payload,
+		}})
+		if err != nil {
+			panic(err) // this will break consensus
+		}
+		var quarantine bool
+		abciContractCallback(ctx, k, types.PrivilegeTypeScheduledCallback, msgBz, func(sdk.AccAddress) {
+			quarantine = true
+		})(0, contractAddr)
+		if !quarantine {
+			continue
+		}
+		if err := k.QuarantinePrivilege(ctx, types.PrivilegeTypeScheduledCallback, contractAddr); err != nil {
+			logger.Error(
+				"failed to quarantine privilege",
+				"type", types.PrivilegeTypeScheduledCallback.String(),
+				"cause", err,
+				"contract-address", contractAddr,
+			)
+		}
+	}
+}
+
+// NotifyPrivilegedContracts sends the message to all contracts registered for the privilege type and quarantines
+// the privilege for contracts that failed too often. Failures do not stop the other contracts from being called.
+func NotifyPrivilegedContracts(ctx sdk.Context, k PrivilegedCallbackKeeper, privilegeType types.PrivilegeType, msgBz []byte) {
+	var quarantine []sdk.AccAddress
+	k.IteratePrivilegedContractsByType(ctx, privilegeType, abciContractCallback(ctx, k, privilegeType, msgBz, func(contractAddr sdk.AccAddress) {
+		quarantine = append(quarantine, contractAddr)
+	}))
+	// released after the iteration to not modify the store while iterating
+	for _, contractAddr := range quarantine {
+		if err := k.QuarantinePrivilege(ctx, privilegeType, contractAddr); err != nil {
+			keeper.ModuleLogger(ctx).Error(
+				"failed to quarantine privilege",
+				"type", privilegeType.String(),
+				"cause", err,
+				"contract-address", contractAddr,
+			)
+		}
+	}
+}
+
+// NotifyPrivilegeExtensionsOnBeginBlock sends the begin block sudo messages of the privilege extensions to the
+// registered contracts. Extensions that fail to build a message are skipped.
+func NotifyPrivilegeExtensionsOnBeginBlock(ctx sdk.Context, k PrivilegedCallbackKeeper, privileges *keeper.PrivilegeRegistry) {
+	notifyPrivilegeExtensions(ctx, k, privileges.IterateBeginBlockSudoMsgBuilders)
+}
+
+// NotifyPrivilegeExtensions sends the end block sudo messages of the privilege extensions to the registered
+// contracts. Extensions that fail to build a message are skipped.
+func NotifyPrivilegeExtensions(ctx sdk.Context, k PrivilegedCallbackKeeper, privileges *keeper.PrivilegeRegistry) {
+	notifyPrivilegeExtensions(ctx, k, privileges.IterateEndBlockSudoMsgBuilders)
+}
+
+func notifyPrivilegeExtensions(
+	ctx sdk.Context,
+	k PrivilegedCallbackKeeper,
+	iterate func(cb func(privilegeType types.PrivilegeType, builder keeper.PrivilegedSudoMsgBuilder)),
+) {
+	iterate(func(privilegeType types.PrivilegeType, builder keeper.PrivilegedSudoMsgBuilder) {
+		msgBz, err := builder(ctx)
+		switch {
+		case err != nil:
+			keeper.ModuleLogger(ctx).Error("failed to build sudo message", "type", privilegeType.String(), "cause", err)
+		case msgBz != nil:
+			NotifyPrivilegedContracts(ctx, k, privilegeType, msgBz)
+		}
+	})
+}
+
 // returns safe method to send the message via sudo to the privileged contract
-func abciContractCallback(parentCtx sdk.Context, k abciKeeper, msgBz []byte) func(pos uint8, contractAddr sdk.AccAddress) bool {
+func abciContractCallback(parentCtx sdk.Context, k PrivilegedCallbackKeeper, privilegeType types.PrivilegeType, msgBz []byte, onQuarantine func(contractAddr sdk.AccAddress)) func(pos uint8, contractAddr sdk.AccAddress) bool {
 	logger := keeper.ModuleLogger(parentCtx)
 	return func(pos uint8, contractAddr sdk.AccAddress) bool {
+		var succeeded bool
+		// track result after any panic was recovered
+		defer func() {
+			if succeeded {
+				k.ResetCallbackFailures(parentCtx, privilegeType, contractAddr)
+				return
+			}
+			if k.TrackCallbackFailure(parentCtx, privilegeType, contractAddr) {
+				onQuarantine(contractAddr)
+			}
+		}()
 		// any panic will crash the node, so we are better taking care of them here
 		defer RecoverToLog(logger, contractAddr)()
 
-		logger.Debug("privileged contract callback", "type", types.PrivilegeTypeEndBlock.String(), "msg", string(msgBz))
+		logger.Debug("privileged contract callback", "type", privilegeType.String(), "msg", string(msgBz))
 		ctx, commit := parentCtx.CacheContext()
 
-		if _, err := k.Sudo(ctx, contractAddr, msgBz); err != nil {
+		gasLimit := k.CallbackGasLimit(ctx, privilegeType, contractAddr)
+		err := ExecuteWithGasLimit(ctx, gasLimit, func(ctx sdk.Context) error {
+			_, err := k.Sudo(ctx, contractAddr, msgBz)
+			return err
+		})
+		if err != nil {
+			if sdkerrors.ErrOutOfGas.Is(err) {
+				ReportCallbackOutOfGas(parentCtx, privilegeType, contractAddr, gasLimit)
+			}
 			logger.Error(
 				"abci callback to privileged contract failed",
-				"type", types.PrivilegeTypeEndBlock.String(),
+				"type", privilegeType.String(),
 				"cause", err,
 				"contract-address", contractAddr,
 				"position", pos,
@@ -95,10 +274,27 @@ func abciContractCallback(parentCtx sdk.Context, k abciKeeper, msgBz []byte) fun
 			return false // return without commit
 		}
 		commit()
+		succeeded = true
 		return false
 	}
 }
 
+// ReportCallbackOutOfGas emits an event and increments the telemetry counter for a privileged callback
+// that exceeded its gas limit
+func ReportCallbackOutOfGas(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, gasLimit sdk.Gas) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "callback", "out_of_gas"},
+		1,
+		[]metrics.Label{telemetry.NewLabel(types.AttributeKeyCallbackType, privilegeType.String())},
+	)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCallbackOutOfGas,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyCallbackType, privilegeType.String()),
+		sdk.NewAttribute(types.AttributeKeyGasLimit, fmt.Sprintf("%d", gasLimit)),
+	))
+}
+
 // RecoverToLog catches panic and logs cause to error
 func RecoverToLog(logger log.Logger, contractAddr sdk.AccAddress) func() {
 	return func() {
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -11,10 +12,12 @@ import (
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/oldfurya/furya/x/twasm/keeper"
 	"github.com/oldfurya/furya/x/twasm/types"
@@ -105,6 +108,42 @@ func TestBeginBlock(t *testing.T) {
 			}},
 			expCommitted: []bool{true},
 		},
+		"with liveness": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = captureSudos(&capturedSudoCalls)
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTypeBeginBlock, myAddr)
+				m.GetTWasmParamsFn = func(ctx sdk.Context) types.TWasmParams {
+					return types.TWasmParams{BeginBlockLiveness: true}
+				}
+			},
+			src: abci.RequestBeginBlock{
+				Header: tmproto.Header{ProposerAddress: myOtherAddr},
+				LastCommitInfo: abci.LastCommitInfo{Votes: []abci.VoteInfo{
+					{Validator: abci.Validator{Address: myOtherAddr, Power: 2}, SignedLastBlock: true},
+					{Validator: abci.Validator{Address: myAddr, Power: 1}, SignedLastBlock: false},
+				}},
+			},
+			expSudoCalls: []tuple{{
+				addr: myAddr,
+				msg: []byte(fmt.Sprintf(`{"begin_block":{"evidence":[],"liveness":{"proposer_address":%q,"signers":[{"address":%q,"power":2}],"non_signers":[{"address":%q,"power":1}]}}}`,
+					myOtherAddrBase64, myOtherAddrBase64, base64.StdEncoding.EncodeToString(myAddr))),
+			}},
+			expCommitted: []bool{true},
+		},
+		"with liveness - disabled": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = captureSudos(&capturedSudoCalls)
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTypeBeginBlock, myAddr)
+			},
+			src: abci.RequestBeginBlock{
+				Header: tmproto.Header{ProposerAddress: myOtherAddr},
+				LastCommitInfo: abci.LastCommitInfo{Votes: []abci.VoteInfo{
+					{Validator: abci.Validator{Address: myOtherAddr, Power: 2}, SignedLastBlock: true},
+				}},
+			},
+			expSudoCalls: []tuple{{addr: myAddr, msg: []byte(`{"begin_block":{"evidence":[]}}`)}},
+			expCommitted: []bool{true},
+		},
 		"with evidence - unknown type ignored": {
 			setup: func(m *MockSudoer) {
 				m.SudoFn = captureSudos(&capturedSudoCalls)
@@ -154,7 +193,8 @@ func TestBeginBlock(t *testing.T) {
 			spec.setup(&mock)
 			commitMultistore := mockCommitMultiStore{}
 			ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
-				WithMultiStore(&commitMultistore)
+				WithMultiStore(&commitMultistore).
+				WithEventManager(sdk.NewEventManager())
 
 			// when
 			if spec.expPanic {
@@ -192,6 +232,7 @@ func TestEndBlock(t *testing.T) {
 		expSudoCalls []tuple
 		expPanic     bool
 		expCommitted []bool
+		expEvents    sdk.Events
 	}{
 		"end block - single callback": {
 			setup: func(m *MockSudoer) {
@@ -243,6 +284,28 @@ func TestEndBlock(t *testing.T) {
 			expSudoCalls: []tuple{{addr: myOtherAddr, msg: []byte(`{"end_block":{}}`)}},
 			expCommitted: []bool{false, true},
 		},
+		"end block - sudo out of gas handled": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					if contractAddress.Equals(myAddr) {
+						ctx.GasMeter().ConsumeGas(101, "testing")
+					}
+					return captureSudos(&capturedSudoCalls)(ctx, contractAddress, msg)
+				}
+				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, []sdk.AccAddress{myAddr, myOtherAddr}, nil)
+				m.CallbackGasLimitFn = func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) sdk.Gas {
+					assert.Equal(t, types.PrivilegeTypeEndBlock, privilegeType)
+					return 100
+				}
+			},
+			expSudoCalls: []tuple{{addr: myOtherAddr, msg: []byte(`{"end_block":{}}`)}},
+			expCommitted: []bool{false, true},
+			expEvents: sdk.Events{sdk.NewEvent("privileged_callback_out_of_gas",
+				sdk.NewAttribute("_contract_address", myAddr.String()),
+				sdk.NewAttribute("privilege_type", "end_blocker"),
+				sdk.NewAttribute("gas_limit", "100"),
+			)},
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -250,8 +313,10 @@ func TestEndBlock(t *testing.T) {
 			mock := MockSudoer{}
 			spec.setup(&mock)
 			commitMultistore := mockCommitMultiStore{}
+			em := sdk.NewEventManager()
 			ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
-				WithMultiStore(&commitMultistore)
+				WithMultiStore(&commitMultistore).
+				WithEventManager(em)
 
 			// when
 			if spec.expPanic {
@@ -262,6 +327,7 @@ func TestEndBlock(t *testing.T) {
 			}
 			gotValsetUpdate := EndBlocker(ctx, &mock)
 			require.Empty(t, gotValsetUpdate)
+			assert.ElementsMatch(t, spec.expEvents, em.Events())
 
 			// then
 			require.Len(t, capturedSudoCalls, len(spec.expSudoCalls))
@@ -278,6 +344,385 @@ func TestEndBlock(t *testing.T) {
 	}
 }
 
+func TestEndBlockScheduledCallbacks(t *testing.T) {
+	var (
+		capturedSudoCalls []tuple
+		myAddr            = keeper.RandomAddress(t)
+		myOtherAddr       = keeper.RandomAddress(t)
+	)
+	myCallback := types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "my-id", Height: 1, Payload: []byte(`{}`)}
+	myOtherCallback := types.ScheduledCallback{ContractAddress: myOtherAddr.String(), ID: "other-id", Height: 1}
+
+	specs := map[string]struct {
+		callbacks     []types.ScheduledCallback
+		setup         func(m *MockSudoer)
+		expSudoCalls  []tuple
+		expQuarantine []sdk.AccAddress
+	}{
+		"no callbacks": {},
+		"single callback": {
+			callbacks:    []types.ScheduledCallback{myCallback},
+			setup:        func(m *MockSudoer) { m.SudoFn = captureSudos(&capturedSudoCalls) },
+			expSudoCalls: []tuple{{addr: myAddr, msg: []byte(`{"scheduled_callback":{"id":"my-id","payload":"e30="}}`)}},
+		},
+		"multiple callbacks": {
+			callbacks: []types.ScheduledCallback{myCallback, myOtherCallback},
+			setup:     func(m *MockSudoer) { m.SudoFn = captureSudos(&capturedSudoCalls) },
+			expSudoCalls: []tuple{
+				{addr: myAddr, msg: []byte(`{"scheduled_callback":{"id":"my-id","payload":"e30="}}`)},
+				{addr: myOtherAddr, msg: []byte(`{"scheduled_callback":{"id":"other-id"}}`)},
+			},
+		},
+		"privilege released": {
+			callbacks: []types.ScheduledCallback{myCallback, myOtherCallback},
+			setup: func(m *MockSudoer) {
+				m.SudoFn = captureSudos(&capturedSudoCalls)
+				m.HasPrivilegedContractFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType types.PrivilegeType) (bool, error) {
+					return !contractAddr.Equals(myAddr), nil
+				}
+			},
+			expSudoCalls: []tuple{{addr: myOtherAddr, msg: []byte(`{"scheduled_callback":{"id":"other-id"}}`)}},
+		},
+		"sudo error handled": {
+			callbacks: []types.ScheduledCallback{myCallback, myOtherCallback},
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					if contractAddress.Equals(myAddr) {
+						return nil, errors.New("test - ignore")
+					}
+					return captureSudos(&capturedSudoCalls)(ctx, contractAddress, msg)
+				}
+			},
+			expSudoCalls: []tuple{{addr: myOtherAddr, msg: []byte(`{"scheduled_callback":{"id":"other-id"}}`)}},
+		},
+		"quarantined after failure": {
+			callbacks: []types.ScheduledCallback{myCallback},
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					panic("testing")
+				}
+				m.TrackCallbackFailureFn = func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool {
+					assert.Equal(t, types.PrivilegeTypeScheduledCallback, privilegeType)
+					return true
+				}
+			},
+			expQuarantine: []sdk.AccAddress{myAddr},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			capturedSudoCalls = nil
+			var gotQuarantine []sdk.AccAddress
+			mock := MockSudoer{
+				IteratePrivilegedContractsByTypeFn: endBlockTypeIterateContractsFn(t, nil, nil),
+				PopDueScheduledCallbacksFn: func(ctx sdk.Context, max int) []types.ScheduledCallback {
+					assert.Equal(t, MaxScheduledCallbacksPerBlock, max)
+					return spec.callbacks
+				},
+				HasPrivilegedContractFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType types.PrivilegeType) (bool, error) {
+					assert.Equal(t, types.PrivilegeTypeScheduledCallback, privilegeType)
+					return true, nil
+				},
+				QuarantinePrivilegeFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error {
+					assert.Equal(t, types.PrivilegeTypeScheduledCallback, privilegeType)
+					gotQuarantine = append(gotQuarantine, contractAddr)
+					return nil
+				},
+			}
+			if spec.setup != nil {
+				spec.setup(&mock)
+			}
+			ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
+				WithMultiStore(&mockCommitMultiStore{}).
+				WithEventManager(sdk.NewEventManager())
+
+			// when
+			EndBlocker(ctx, &mock)
+
+			// then
+			require.Len(t, capturedSudoCalls, len(spec.expSudoCalls))
+			for i, v := range spec.expSudoCalls {
+				require.Equal(t, v.addr, capturedSudoCalls[i].addr)
+				exp, got := string(v.msg), string(capturedSudoCalls[i].msg)
+				assert.JSONEq(t, exp, got, "expected %q but got %q", exp, got)
+			}
+			assert.Equal(t, spec.expQuarantine, gotQuarantine)
+		})
+	}
+}
+
+func TestEndBlockTimelockedGovProposals(t *testing.T) {
+	proposals := []types.TimelockedGovProposal{{ID: 1}, {ID: 2}, {ID: 3}}
+	specs := map[string]struct {
+		executeFn  func(ctx sdk.Context, proposal types.TimelockedGovProposal) error
+		expExecute []uint64
+	}{
+		"all executed": {
+			executeFn: func(ctx sdk.Context, proposal types.TimelockedGovProposal) error {
+				return nil
+			},
+			expExecute: []uint64{1, 2, 3},
+		},
+		"error handled": {
+			executeFn: func(ctx sdk.Context, proposal types.TimelockedGovProposal) error {
+				if proposal.ID == 1 {
+					return errors.New("testing")
+				}
+				return nil
+			},
+			expExecute: []uint64{1, 2, 3},
+		},
+		"panic handled": {
+			executeFn: func(ctx sdk.Context, proposal types.TimelockedGovProposal) error {
+				if proposal.ID == 2 {
+					panic("testing")
+				}
+				return nil
+			},
+			expExecute: []uint64{1, 2, 3},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotExecuted []uint64
+			mock := MockSudoer{
+				IteratePrivilegedContractsByTypeFn: endBlockTypeIterateContractsFn(t, nil, nil),
+				PopDueTimelockedGovProposalsFn: func(ctx sdk.Context, max int) []types.TimelockedGovProposal {
+					assert.Equal(t, MaxTimelockedGovProposalsPerBlock, max)
+					return proposals
+				},
+				ExecuteTimelockedGovProposalFn: func(ctx sdk.Context, proposal types.TimelockedGovProposal) error {
+					gotExecuted = append(gotExecuted, proposal.ID)
+					return spec.executeFn(ctx, proposal)
+				},
+			}
+			ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
+				WithMultiStore(&mockCommitMultiStore{}).
+				WithEventManager(sdk.NewEventManager())
+
+			// when
+			EndBlocker(ctx, &mock)
+
+			// then
+			assert.Equal(t, spec.expExecute, gotExecuted)
+		})
+	}
+}
+
+func TestNotifyPrivilegeExtensions(t *testing.T) {
+	var capturedSudoCalls []tuple
+	myAddr := keeper.RandomAddress(t)
+	privileges := keeper.NewPrivilegeRegistry()
+	myMsg := []byte(`{"my_callback":{}}`)
+	myPrivilegeType := privileges.Register(keeper.PrivilegeExtension{
+		ID:              0xf1,
+		Name:            "testing_abci",
+		EndBlockSudoMsg: func(ctx sdk.Context) ([]byte, error) { return myMsg, nil },
+	})
+	privileges.Register(keeper.PrivilegeExtension{
+		ID:              0xf2,
+		Name:            "testing_abci_skipped",
+		EndBlockSudoMsg: func(ctx sdk.Context) ([]byte, error) { return nil, nil },
+	})
+	privileges.Register(keeper.PrivilegeExtension{
+		ID:              0xf3,
+		Name:            "testing_abci_failing",
+		EndBlockSudoMsg: func(ctx sdk.Context) ([]byte, error) { return nil, errors.New("testing") },
+	})
+	privileges.Register(keeper.PrivilegeExtension{ID: 0xf4, Name: "testing_abci_no_callback"})
+
+	mock := MockSudoer{
+		SudoFn:                             captureSudos(&capturedSudoCalls),
+		IteratePrivilegedContractsByTypeFn: iterateContractsFn(t, myPrivilegeType, myAddr),
+	}
+	ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
+		WithMultiStore(&mockCommitMultiStore{}).
+		WithEventManager(sdk.NewEventManager())
+
+	// when
+	NotifyPrivilegeExtensions(ctx, &mock, privileges)
+
+	// then
+	require.Len(t, capturedSudoCalls, 1)
+	assert.Equal(t, myAddr, capturedSudoCalls[0].addr)
+	assert.Equal(t, myMsg, capturedSudoCalls[0].msg)
+}
+
+func TestNotifyPrivilegeExtensionsOnBeginBlock(t *testing.T) {
+	var capturedSudoCalls []tuple
+	myAddr := keeper.RandomAddress(t)
+	privileges := keeper.NewPrivilegeRegistry()
+	myMsg := []byte(`{"my_begin_block_callback":{}}`)
+	myPrivilegeType := privileges.Register(keeper.PrivilegeExtension{
+		ID:                0xf5,
+		Name:              "testing_abci_begin_block",
+		BeginBlockSudoMsg: func(ctx sdk.Context) ([]byte, error) { return myMsg, nil },
+		EndBlockSudoMsg:   func(ctx sdk.Context) ([]byte, error) { return []byte(`{"not":"expected"}`), nil },
+	})
+
+	mock := MockSudoer{
+		SudoFn:                             captureSudos(&capturedSudoCalls),
+		IteratePrivilegedContractsByTypeFn: iterateContractsFn(t, myPrivilegeType, myAddr),
+	}
+	ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
+		WithMultiStore(&mockCommitMultiStore{}).
+		WithEventManager(sdk.NewEventManager())
+
+	// when
+	NotifyPrivilegeExtensionsOnBeginBlock(ctx, &mock, privileges)
+
+	// then
+	require.Len(t, capturedSudoCalls, 1)
+	assert.Equal(t, myAddr, capturedSudoCalls[0].addr)
+	assert.Equal(t, myMsg, capturedSudoCalls[0].msg)
+}
+
+func TestCallbackFailureTracking(t *testing.T) {
+	var (
+		myAddr      = keeper.RandomAddress(t)
+		myOtherAddr = keeper.RandomAddress(t)
+	)
+	failingSudoFn := func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+		if contractAddress.Equals(myAddr) {
+			return nil, errors.New("testing")
+		}
+		return nil, nil
+	}
+	panicSudoFn := func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+		if contractAddress.Equals(myAddr) {
+			panic("testing")
+		}
+		return nil, nil
+	}
+	specs := map[string]struct {
+		sudoFn           func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+		thresholdReached bool
+		quarantineErr    error
+		expFailed        []sdk.AccAddress
+		expReset         []sdk.AccAddress
+		expQuarantined   []sdk.AccAddress
+	}{
+		"all succeed": {
+			sudoFn:   func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) { return nil, nil },
+			expReset: []sdk.AccAddress{myAddr, myOtherAddr},
+		},
+		"error tracked": {
+			sudoFn:    failingSudoFn,
+			expFailed: []sdk.AccAddress{myAddr},
+			expReset:  []sdk.AccAddress{myOtherAddr},
+		},
+		"panic tracked": {
+			sudoFn:    panicSudoFn,
+			expFailed: []sdk.AccAddress{myAddr},
+			expReset:  []sdk.AccAddress{myOtherAddr},
+		},
+		"threshold reached": {
+			sudoFn:           failingSudoFn,
+			thresholdReached: true,
+			expFailed:        []sdk.AccAddress{myAddr},
+			expReset:         []sdk.AccAddress{myOtherAddr},
+			expQuarantined:   []sdk.AccAddress{myAddr},
+		},
+		"quarantine error handled": {
+			sudoFn:           failingSudoFn,
+			thresholdReached: true,
+			quarantineErr:    errors.New("testing"),
+			expFailed:        []sdk.AccAddress{myAddr},
+			expReset:         []sdk.AccAddress{myOtherAddr},
+			expQuarantined:   []sdk.AccAddress{myAddr},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotFailed, gotReset, gotQuarantined []sdk.AccAddress
+			iterating := false
+			mock := MockSudoer{
+				SudoFn: spec.sudoFn,
+				IteratePrivilegedContractsByTypeFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool) {
+					iterating = true
+					defer func() { iterating = false }()
+					iterateContractsFn(t, types.PrivilegeTypeBeginBlock, myAddr, myOtherAddr)(ctx, privilegeType, cb)
+				},
+				TrackCallbackFailureFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool {
+					gotFailed = append(gotFailed, contractAddr)
+					return spec.thresholdReached
+				},
+				ResetCallbackFailuresFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) {
+					gotReset = append(gotReset, contractAddr)
+				},
+				QuarantinePrivilegeFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error {
+					assert.False(t, iterating, "must not modify store while iterating")
+					assert.Equal(t, types.PrivilegeTypeBeginBlock, privilegeType)
+					gotQuarantined = append(gotQuarantined, contractAddr)
+					return spec.quarantineErr
+				},
+			}
+			ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
+				WithMultiStore(&mockCommitMultiStore{}).
+				WithEventManager(sdk.NewEventManager())
+
+			// when
+			BeginBlocker(ctx, &mock, abci.RequestBeginBlock{})
+
+			// then
+			assert.Equal(t, spec.expFailed, gotFailed)
+			assert.Equal(t, spec.expReset, gotReset)
+			assert.Equal(t, spec.expQuarantined, gotQuarantined)
+		})
+	}
+}
+
+func TestExecuteWithGasLimit(t *testing.T) {
+	specs := map[string]struct {
+		gasLimit   sdk.Gas
+		consume    sdk.Gas
+		cbErr      error
+		expErr     error
+		expGasUsed sdk.Gas
+	}{
+		"within limit": {
+			gasLimit:   100,
+			consume:    100,
+			expGasUsed: 100,
+		},
+		"out of gas": {
+			gasLimit: 100,
+			consume:  101,
+			expErr:   sdkerrors.ErrOutOfGas,
+		},
+		"no limit": {
+			consume:    math.MaxUint64 - 1,
+			expGasUsed: math.MaxUint64 - 1,
+		},
+		"callback error returned": {
+			gasLimit:   100,
+			cbErr:      errors.New("testing"),
+			expErr:     errors.New("testing"),
+			expGasUsed: 0,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gasUsed sdk.Gas
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			gotErr := ExecuteWithGasLimit(ctx, spec.gasLimit, func(ctx sdk.Context) error {
+				defer func() { gasUsed = ctx.GasMeter().GasConsumed() }()
+				ctx.GasMeter().ConsumeGas(spec.consume, "testing")
+				return spec.cbErr
+			})
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				if sdkerrors.IsOf(spec.expErr, sdkerrors.ErrOutOfGas) {
+					assert.True(t, sdkerrors.ErrOutOfGas.Is(gotErr))
+				}
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expGasUsed, gasUsed)
+		})
+	}
+}
+
 func iterateContractsFn(t *testing.T, expType types.PrivilegeType, addrs ...sdk.AccAddress) func(ctx sdk.Context, callbackType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool) {
 	return func(ctx sdk.Context, callbackType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool) {
 		require.Equal(t, expType, callbackType)
@@ -318,6 +763,23 @@ func captureSudos(capturedSudoCalls *[]tuple) func(ctx sdk.Context, contractAddr
 type MockSudoer struct {
 	SudoFn                             func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	IteratePrivilegedContractsByTypeFn func(ctx sdk.Context, privilegeType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	CallbackGasLimitFn                 func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) sdk.Gas
+	TrackCallbackFailureFn             func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool
+	ResetCallbackFailuresFn            func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress)
+	QuarantinePrivilegeFn              func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error
+	HasPrivilegedContractFn            func(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType types.PrivilegeType) (bool, error)
+	PopDueScheduledCallbacksFn         func(ctx sdk.Context, max int) []types.ScheduledCallback
+	GetTWasmParamsFn                   func(ctx sdk.Context) types.TWasmParams
+	PopDueTimelockedGovProposalsFn     func(ctx sdk.Context, max int) []types.TimelockedGovProposal
+	ExecuteTimelockedGovProposalFn     func(ctx sdk.Context, proposal types.TimelockedGovProposal) error
+}
+
+// GetTWasmParams returns the default params when no custom function is set
+func (m MockSudoer) GetTWasmParams(ctx sdk.Context) types.TWasmParams {
+	if m.GetTWasmParamsFn == nil {
+		return types.DefaultTWasmParams()
+	}
+	return m.GetTWasmParamsFn(ctx)
 }
 
 func (m MockSudoer) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
@@ -334,6 +796,14 @@ func (m MockSudoer) IteratePrivilegedContractsByType(ctx sdk.Context, privilegeT
 	m.IteratePrivilegedContractsByTypeFn(ctx, privilegeType, cb)
 }
 
+// CallbackGasLimit returns 0 for no limit when no custom function is set
+func (m MockSudoer) CallbackGasLimit(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) sdk.Gas {
+	if m.CallbackGasLimitFn == nil {
+		return 0
+	}
+	return m.CallbackGasLimitFn(ctx, privilegeType, contractAddr)
+}
+
 type mockCommitMultiStore struct {
 	sdk.CommitMultiStore
 	committed []bool
@@ -352,3 +822,56 @@ type mockCMS struct {
 func (m *mockCMS) Write() {
 	*m.committed = true
 }
+
+// TrackCallbackFailure returns false for no quarantine when no custom function is set
+func (m MockSudoer) TrackCallbackFailure(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool {
+	if m.TrackCallbackFailureFn == nil {
+		return false
+	}
+	return m.TrackCallbackFailureFn(ctx, privilegeType, contractAddr)
+}
+
+// ResetCallbackFailures is a noop when no custom function is set
+func (m MockSudoer) ResetCallbackFailures(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) {
+	if m.ResetCallbackFailuresFn == nil {
+		return
+	}
+	m.ResetCallbackFailuresFn(ctx, privilegeType, contractAddr)
+}
+
+func (m MockSudoer) QuarantinePrivilege(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error {
+	if m.QuarantinePrivilegeFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QuarantinePrivilegeFn(ctx, privilegeType, contractAddr)
+}
+
+func (m MockSudoer) HasPrivilegedContract(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType types.PrivilegeType) (bool, error) {
+	if m.HasPrivilegedContractFn == nil {
+		panic("not expected to be called")
+	}
+	return m.HasPrivilegedContractFn(ctx, contractAddr, privilegeType)
+}
+
+// PopDueScheduledCallbacks returns no callbacks when no custom function is set
+func (m MockSudoer) PopDueScheduledCallbacks(ctx sdk.Context, max int) []types.ScheduledCallback {
+	if m.PopDueScheduledCallbacksFn == nil {
+		return nil
+	}
+	return m.PopDueScheduledCallbacksFn(ctx, max)
+}
+
+// PopDueTimelockedGovProposals returns no proposals when no custom function is set
+func (m MockSudoer) PopDueTimelockedGovProposals(ctx sdk.Context, max int) []types.TimelockedGovProposal {
+	if m.PopDueTimelockedGovProposalsFn == nil {
+		return nil
+	}
+	return m.PopDueTimelockedGovProposalsFn(ctx, max)
+}
+
+func (m MockSudoer) ExecuteTimelockedGovProposal(ctx sdk.Context, proposal types.TimelockedGovProposal) error {
+	if m.ExecuteTimelockedGovProposalFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ExecuteTimelockedGovProposalFn(ctx, proposal)
+}
@@ -3,6 +3,7 @@
 package twasm
 
 import (
+	"github.com/oldfurya/furya/x/twasm/keeper"
 	"github.com/oldfurya/furya/x/twasm/types"
 )
 
@@ -11,3 +12,5 @@ const (
 	StoreKey   = types.StoreKey
 	RouterKey  = types.RouterKey
 )
+
+var ExecuteWithGasLimit = keeper.ExecuteWithGasLimit
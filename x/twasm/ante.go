@@ -0,0 +1,169 @@
+package twasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/oldfurya/furya/x/twasm/contract"
+	"github.com/oldfurya/furya/x/twasm/keeper"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+// MaxTxAnteHookGas is the max gas a contract can consume for a single tx ante hook callback.
+// A lower limit can be set via the callback gas limits.
+const MaxTxAnteHookGas sdk.Gas = 200_000
+
+// TxAnteHookKeeper defines a subset of the twasm keeper
+type TxAnteHookKeeper interface {
+	PrivilegedCallbackKeeper
+}
+
+// TxAnteHookDecorator sends the tx details to all contracts registered for the tx ante hook privilege.
+// The tx is rejected when any contract returns an error. Contracts that exceed the gas limit or panic do not reject
+// the tx but are tracked as failed callbacks and quarantined when the failure threshold is reached.
+// Txs with gov messages only are never sent to the contracts so that a broken hook can always be demoted.
+// The gas consumed by the contracts is charged to the tx.
+// CONTRACT: Tx must implement FeeTx and SigVerifiableTx interfaces
+type TxAnteHookDecorator struct {
+	k TxAnteHookKeeper
+}
+
+// NewTxAnteHookDecorator constructor
+func NewTxAnteHookDecorator(k TxAnteHookKeeper) TxAnteHookDecorator {
+	return TxAnteHookDecorator{k: k}
+}
+
+// AnteHandle calls the tx ante hook contracts
+func (d TxAnteHookDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// collect first to not modify the store while iterating
+	var contracts []sdk.AccAddress
+	d.k.IteratePrivilegedContractsByType(ctx, types.PrivilegeTxAnteHook, func(_ uint8, contractAddr sdk.AccAddress) bool {
+		contracts = append(contracts, contractAddr)
+		return false
+	})
+	if len(contracts) == 0 || isGovTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	msgBz, err := buildTxAnteMsg(tx)
+	if err != nil {
+		return ctx, err
+	}
+	var quarantine []sdk.AccAddress
+	for _, contractAddr := range contracts {
+		rejected, failed := d.callTxAnteHook(ctx, contractAddr, msgBz)
+		if rejected != nil {
+			return ctx, sdkerrors.Wrapf(rejected, "tx ante hook contract %s", contractAddr)
+		}
+		if !failed {
+			d.k.ResetCallbackFailures(ctx, types.PrivilegeTxAnteHook, contractAddr)
+			continue
+		}
+		if d.k.TrackCallbackFailure(ctx, types.PrivilegeTxAnteHook, contractAddr) {
+			quarantine = append(quarantine, contractAddr)
+		}
+	}
+	for _, contractAddr := range quarantine {
+		if err := d.k.QuarantinePrivilege(ctx, types.PrivilegeTxAnteHook, contractAddr); err != nil {
+			keeper.ModuleLogger(ctx).Error("failed to quarantine privilege", "type", types.PrivilegeTxAnteHook.String(), "cause", err, "contract-address", contractAddr)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// callTxAnteHook sends the message to the contract in a cache context that is committed on success. Returns the
+// error when the contract rejected the tx. Out of gas and panics are reported as failed instead.
+func (d TxAnteHookDecorator) callTxAnteHook(ctx sdk.Context, contractAddr sdk.AccAddress, msgBz []byte) (rejected error, failed bool) {
+	gasLimit := d.k.CallbackGasLimit(ctx, types.PrivilegeTxAnteHook, contractAddr)
+	if gasLimit == 0 || gasLimit > MaxTxAnteHookGas {
+		gasLimit = MaxTxAnteHookGas
+	}
+	var gasUsed sdk.Gas
+	defer func() { ctx.GasMeter().ConsumeGas(gasUsed, "tx ante hook") }()
+	defer func() {
+		if r := recover(); r != nil {
+			keeper.ModuleLogger(ctx).Error("tx ante hook panicked", "cause", fmt.Sprintf("%v", r), "contract-address", contractAddr)
+			rejected, failed = nil, true
+		}
+	}()
+	cacheCtx, commit := ctx.CacheContext()
+	err := ExecuteWithGasLimit(cacheCtx, gasLimit, func(hookCtx sdk.Context) error {
+		defer func() { gasUsed = hookCtx.GasMeter().GasConsumedToLimit() }()
+		_, err := d.k.Sudo(hookCtx, contractAddr, msgBz)
+		return err
+	})
+	switch {
+	case err == nil:
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return nil, false
+	case sdkerrors.ErrOutOfGas.Is(err):
+		ReportCallbackOutOfGas(ctx, types.PrivilegeTxAnteHook, contractAddr, gasLimit)
+		return nil, true
+	default:
+		return err, false
+	}
+}
+
+// isGovTx returns true when the tx contains gov proposal, deposit and vote messages only
+func isGovTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	for _, m := range msgs {
+		switch m.(type) {
+		case *govtypes.MsgSubmitProposal, *govtypes.MsgDeposit, *govtypes.MsgVote, *govtypes.MsgVoteWeighted:
+		default:
+			return false
+		}
+	}
+	return len(msgs) != 0
+}
+
+func buildTxAnteMsg(tx sdk.Tx) ([]byte, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a SigVerifiableTx")
+	}
+	signers := sigTx.GetSigners()
+	msg := contract.TxAnte{
+		Signers:     make([]string, len(signers)),
+		MsgTypeURLs: make([]string, len(tx.GetMsgs())),
+		Fee:         wasmkeeper.ConvertSdkCoinsToWasmCoins(feeTx.GetFee()),
+	}
+	for i, s := range signers {
+		msg.Signers[i] = s.String()
+	}
+	for i, m := range tx.GetMsgs() {
+		msg.MsgTypeURLs[i] = sdk.MsgTypeURL(m)
+	}
+	bz, err := json.Marshal(contract.PetriSudoMsg{TxAnte: &msg})
+	return bz, sdkerrors.Wrap(err, "marshal sudo msg")
+}
+
+// TxSignersDecorator stores the tx signers in the context so that the contract message handlers can check
+// the consent of an account.
+// CONTRACT: Tx must implement SigVerifiableTx interface
+type TxSignersDecorator struct{}
+
+// NewTxSignersDecorator constructor
+func NewTxSignersDecorator() TxSignersDecorator {
+	return TxSignersDecorator{}
+}
+
+// AnteHandle sets the tx signers in the context
+func (d TxSignersDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a SigVerifiableTx")
+	}
+	return next(types.WithTxSigners(ctx, sigTx.GetSigners()), tx, simulate)
+}
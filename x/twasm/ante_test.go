@@ -0,0 +1,232 @@
+package twasm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/oldfurya/furya/x/twasm/keeper"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+func TestTxAnteHookDecorator(t *testing.T) {
+	var (
+		capturedSudoCalls []tuple
+		myAddr            = keeper.RandomAddress(t)
+		myOtherAddr       = keeper.RandomAddress(t)
+		signer            = keeper.RandomAddress(t)
+	)
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	govtypes.RegisterInterfaces(interfaceRegistry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), authtx.DefaultSignModes)
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(signer, myAddr, sdk.NewCoins(sdk.NewInt64Coin("ufury", 1)))))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("ufury", 2)))
+	myTx := txBuilder.GetTx()
+	govTxBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, govTxBuilder.SetMsgs(govtypes.NewMsgVote(signer, 1, govtypes.OptionYes)))
+	myGovTx := govTxBuilder.GetTx()
+	alwaysFails := func(m *MockSudoer) {
+		m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			capturedSudoCalls = append(capturedSudoCalls, tuple{addr: contractAddress, msg: msg})
+			return nil, sdkerrors.ErrUnauthorized.Wrap("testing")
+		}
+		m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTxAnteHook, myAddr)
+	}
+	expMsg := []byte(`{"tx_ante":{"signers":["` + signer.String() + `"],"msg_type_urls":["/cosmos.bank.v1beta1.MsgSend"],"fee":[{"denom":"ufury","amount":"2"}]}}`)
+
+	specs := map[string]struct {
+		setup         func(m *MockSudoer)
+		tx            sdk.Tx
+		expSudoCalls  []tuple
+		expGasUsed    sdk.Gas
+		expErr        *sdkerrors.Error
+		expFailed     []sdk.AccAddress
+		expQuarantine []sdk.AccAddress
+	}{
+		"no contracts registered": {
+			setup: func(m *MockSudoer) {
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTxAnteHook)
+			},
+		},
+		"all contracts accept": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = captureSudos(&capturedSudoCalls)
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTxAnteHook, myAddr, myOtherAddr)
+			},
+			expSudoCalls: []tuple{{addr: myAddr, msg: expMsg}, {addr: myOtherAddr, msg: expMsg}},
+		},
+		"rejected by contract": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					capturedSudoCalls = append(capturedSudoCalls, tuple{addr: contractAddress, msg: msg})
+					return nil, sdkerrors.ErrUnauthorized.Wrap("testing")
+				}
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTxAnteHook, myAddr, myOtherAddr)
+			},
+			expSudoCalls: []tuple{{addr: myAddr, msg: expMsg}},
+			expErr:       sdkerrors.ErrUnauthorized,
+		},
+		"gas consumed by contract charged": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					ctx.GasMeter().ConsumeGas(123, "testing")
+					return nil, nil
+				}
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTxAnteHook, myAddr)
+			},
+			expGasUsed: 123,
+		},
+		"custom gas limit exceeded": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					ctx.GasMeter().ConsumeGas(101, "testing")
+					return nil, nil
+				}
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTxAnteHook, myAddr)
+				m.CallbackGasLimitFn = func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) sdk.Gas {
+					return 100
+				}
+			},
+			expGasUsed: 100,
+			expFailed:  []sdk.AccAddress{myAddr},
+		},
+		"max gas limit exceeded": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					ctx.GasMeter().ConsumeGas(MaxTxAnteHookGas+1, "testing")
+					return nil, nil
+				}
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTxAnteHook, myAddr)
+				m.CallbackGasLimitFn = func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) sdk.Gas {
+					return MaxTxAnteHookGas + 2
+				}
+			},
+			expGasUsed: MaxTxAnteHookGas,
+			expFailed:  []sdk.AccAddress{myAddr},
+		},
+		"contract panics": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					panic("testing")
+				}
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTxAnteHook, myAddr, myOtherAddr)
+			},
+			expFailed: []sdk.AccAddress{myAddr, myOtherAddr},
+		},
+		"failure threshold reached": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					ctx.GasMeter().ConsumeGas(MaxTxAnteHookGas+1, "testing")
+					return nil, nil
+				}
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTxAnteHook, myAddr)
+				m.TrackCallbackFailureFn = func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool {
+					return true
+				}
+			},
+			expGasUsed:    MaxTxAnteHookGas,
+			expFailed:     []sdk.AccAddress{myAddr},
+			expQuarantine: []sdk.AccAddress{myAddr},
+		},
+		"gov vote passes failing contract": {
+			setup: alwaysFails,
+			tx:    myGovTx,
+		},
+		"bank send rejected by failing contract": {
+			setup:        alwaysFails,
+			expSudoCalls: []tuple{{addr: myAddr, msg: expMsg}},
+			expErr:       sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			capturedSudoCalls = nil
+			var capturedFailed, capturedQuarantine []sdk.AccAddress
+			mock := MockSudoer{
+				TrackCallbackFailureFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool {
+					return false
+				},
+				QuarantinePrivilegeFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error {
+					require.Equal(t, types.PrivilegeTxAnteHook, privilegeType)
+					capturedQuarantine = append(capturedQuarantine, contractAddr)
+					return nil
+				},
+			}
+			spec.setup(&mock)
+			trackFn := mock.TrackCallbackFailureFn
+			mock.TrackCallbackFailureFn = func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool {
+				require.Equal(t, types.PrivilegeTxAnteHook, privilegeType)
+				capturedFailed = append(capturedFailed, contractAddr)
+				return trackFn(ctx, privilegeType, contractAddr)
+			}
+			ctx := sdk.Context{}.
+				WithLogger(log.TestingLogger()).
+				WithMultiStore(&mockCommitMultiStore{}).
+				WithEventManager(sdk.NewEventManager()).
+				WithGasMeter(sdk.NewInfiniteGasMeter())
+			tx := spec.tx
+			if tx == nil {
+				tx = myTx
+			}
+			var nextCalled bool
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+
+			// when
+			_, gotErr := NewTxAnteHookDecorator(mock).AnteHandle(ctx, tx, false, next)
+
+			// then
+			assert.Equal(t, spec.expSudoCalls, capturedSudoCalls)
+			assert.Equal(t, spec.expGasUsed, ctx.GasMeter().GasConsumed())
+			assert.Equal(t, spec.expFailed, capturedFailed)
+			assert.Equal(t, spec.expQuarantine, capturedQuarantine)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				assert.False(t, nextCalled)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, nextCalled)
+		})
+	}
+}
+
+func TestTxSignersDecorator(t *testing.T) {
+	signer, otherSigner := keeper.RandomAddress(t), keeper.RandomAddress(t)
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), authtx.DefaultSignModes)
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(
+		banktypes.NewMsgSend(signer, otherSigner, sdk.NewCoins(sdk.NewInt64Coin("ufury", 1))),
+		banktypes.NewMsgSend(otherSigner, signer, sdk.NewCoins(sdk.NewInt64Coin("ufury", 1))),
+	))
+	myTx := txBuilder.GetTx()
+
+	ctx := sdk.Context{}.WithContext(context.Background())
+	assert.Nil(t, types.TxSigners(ctx))
+	var gotSigners []sdk.AccAddress
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		gotSigners = types.TxSigners(ctx)
+		return ctx, nil
+	}
+	// when
+	_, gotErr := NewTxSignersDecorator().AnteHandle(ctx, myTx, false, next)
+	// then
+	require.NoError(t, gotErr)
+	assert.Equal(t, []sdk.AccAddress{signer, otherSigner}, gotSigners)
+}
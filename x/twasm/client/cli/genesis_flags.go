@@ -1,12 +1,19 @@
 package cli
 
 import (
+	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"strconv"
 
+	wasmcli "github.com/CosmWasm/wasmd/x/wasm/client/cli"
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
@@ -14,6 +21,13 @@ import (
 	"github.com/oldfurya/furya/x/twasm/types"
 )
 
+const (
+	flagRunAs   = "run-as"
+	flagLabel   = "label"
+	flagAdmin   = "admin"
+	flagNoAdmin = "no-admin"
+)
+
 // GenesisSetPrivileged cli command to enable privileges for a contract in the genesis
 func GenesisSetPrivileged(defaultNodeHome string, genesisMutator *GenesisIO) *cobra.Command {
 	cmd := &cobra.Command{
@@ -65,3 +79,148 @@ func GenesisSetPinned(defaultNodeHome string, genesisMutator *GenesisIO) *cobra.
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	return cmd
 }
+
+// GenesisAddPrivilegedContract returns a cli command to store the wasm code and instantiate a contract via genesis
+// messages. The new contract is set privileged and its code pinned.
+func GenesisAddPrivilegedContract(defaultNodeHome string, genesisMutator *GenesisIO) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-privileged-contract [wasm_file] [json_encoded_init_args] --run-as [address_or_key_name] --label [text] --admin [address,optional]",
+		Short: "Store, instantiate and set a privileged contract in the genesis",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			senderAddr, err := getActorAddress(cmd)
+			if err != nil {
+				return err
+			}
+			storeMsg, err := parseStoreCodeArgs(args[0], senderAddr)
+			if err != nil {
+				return err
+			}
+			if err := storeMsg.ValidateBasic(); err != nil {
+				return sdkerrors.Wrap(err, "store code")
+			}
+			instantiateMsg, err := parseInstantiateArgs(args[1], senderAddr, cmd)
+			if err != nil {
+				return err
+			}
+
+			var result struct {
+				CodeID          uint64 `json:"code_id"`
+				ContractAddress string `json:"contract_address"`
+			}
+			err = genesisMutator.AlterTWasmModuleState(cmd, func(state *types.GenesisState, _ map[string]json.RawMessage) error {
+				wasmState := state.RawWasmState()
+				wasmState.GenMsgs = append(wasmState.GenMsgs, wasmtypes.GenesisState_GenMsgs{
+					Sum: &wasmtypes.GenesisState_GenMsgs_StoreCode{StoreCode: &storeMsg},
+				})
+				codes := wasmcli.GetAllCodes(&wasmState)
+				result.CodeID = codes[len(codes)-1].CodeID
+
+				instantiateMsg.CodeID = result.CodeID
+				if err := instantiateMsg.ValidateBasic(); err != nil {
+					return sdkerrors.Wrap(err, "instantiate contract")
+				}
+				wasmState.GenMsgs = append(wasmState.GenMsgs, wasmtypes.GenesisState_GenMsgs{
+					Sum: &wasmtypes.GenesisState_GenMsgs_InstantiateContract{InstantiateContract: &instantiateMsg},
+				})
+				contracts := wasmcli.GetAllContracts(&wasmState)
+				result.ContractAddress = contracts[len(contracts)-1].ContractAddress
+
+				state.GenMsgs = wasmState.GenMsgs
+				state.PrivilegedContractAddresses = append(state.PrivilegedContractAddresses, result.ContractAddress)
+				state.PinnedCodeIDs = append(state.PinnedCodeIDs, result.CodeID)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(result, "", " ")
+			if err != nil {
+				return err
+			}
+			return client.GetClientContextFromCmd(cmd).PrintString(string(bz) + "\n")
+		},
+	}
+	cmd.Flags().String(flagRunAs, "", "The address that is stored as code and contract creator")
+	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().String(flagAdmin, "", "Address of an admin")
+	cmd.Flags().Bool(flagNoAdmin, false, "You must set this explicitly if you don't want an admin")
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	return cmd
+}
+
+// parseStoreCodeArgs reads the wasm file and returns the store code message with the gzipped byte code
+func parseStoreCodeArgs(file string, sender sdk.AccAddress) (wasmtypes.MsgStoreCode, error) {
+	wasm, err := os.ReadFile(file)
+	if err != nil {
+		return wasmtypes.MsgStoreCode{}, err
+	}
+	if ioutils.IsWasm(wasm) {
+		if wasm, err = ioutils.GzipIt(wasm); err != nil {
+			return wasmtypes.MsgStoreCode{}, err
+		}
+	} else if !ioutils.IsGzip(wasm) {
+		return wasmtypes.MsgStoreCode{}, errors.New("invalid input file. Use wasm binary or gzip")
+	}
+	return wasmtypes.MsgStoreCode{Sender: sender.String(), WASMByteCode: wasm}, nil
+}
+
+// parseInstantiateArgs returns the instantiate message without code id
+func parseInstantiateArgs(initMsg string, sender sdk.AccAddress, cmd *cobra.Command) (wasmtypes.MsgInstantiateContract, error) {
+	label, err := cmd.Flags().GetString(flagLabel)
+	if err != nil {
+		return wasmtypes.MsgInstantiateContract{}, fmt.Errorf("label: %w", err)
+	}
+	if label == "" {
+		return wasmtypes.MsgInstantiateContract{}, errors.New("label is required on all contracts")
+	}
+	admin, err := cmd.Flags().GetString(flagAdmin)
+	if err != nil {
+		return wasmtypes.MsgInstantiateContract{}, fmt.Errorf("admin: %w", err)
+	}
+	noAdmin, err := cmd.Flags().GetBool(flagNoAdmin)
+	if err != nil {
+		return wasmtypes.MsgInstantiateContract{}, fmt.Errorf("no-admin: %w", err)
+	}
+	switch {
+	case admin == "" && !noAdmin:
+		return wasmtypes.MsgInstantiateContract{}, errors.New("you must set an admin or explicitly pass --no-admin to make it immutable")
+	case admin != "" && noAdmin:
+		return wasmtypes.MsgInstantiateContract{}, errors.New("you set an admin and passed --no-admin, those cannot both be true")
+	}
+	return wasmtypes.MsgInstantiateContract{
+		Sender: sender.String(),
+		Admin:  admin,
+		Label:  label,
+		Msg:    wasmtypes.RawContractMessage(initMsg),
+	}, nil
+}
+
+// getActorAddress returns the address of the run-as flag. Key names are resolved via the keyring.
+func getActorAddress(cmd *cobra.Command) (sdk.AccAddress, error) {
+	actorArg, err := cmd.Flags().GetString(flagRunAs)
+	if err != nil {
+		return nil, fmt.Errorf("run-as: %w", err)
+	}
+	if actorArg == "" {
+		return nil, errors.New("run-as address is required")
+	}
+	if actorAddr, err := sdk.AccAddressFromBech32(actorArg); err == nil {
+		return actorAddr, nil
+	}
+	keyringBackend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+	if err != nil {
+		return nil, err
+	}
+	homeDir := client.GetClientContextFromCmd(cmd).HomeDir
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyringBackend, homeDir, bufio.NewReader(cmd.InOrStdin()))
+	if err != nil {
+		return nil, err
+	}
+	info, err := kb.Key(actorArg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address from keyring: %w", err)
+	}
+	return info.GetAddress(), nil
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/oldfurya/furya/x/twasm/keeper"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+func TestGenesisAddPrivilegedContract(t *testing.T) {
+	wasmFile := filepath.Join(t.TempDir(), "contract.wasm")
+	require.NoError(t, os.WriteFile(wasmFile, append([]byte("\x00asm"), []byte("my contract")...), 0o600))
+	myAddr := keeper.RandomAddress(t)
+
+	specs := map[string]struct {
+		initMsg string
+		expErr  bool
+	}{
+		"all good": {
+			initMsg: `{}`,
+		},
+		"invalid init msg": {
+			initMsg: `not json`,
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			homeDir := setupGenesis(t, types.GenesisState{
+				Params:      wasmtypes.DefaultParams(),
+				TWasmParams: types.DefaultTWasmParams(),
+			})
+			genFile := filepath.Join(homeDir, "config", "genesis.json")
+			srcGenesis, err := os.ReadFile(genFile)
+			require.NoError(t, err)
+
+			cmd := GenesisAddPrivilegedContract(homeDir, NewGenesisIO())
+			cmd.SetArgs([]string{wasmFile, spec.initMsg, "--run-as=" + myAddr.String(), "--label=testing", "--no-admin"})
+			var out bytes.Buffer
+
+			// when
+			gotErr := executeCmdWithContext(t, homeDir, cmd, &out)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				gotGenesis, err := os.ReadFile(genFile)
+				require.NoError(t, err)
+				assert.Equal(t, srcGenesis, gotGenesis, "genesis must not be modified")
+				return
+			}
+			require.NoError(t, gotErr)
+			var result struct {
+				CodeID          uint64 `json:"code_id"`
+				ContractAddress string `json:"contract_address"`
+			}
+			require.NoError(t, json.Unmarshal(out.Bytes(), &result))
+			assert.Equal(t, uint64(1), result.CodeID)
+
+			state := loadModuleState(t, homeDir)
+			assert.Equal(t, []string{result.ContractAddress}, state.PrivilegedContractAddresses)
+			assert.Equal(t, []uint64{result.CodeID}, state.PinnedCodeIDs)
+			require.Len(t, state.GenMsgs, 2)
+
+			// and the predicted address matches the contract created on genesis import
+			mock := keeper.NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+				m.PinFn = func(checksum cosmwasm.Checksum) error { return nil }
+				m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					return &wasmvmtypes.Response{}, 0, nil
+				}
+			})
+			ctx, keepers := keeper.CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+			k := keepers.TWasmKeeper
+			msgHandler := wasm.NewHandler(wasmkeeper.NewDefaultPermissionKeeper(k))
+			_, err = keeper.InitGenesis(ctx, k, state, msgHandler)
+			require.NoError(t, err)
+
+			contractAddr, err := sdk.AccAddressFromBech32(result.ContractAddress)
+			require.NoError(t, err)
+			contractInfo := k.GetContractInfo(ctx, contractAddr)
+			require.NotNil(t, contractInfo)
+			assert.Equal(t, result.CodeID, contractInfo.CodeID)
+			assert.Equal(t, "testing", contractInfo.Label)
+			assert.True(t, k.IsPrivileged(ctx, contractAddr))
+			assert.True(t, k.IsPinnedCode(ctx, result.CodeID))
+		})
+	}
+}
+
+func setupGenesis(t *testing.T, twasmGenesis types.GenesisState) string {
+	t.Helper()
+	appCodec := keeper.MakeEncodingConfig(t).Codec
+	homeDir := t.TempDir()
+
+	require.NoError(t, os.Mkdir(filepath.Join(homeDir, "config"), 0o700))
+	appState := map[string]json.RawMessage{types.ModuleName: appCodec.MustMarshalJSON(&twasmGenesis)}
+	appStateBz, err := json.Marshal(appState)
+	require.NoError(t, err)
+	genDoc := tmtypes.GenesisDoc{ChainID: "testing", AppState: appStateBz}
+	require.NoError(t, genutil.ExportGenesisFile(&genDoc, filepath.Join(homeDir, "config", "genesis.json")))
+	return homeDir
+}
+
+func executeCmdWithContext(t *testing.T, homeDir string, cmd *cobra.Command, out *bytes.Buffer) error {
+	t.Helper()
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(homeDir)
+	require.NoError(t, err)
+	appCodec := keeper.MakeEncodingConfig(t).Codec
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config = cfg
+	clientCtx := client.Context{}.WithCodec(appCodec).WithHomeDir(homeDir).WithOutput(out)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	return cmd.ExecuteContext(ctx)
+}
+
+func loadModuleState(t *testing.T, homeDir string) types.GenesisState {
+	t.Helper()
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(homeDir, "config", "genesis.json"))
+	require.NoError(t, err)
+	require.Contains(t, appState, types.ModuleName)
+
+	var state types.GenesisState
+	require.NoError(t, keeper.MakeEncodingConfig(t).Codec.UnmarshalJSON(appState[types.ModuleName], &state))
+	return state
+}
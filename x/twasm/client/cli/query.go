@@ -2,11 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	wasmcli "github.com/CosmWasm/wasmd/x/wasm/client/cli"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/oldfurya/furya/x/twasm/types"
@@ -24,6 +26,13 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdShowPrivilegedContracts(),
 		GetCmdListPrivilegedContracts(),
+		GetCmdListCallbackFailures(),
+		GetCmdContractPrivileges(),
+		GetCmdMintAllowance(),
+		GetCmdListScheduledCallbacks(),
+		GetCmdListTimelockedGovProposals(),
+		GetCmdTimelockedGovProposal(),
+		GetCmdListDelegatorGrants(),
 	)
 	// add all wasmd queries
 	queryCmd.AddCommand(wasmcli.GetQueryCmd().Commands()...)
@@ -48,11 +57,17 @@ func GetCmdShowPrivilegedContracts() *cobra.Command {
 				return fmt.Errorf("unknown privilege type: %q", args[0])
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.ContractsByPrivilegeType(
 				cmd.Context(),
 				&types.QueryContractsByPrivilegeTypeRequest{
 					PrivilegeType: cbt.String(),
+					Pagination:    pageReq,
 				},
 			)
 			if err != nil {
@@ -62,6 +77,7 @@ func GetCmdShowPrivilegedContracts() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts by privilege type")
 	return cmd
 }
 
@@ -79,10 +95,280 @@ func GetCmdListPrivilegedContracts() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.PrivilegedContracts(
 				cmd.Context(),
-				&types.QueryPrivilegedContractsRequest{},
+				&types.QueryPrivilegedContractsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "privileged contracts")
+	return cmd
+}
+
+// GetCmdListCallbackFailures lists the failure counters of privileged contract callbacks
+func GetCmdListCallbackFailures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-callback-failures",
+		Short:   "List consecutive failures of privileged contract callbacks",
+		Long:    "List the number of consecutive failed callbacks by privileged contract and privilege type",
+		Aliases: []string{"callback-failures", "lcf"},
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CallbackFailures(
+				cmd.Context(),
+				&types.QueryCallbackFailuresRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdContractPrivileges shows the privilege details of a single contract
+func GetCmdContractPrivileges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "privileges <contract_address>",
+		Short:   "Show the privilege details of a contract",
+		Long:    "Show the privileged flag, pinned code status and registered privileges with callback order of a contract",
+		Aliases: []string{"contract-privileges", "cp"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractPrivileges(
+				cmd.Context(),
+				&types.QueryContractPrivilegesRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-allowance <contract_address>",
+		Short:   "Show the mint allowance of a contract",
+		Long:    "Show the mint limits set by governance and the tokens minted so far by a contract",
+		Aliases: []string{"ma"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MintAllowance(
+				cmd.Context(),
+				&types.QueryMintAllowanceRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListScheduledCallbacks lists the pending scheduled callbacks
+func GetCmdListScheduledCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-scheduled-callbacks [contract_address]",
+		Short:   "List pending scheduled callbacks",
+		Long:    "List pending scheduled callbacks of all contracts in execution order or of a single contract by ID",
+		Aliases: []string{"scheduled-callbacks", "lsc"},
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var contractAddr string
+			if len(args) == 1 {
+				if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+					return err
+				}
+				contractAddr = args[0]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledCallbacks(
+				cmd.Context(),
+				&types.QueryScheduledCallbacksRequest{
+					Address:    contractAddr,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled callbacks")
+	return cmd
+}
+
+// GetCmdListTimelockedGovProposals lists the queued gov proposals
+func GetCmdListTimelockedGovProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-queued-gov-proposals",
+		Short:   "List timelocked gov proposals",
+		Long:    "List gov proposals of privileged contracts that are queued for execution in execution order",
+		Aliases: []string{"queued-gov-proposals", "lqgp"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TimelockedGovProposals(
+				cmd.Context(),
+				&types.QueryTimelockedGovProposalsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued gov proposals")
+	return cmd
+}
+
+// GetCmdTimelockedGovProposal shows a queued gov proposal
+func GetCmdTimelockedGovProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "queued-gov-proposal <proposal_id>",
+		Short:   "Show a timelocked gov proposal",
+		Long:    "Show a gov proposal of a privileged contract that is queued for execution",
+		Aliases: []string{"qgp"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TimelockedGovProposal(
+				cmd.Context(),
+				&types.QueryTimelockedGovProposalRequest{
+					Id: id,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListDelegatorGrants lists the delegator grants
+func GetCmdListDelegatorGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-delegator-grants [staker_address]",
+		Short:   "List delegator grants",
+		Long:    "List the authorizations of contracts to delegate staker tokens for all stakers or a single staker",
+		Aliases: []string{"delegator-grants", "ldg"},
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var staker string
+			if len(args) == 1 {
+				if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+					return err
+				}
+				staker = args[0]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DelegatorGrants(
+				cmd.Context(),
+				&types.QueryDelegatorGrantsRequest{
+					Staker:     staker,
+					Pagination: pageReq,
+				},
 			)
 			if err != nil {
 				return err
@@ -91,5 +377,6 @@ func GetCmdListPrivilegedContracts() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegator grants")
 	return cmd
 }
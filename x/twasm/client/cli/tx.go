@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	wasmcli "github.com/CosmWasm/wasmd/x/wasm/client/cli"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+const flagExpiration = "expiration"
+
+// GetTxCmd returns the wasmd tx commands extended by the twasm commands
+func GetTxCmd() *cobra.Command {
+	txCmd := wasmcli.GetTxCmd()
+	txCmd.AddCommand(
+		NewGrantDelegatorCmd(),
+		NewRevokeDelegatorCmd(),
+	)
+	return txCmd
+}
+
+func NewGrantDelegatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-delegator [contract_address]",
+		Short: "Authorize a contract to delegate your tokens",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Authorize a contract with the delegator privilege to delegate your liquid and vesting tokens.
+The optional expiration is a RFC3339 timestamp.
+
+Example:
+$ %s tx wasm grant-delegator <contract_address> --expiration 2023-01-01T00:00:00Z --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("contract address: %w", err)
+			}
+			msg := types.MsgGrantDelegator{
+				Staker:          clientCtx.GetFromAddress().String(),
+				ContractAddress: args[0],
+			}
+			expiration, err := cmd.Flags().GetString(flagExpiration)
+			if err != nil {
+				return err
+			}
+			if expiration != "" {
+				t, err := time.Parse(time.RFC3339, expiration)
+				if err != nil {
+					return fmt.Errorf("expiration: %w", err)
+				}
+				t = t.UTC()
+				msg.Expiration = &t
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().String(flagExpiration, "", "Optional RFC3339 timestamp when the grant ends")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRevokeDelegatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-delegator [contract_address]",
+		Short: "Remove the authorization of a contract to delegate your tokens",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the authorization of a contract to delegate your liquid and vesting tokens.
+
+Example:
+$ %s tx wasm revoke-delegator <contract_address> --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgRevokeDelegator{
+				Staker:          clientCtx.GetFromAddress().String(),
+				ContractAddress: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,7 @@ package contract
 
 import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 )
 
 // PetriSudoMsg callback message sent to a contract.
@@ -21,6 +22,43 @@ type PetriSudoMsg struct {
 	Export *struct{} `json:"export,omitempty"`
 	// Import genesis state
 	Import *wasmtypes.RawContractMessage `json:"import,omitempty"`
+	// ExportPage dump a page of the state for genesis export. Only sent to contracts with the state_page_exporter
+	// privilege, all others are exported with `export`.
+	ExportPage *ExportPage `json:"export_page,omitempty"`
+	// ImportPage import a page of the genesis state
+	ImportPage *ImportPage `json:"import_page,omitempty"`
+
+	// BankSend is delivered before tokens are transferred via the bank module.
+	// The transfer is rejected when the contract returns an error.
+	BankSend *BankSend `json:"bank_send,omitempty"`
+
+	// TxAnte is delivered for every transaction after the signatures were verified.
+	// The transaction is rejected when the contract returns an error.
+	TxAnte *TxAnte `json:"tx_ante,omitempty"`
+
+	// ScheduledCallback is delivered in the end blocker when the height or time of a scheduled callback is reached.
+	ScheduledCallback *ScheduledCallback `json:"scheduled_callback,omitempty"`
+
+	// ValidatorSetChanged is delivered in the end blocker after the validator set was updated.
+	ValidatorSetChanged *ValidatorSetChanged `json:"validator_set_changed,omitempty"`
+}
+
+// ExportPage requests the page of the state that starts at the cursor. The cursor is empty for the first page.
+type ExportPage struct {
+	Cursor []byte `json:"cursor,omitempty"`
+}
+
+// ExportPageResponse is the data returned by the contract for an ExportPage message.
+// The next cursor is empty for the last page.
+type ExportPageResponse struct {
+	Data       wasmtypes.RawContractMessage `json:"data"`
+	NextCursor []byte                       `json:"next_cursor,omitempty"`
+}
+
+// ImportPage contains a page of the state that was exported with ExportPage. Last is set for the final page.
+type ImportPage struct {
+	Data wasmtypes.RawContractMessage `json:"data"`
+	Last bool                         `json:"last"`
 }
 
 // PrivilegeChangeMsg is called on a contract when it is made privileged or demoted
@@ -31,11 +69,74 @@ type PrivilegeChangeMsg struct {
 	Promoted *struct{} `json:"promoted,omitempty"`
 	/// This is called when a contract looses "privileged status"
 	Demoted *struct{} `json:"demoted,omitempty"`
+	/// This is called when a privileged contract was migrated to new code. All privileges were released
+	/// and must be registered again.
+	Migrated *Migrated `json:"migrated,omitempty"`
+}
+
+// Migrated contains the code id before the migration
+type Migrated struct {
+	OldCodeID uint64 `json:"old_code_id"`
+}
+
+// BankSend is delivered on every bank send if the contract is currently registered for the bank send hook.
+// For multi sends with more than one input, either `from` or `to` is empty.
+type BankSend struct {
+	From   string            `json:"from"`
+	To     string            `json:"to"`
+	Amount wasmvmtypes.Coins `json:"amount"`
+}
+
+// TxAnte is delivered for every transaction if the contract is currently registered for the tx ante hook
+type TxAnte struct {
+	// Signers addresses of all signers
+	Signers []string `json:"signers"`
+	// MsgTypeURLs type URL of each message in the transaction
+	MsgTypeURLs []string `json:"msg_type_urls"`
+	// Fee amount paid for the transaction
+	Fee wasmvmtypes.Coins `json:"fee"`
+}
+
+// ScheduledCallback is delivered to the contract that registered the callback
+type ScheduledCallback struct {
+	ID      string `json:"id"`
+	Payload []byte `json:"payload,omitempty"`
+}
+
+// ValidatorSetChanged is delivered to all contracts registered for the validator set listener privilege
+type ValidatorSetChanged struct {
+	Updates []ValidatorSetUpdate `json:"updates"`
+}
+
+// ValidatorSetUpdate is an applied change to the validator set. A power of zero removes the validator.
+// See https://github.com/tendermint/tendermint/blob/v0.34.8/proto/tendermint/abci/types.proto#L343-L346
+type ValidatorSetUpdate struct {
+	// Operator address of the validator. Empty when it can not be resolved
+	Operator string          `json:"operator,omitempty"`
+	PubKey   ValidatorPubkey `json:"pubkey"`
+	Power    uint64          `json:"power"`
+}
+
+// ValidatorPubkey is the tendermint consensus pubkey of a validator
+type ValidatorPubkey struct {
+	Ed25519   []byte `json:"ed25519,omitempty"`
+	Secp256k1 []byte `json:"secp256k1,omitempty"`
 }
 
 // BeginBlock is delivered every block if the contract is currently registered for Begin Block
 type BeginBlock struct {
 	Evidence []Evidence `json:"evidence"` // This is key for slashing - let's figure out a standard for these types
+	// Liveness of the validators in the last commit. Only set when enabled in the twasm params
+	Liveness *Liveness `json:"liveness,omitempty"`
+}
+
+// Liveness contains the validators that signed the last block and the proposer of the current block
+// See https://github.com/tendermint/tendermint/blob/v0.34.8/proto/tendermint/abci/types.proto#L348-L352
+type Liveness struct {
+	// The first 20 bytes of SHA256(public key) of the block proposer
+	ProposerAddress []byte      `json:"proposer_address"`
+	Signers         []Validator `json:"signers"`
+	NonSigners      []Validator `json:"non_signers"`
 }
 
 type EvidenceType string
@@ -15,6 +15,8 @@ import (
 	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/oldfurya/furya/x/twasm/types"
 )
@@ -24,9 +26,13 @@ type PetriMsg struct {
 	Privilege          *PrivilegeMsg          `json:"privilege,omitempty"`
 	ExecuteGovProposal *ExecuteGovProposal    `json:"execute_gov_proposal,omitempty"`
 	MintTokens         *MintTokens            `json:"mint_tokens,omitempty"`
+	BurnTokens         *BurnTokens            `json:"burn_tokens,omitempty"`
 	ConsensusParams    *ConsensusParamsUpdate `json:"consensus_params,omitempty"`
 	Delegate           *Delegate              `json:"delegate,omitempty"`
 	Undelegate         *Undelegate            `json:"undelegate,omitempty"`
+	ScheduleCallback   *ScheduleCallback      `json:"schedule_callback,omitempty"`
+	CancelCallback     *CancelCallback        `json:"cancel_callback,omitempty"`
+	VetoGovProposal    *VetoGovProposal       `json:"veto_gov_proposal,omitempty"`
 }
 
 // UnmarshalWithAny from json to Go objects with cosmos-sdk Any types that have their objects/ interfaces unpacked and
@@ -37,7 +43,7 @@ func (p *PetriMsg) UnmarshalWithAny(bz []byte, unpacker codectypes.AnyUnpacker)
 	}
 	// unpack interfaces in protobuf Any types
 	if p.ExecuteGovProposal != nil {
-		return sdkerrors.Wrap(p.ExecuteGovProposal.unpackInterfaces(unpacker), "execute_gov_proposal")
+		return sdkerrors.Wrap(p.ExecuteGovProposal.UnpackInterfaces(unpacker), "execute_gov_proposal")
 	}
 	return nil
 }
@@ -84,6 +90,10 @@ func (p ExecuteGovProposal) GetProposalContent(sender sdk.AccAddress) govtypes.C
 		p.Proposal.IBCClientUpdate.Title = p.Title
 		p.Proposal.IBCClientUpdate.Description = p.Description
 		return p.Proposal.IBCClientUpdate
+	case p.Proposal.IBCUpgrade != nil:
+		p.Proposal.IBCUpgrade.Title = p.Title
+		p.Proposal.IBCUpgrade.Description = p.Description
+		return p.Proposal.IBCUpgrade
 	case p.Proposal.PromoteToPrivilegedContract != nil:
 		p.Proposal.PromoteToPrivilegedContract.Title = p.Title
 		p.Proposal.PromoteToPrivilegedContract.Description = p.Description
@@ -92,6 +102,14 @@ func (p ExecuteGovProposal) GetProposalContent(sender sdk.AccAddress) govtypes.C
 		p.Proposal.DemotePrivilegedContract.Title = p.Title
 		p.Proposal.DemotePrivilegedContract.Description = p.Description
 		return p.Proposal.DemotePrivilegedContract
+	case p.Proposal.SetPrivilegeCallbackOrder != nil:
+		p.Proposal.SetPrivilegeCallbackOrder.Title = p.Title
+		p.Proposal.SetPrivilegeCallbackOrder.Description = p.Description
+		return p.Proposal.SetPrivilegeCallbackOrder
+	case p.Proposal.SetMintAllowance != nil:
+		p.Proposal.SetMintAllowance.Title = p.Title
+		p.Proposal.SetMintAllowance.Description = p.Description
+		return p.Proposal.SetMintAllowance
 	case p.Proposal.InstantiateContract != nil:
 		p.Proposal.InstantiateContract.Title = p.Title
 		p.Proposal.InstantiateContract.Description = p.Description
@@ -122,22 +140,34 @@ func (p ExecuteGovProposal) GetProposalContent(sender sdk.AccAddress) govtypes.C
 		p.Proposal.UnpinCodes.Title = p.Title
 		p.Proposal.UnpinCodes.Description = p.Description
 		return p.Proposal.UnpinCodes
+	case p.Proposal.SudoContract != nil:
+		p.Proposal.SudoContract.Title = p.Title
+		p.Proposal.SudoContract.Description = p.Description
+		return p.Proposal.SudoContract
+	case p.Proposal.ExecuteContract != nil:
+		p.Proposal.ExecuteContract.Title = p.Title
+		p.Proposal.ExecuteContract.Description = p.Description
+		p.Proposal.ExecuteContract.RunAs = sender.String()
+		return p.Proposal.ExecuteContract
+	case p.Proposal.UpdateInstantiateConfig != nil:
+		p.Proposal.UpdateInstantiateConfig.Title = p.Title
+		p.Proposal.UpdateInstantiateConfig.Description = p.Description
+		return p.Proposal.UpdateInstantiateConfig
 	default:
 		return nil
 	}
 }
 
-// unpackInterfaces unpacks the Any type into the interface type in `Any.cachedValue`
-func (p *ExecuteGovProposal) unpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	var err error
+// UnpackInterfaces unpacks the Any type into the interface type in `Any.cachedValue`
+func (p *ExecuteGovProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	switch { //nolint:gocritic
-	case p.Proposal.RegisterUpgrade != nil:
-		// revisit with https://github.com/oldfurya/furya/issues/364
-		if p.Proposal.RegisterUpgrade.UpgradedClientState != nil { //nolint:staticcheck
-			return sdkerrors.ErrInvalidRequest.Wrap("upgrade logic for IBC has been moved to the IBC module")
+	case p.Proposal.IBCUpgrade != nil:
+		var clientState ibcexported.ClientState
+		if err := unpacker.UnpackAny(p.Proposal.IBCUpgrade.UpgradedClientState, &clientState); err != nil {
+			return sdkerrors.Wrap(err, "upgraded client state")
 		}
 	}
-	return err
+	return nil
 }
 
 // ProtoAny data type to map from json to cosmos-sdk Any type.
@@ -171,31 +201,48 @@ func (p *GovProposal) UnmarshalJSON(b []byte) error {
 	customUnmarshalers := map[string]func(b []byte) error{
 		"ibc_client_update": func(b []byte) error {
 			proxy := struct {
-				ClientID string    `json:"client_id"`
-				Header   *ProtoAny `json:"header"`
+				// ClientID is the legacy name of the subject client id
+				ClientID           string `json:"client_id"`
+				SubjectClientID    string `json:"subject_client_id"`
+				SubstituteClientID string `json:"substitute_client_id"`
 			}{}
 			if err := json.Unmarshal(b, &proxy); err != nil {
 				return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 			}
+			subjectClientID := proxy.SubjectClientID
+			if subjectClientID == "" {
+				subjectClientID = proxy.ClientID
+			}
 			result.IBCClientUpdate = &ibcclienttypes.ClientUpdateProposal{
-				SubjectClientId: proxy.ClientID,
+				SubjectClientId:    subjectClientID,
+				SubstituteClientId: proxy.SubstituteClientID,
 			}
 			return nil
 		},
 		"register_upgrade": func(b []byte) error {
 			proxy := struct {
-				Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-				Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-				Info   string `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+				Name                string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+				Height              int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+				Info                string    `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+				UpgradedClientState *ProtoAny `json:"upgraded_client_state,omitempty"`
 			}{}
 			if err := json.Unmarshal(b, &proxy); err != nil {
 				return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 			}
-			result.RegisterUpgrade = &upgradetypes.Plan{
+			plan := upgradetypes.Plan{
 				Name:   proxy.Name,
 				Height: proxy.Height,
 				Info:   proxy.Info,
 			}
+			if proxy.UpgradedClientState == nil {
+				result.RegisterUpgrade = &plan
+				return nil
+			}
+			// an upgrade that breaks IBC clients is handled by the IBC module since ibc-go v1
+			result.IBCUpgrade = &ibcclienttypes.UpgradeProposal{
+				Plan:                plan,
+				UpgradedClientState: proxy.UpgradedClientState.Encode(),
+			}
 			return nil
 		},
 		"migrate_contract": func(b []byte) error {
@@ -213,7 +260,38 @@ func (p *GovProposal) UnmarshalJSON(b []byte) error {
 				Msg:      proxy.Msg,
 			}
 			return nil
-		}, "instantiate_contract": func(b []byte) error {
+		},
+		"sudo_contract": func(b []byte) error {
+			proxy := struct { // custom type to have the msg base64 encoded like the other contract messages
+				Contract string `json:"contract"`
+				Msg      []byte `json:"sudo_msg"`
+			}{}
+			if err := json.Unmarshal(b, &proxy); err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+			}
+			result.SudoContract = &wasmtypes.SudoContractProposal{
+				Contract: proxy.Contract,
+				Msg:      proxy.Msg,
+			}
+			return nil
+		},
+		"execute_contract": func(b []byte) error {
+			proxy := wasmvmtypes.ExecuteMsg{}
+			if err := json.Unmarshal(b, &proxy); err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+			}
+			funds, err := convertWasmCoinsToSdkCoins(proxy.Funds)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+			}
+			result.ExecuteContract = &wasmtypes.ExecuteContractProposal{
+				Contract: proxy.ContractAddr,
+				Msg:      proxy.Msg,
+				Funds:    funds,
+			}
+			return nil
+		},
+		"instantiate_contract": func(b []byte) error {
 			proxy := wasmvmtypes.InstantiateMsg{}
 			if err := json.Unmarshal(b, &proxy); err != nil {
 				return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
@@ -274,17 +352,29 @@ type proposalContent struct {
 	// See https://github.com/cosmos/cosmos-sdk/blob/v0.42.3/proto/cosmos/params/v1beta1/params.proto#L9-L27
 	ChangeParams *[]proposaltypes.ParamChange `json:"change_params"`
 
-	// Updates the matching client to set a new trusted header.
-	// This can be used by governance to restore a client that has timed out or forked or otherwise broken.
-	// See https://github.com/cosmos/cosmos-sdk/blob/v0.42.3/proto/ibc/core/client/v1/client.proto#L36-L49
+	// Updates the subject client with the state of the substitute client.
+	// This can be used by governance to restore a client that has expired or frozen.
+	// See https://github.com/cosmos/ibc-go/blob/v3.3.0/proto/ibc/core/client/v1/client.proto#L42-L56
 	IBCClientUpdate *ibcclienttypes.ClientUpdateProposal `json:"ibc_client_update"`
 
+	// Register an upgrade that breaks IBC clients. Set via `register_upgrade` with an `upgraded_client_state`.
+	// See https://github.com/cosmos/ibc-go/blob/v3.3.0/proto/ibc/core/client/v1/client.proto#L58-L77
+	IBCUpgrade *ibcclienttypes.UpgradeProposal `json:"-"`
+
 	// See https://github.com/oldfurya/furya/blob/privileged_contracts_5/proto/confio/twasm/v1beta1/proposal.proto
 	PromoteToPrivilegedContract *types.PromoteToPrivilegedContractProposal `json:"promote_to_privileged_contract"`
 
 	// See https://github.com/oldfurya/furya/blob/privileged_contracts_5/proto/confio/twasm/v1beta1/proposal.proto
 	DemotePrivilegedContract *types.DemotePrivilegedContractProposal `json:"demote_privileged_contract"`
 
+	// Sets the callback order of all contracts registered for a privilege type.
+	// See https://github.com/oldfurya/furya/blob/main/proto/confio/twasm/v1beta1/proposal.proto
+	SetPrivilegeCallbackOrder *types.SetPrivilegeCallbackOrderProposal `json:"set_privilege_callback_order"`
+
+	// Sets the mint allowance for a contract with the token minter privilege.
+	// See https://github.com/oldfurya/furya/blob/main/proto/confio/twasm/v1beta1/proposal.proto
+	SetMintAllowance *types.SetMintAllowanceProposal `json:"set_mint_allowance"`
+
 	// See https://github.com/CosmWasm/wasmd/blob/master/proto/cosmwasm/wasm/v1/proposal.proto#L32-L54
 	InstantiateContract *wasmtypes.InstantiateContractProposal `json:"instantiate_contract"`
 
@@ -305,6 +395,18 @@ type proposalContent struct {
 
 	// See https://github.com/CosmWasm/wasmd/blob/master/proto/cosmwasm/wasm/v1/proposal.proto#L109-L121
 	UnpinCodes *wasmtypes.UnpinCodesProposal `json:"unpin_codes"`
+
+	// Calls the sudo entry point of a contract.
+	// See https://github.com/CosmWasm/wasmd/blob/v0.29.1/proto/cosmwasm/wasm/v1/proposal.proto#L70-L81
+	SudoContract *wasmtypes.SudoContractProposal `json:"sudo_contract"`
+
+	// Executes a contract with the sending contract as `RunAs` address.
+	// See https://github.com/CosmWasm/wasmd/blob/v0.29.1/proto/cosmwasm/wasm/v1/proposal.proto#L83-L101
+	ExecuteContract *wasmtypes.ExecuteContractProposal `json:"execute_contract"`
+
+	// Updates the instantiate permission of stored codes.
+	// See https://github.com/CosmWasm/wasmd/blob/v0.29.1/proto/cosmwasm/wasm/v1/proposal.proto#L163-L174
+	UpdateInstantiateConfig *wasmtypes.UpdateInstantiateConfigProposal `json:"update_instantiate_config"`
 }
 
 // MintTokens custom message to mint native tokens on the chain.
@@ -315,11 +417,19 @@ type MintTokens struct {
 	RecipientAddr string `json:"recipient"`
 }
 
+// BurnTokens custom message to burn native tokens owned by the contract.
+type BurnTokens struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
 // ConsensusParamsUpdate subset of tendermint params.
 // See https://github.com/tendermint/tendermint/blob/v0.34.8/proto/tendermint/abci/types.proto#L282-L289
 type ConsensusParamsUpdate struct {
-	Block    *BlockParams    `json:"block,omitempty"`
-	Evidence *EvidenceParams `json:"evidence,omitempty"`
+	Block     *BlockParams     `json:"block,omitempty"`
+	Evidence  *EvidenceParams  `json:"evidence,omitempty"`
+	Validator *ValidatorParams `json:"validator,omitempty"`
+	Version   *VersionParams   `json:"version,omitempty"`
 }
 
 // Delegate funds. Used for vesting accounts.
@@ -336,7 +446,7 @@ type Undelegate struct {
 
 // ValidateBasic check basics
 func (c ConsensusParamsUpdate) ValidateBasic() error {
-	if c.Block == nil && c.Evidence == nil {
+	if c.Block == nil && c.Evidence == nil && c.Validator == nil && c.Version == nil {
 		return wasmtypes.ErrEmpty
 	}
 	if err := c.Block.ValidateBasic(); err != nil {
@@ -345,6 +455,12 @@ func (c ConsensusParamsUpdate) ValidateBasic() error {
 	if err := c.Evidence.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "evidence")
 	}
+	if err := c.Validator.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "validator")
+	}
+	if err := c.Version.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "version")
+	}
 	return nil
 }
 
@@ -387,6 +503,52 @@ func (p *EvidenceParams) ValidateBasic() error {
 	return nil
 }
 
+type ValidatorParams struct {
+	// PubKeyTypes Public key types validators can use, like "ed25519" or "secp256k1".
+	// The list replaces the current one.
+	PubKeyTypes []string `json:"pub_key_types,omitempty"`
+}
+
+// ValidateBasic check basics
+func (p *ValidatorParams) ValidateBasic() error {
+	if p == nil {
+		return nil
+	}
+	if len(p.PubKeyTypes) == 0 {
+		return wasmtypes.ErrEmpty
+	}
+	unique := make(map[string]struct{}, len(p.PubKeyTypes))
+	for _, t := range p.PubKeyTypes {
+		if _, ok := tmtypes.ABCIPubKeyTypesToNames[t]; !ok {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "unknown pub key type: %q", t)
+		}
+		if _, exists := unique[t]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "pub key type: %q", t)
+		}
+		unique[t] = struct{}{}
+	}
+	return nil
+}
+
+type VersionParams struct {
+	// AppVersion Protocol version of the application
+	AppVersion *uint64 `json:"app_version,omitempty"`
+}
+
+// ValidateBasic check basics
+func (p *VersionParams) ValidateBasic() error {
+	if p == nil {
+		return nil
+	}
+	if p.AppVersion == nil {
+		return wasmtypes.ErrEmpty
+	}
+	if *p.AppVersion == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "app version must not be 0")
+	}
+	return nil
+}
+
 // copied from wasmd. should be public soon
 func convertWasmCoinsToSdkCoins(coins []wasmvmtypes.Coin) (sdk.Coins, error) {
 	var toSend sdk.Coins
@@ -412,3 +574,25 @@ func convertWasmCoinToSdkCoin(coin wasmvmtypes.Coin) (sdk.Coin, error) {
 	}
 	return r, r.Validate()
 }
+
+// ScheduleCallback registers a sudo callback for the contract that is executed in the end blocker when the given
+// block height or time is reached. Either height or time must be set.
+type ScheduleCallback struct {
+	// ID is chosen by the contract and must be unique for all pending callbacks of the contract
+	ID     string  `json:"id"`
+	Height *uint64 `json:"height,omitempty"`
+	// Time in nanosec UNIX time, like env.block.time
+	Time *uint64 `json:"time,omitempty"`
+	// Payload is passed back to the contract with the callback
+	Payload []byte `json:"payload,omitempty"`
+}
+
+// CancelCallback removes a pending scheduled callback of the contract
+type CancelCallback struct {
+	ID string `json:"id"`
+}
+
+// VetoGovProposal removes a gov proposal from the timelock queue. Only the configured veto contract can send it.
+type VetoGovProposal struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
@@ -1,6 +1,7 @@
 package contract
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"testing"
 	"time"
@@ -27,6 +28,12 @@ func TestGetProposalContent(t *testing.T) {
 	ibcclienttypes.RegisterInterfaces(ir)
 	ibctmtypes.RegisterInterfaces(ir)
 
+	myClientState := &ibctmtypes.ClientState{ChainId: "myChainID", LatestHeight: ibcclienttypes.NewHeight(1, 2)}
+	myClientStateBz, err := myClientState.Marshal()
+	require.NoError(t, err)
+	myUpgradeProposal, err := ibcclienttypes.NewUpgradeProposal("foo", "bar", upgradetypes.Plan{Name: "myUpgradeName", Height: 1}, myClientState)
+	require.NoError(t, err)
+
 	specs := map[string]struct {
 		src               string
 		expGovProposal    govtypes.Content
@@ -79,16 +86,44 @@ func TestGetProposalContent(t *testing.T) {
     "title": "foo", "description": "bar",
     "proposal": {
       "ibc_client_update": {
-        "client_id": "myClientID",
+        "subject_client_id": "07-tendermint-0",
+        "substitute_client_id": "07-tendermint-1"
+      }}}}`,
+			expGovProposal: &ibcclienttypes.ClientUpdateProposal{
+				Title:              "foo",
+				Description:        "bar",
+				SubjectClientId:    "07-tendermint-0",
+				SubstituteClientId: "07-tendermint-1",
+			},
+		},
+		"ibc client update - legacy client id": {
+			src: `{
+"execute_gov_proposal": {
+    "title": "foo", "description": "bar",
+    "proposal": {
+      "ibc_client_update": {
+        "client_id": "07-tendermint-0",
         "header": {"type_url": "/ibc.lightclients.tendermint.v1.Header","value": "GgA="}
       }}}}`,
 			expGovProposal: &ibcclienttypes.ClientUpdateProposal{
 				Title:           "foo",
 				Description:     "bar",
-				SubjectClientId: "myClientID",
+				SubjectClientId: "07-tendermint-0",
 			},
 			skipValidateBasic: true,
 		},
+		"ibc upgrade": {
+			src: `{
+"execute_gov_proposal": {
+    "title": "foo", "description": "bar",
+    "proposal": {
+      "register_upgrade": {
+        "name": "myUpgradeName",
+        "height": 1,
+        "upgraded_client_state": {"type_url": "/ibc.lightclients.tendermint.v1.ClientState","value": "` + base64.StdEncoding.EncodeToString(myClientStateBz) + `"}
+      }}}}`,
+			expGovProposal: myUpgradeProposal,
+		},
 		"promote to privileged contract": {
 			src: `{"execute_gov_proposal":{"title":"foo", "description":"bar", "proposal":{"promote_to_privileged_contract":{"contract":"cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09"}}}}`,
 			expGovProposal: &types.PromoteToPrivilegedContractProposal{
@@ -221,6 +256,95 @@ func TestGetProposalContent(t *testing.T) {
 				CodeIDs:     []uint64{3, 2, 1},
 			},
 		},
+		"sudo contract": {
+			src: `{
+  "execute_gov_proposal": {
+    "title": "foo", "description": "bar",
+    "proposal": {
+      "sudo_contract": {
+		"contract": "cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09",
+        "sudo_msg": "e30="
+      }}}}`,
+			expGovProposal: &wasmtypes.SudoContractProposal{
+				Title:       "foo",
+				Description: "bar",
+				Contract:    "cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09",
+				Msg:         []byte("{}"),
+			},
+		},
+		"execute contract": {
+			src: `{
+  "execute_gov_proposal": {
+    "title": "foo", "description": "bar",
+    "proposal": {
+      "execute_contract": {
+		"contract_addr": "cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09",
+        "msg": "e30=",
+        "funds": [{"denom": "ALX", "amount": "2"}]
+      }}}}`,
+			expGovProposal: &wasmtypes.ExecuteContractProposal{
+				Title:       "foo",
+				Description: "bar",
+				RunAs:       mySenderContractAddr.String(),
+				Contract:    "cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09",
+				Msg:         []byte("{}"),
+				Funds:       sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2))),
+			},
+		},
+		"update instantiate config": {
+			src: `{
+  "execute_gov_proposal": {
+    "title": "foo", "description": "bar",
+    "proposal": {
+      "update_instantiate_config": {
+		"access_config_updates": [
+          {"code_id": 1, "instantiate_permission": {"permission": "Nobody"}},
+          {"code_id": 2, "instantiate_permission": {"permission": "OnlyAddress", "address": "cosmos1g6chpwdke3kz69x67jkak5y7gynneqm3nulfrd"}}
+        ]
+      }}}}`,
+			expGovProposal: &wasmtypes.UpdateInstantiateConfigProposal{
+				Title:       "foo",
+				Description: "bar",
+				AccessConfigUpdates: []wasmtypes.AccessConfigUpdate{
+					{CodeID: 1, InstantiatePermission: wasmtypes.AllowNobody},
+					{CodeID: 2, InstantiatePermission: wasmtypes.AccessTypeOnlyAddress.With(sdk.MustAccAddressFromBech32("cosmos1g6chpwdke3kz69x67jkak5y7gynneqm3nulfrd"))},
+				},
+			},
+		},
+		"set privilege callback order": {
+			src: `{
+  "execute_gov_proposal": {
+    "title": "foo", "description": "bar",
+    "proposal": {
+      "set_privilege_callback_order": {
+        "privilege_type": "end_blocker",
+        "contracts": ["cosmos1g6chpwdke3kz69x67jkak5y7gynneqm3nulfrd", "cosmos1qgpqyqszqgpqyqszqgpqyqszqgpqyqszrh8mx2"]
+      }}}}`,
+			expGovProposal: &types.SetPrivilegeCallbackOrderProposal{
+				Title:         "foo",
+				Description:   "bar",
+				PrivilegeType: "end_blocker",
+				Contracts:     []string{"cosmos1g6chpwdke3kz69x67jkak5y7gynneqm3nulfrd", "cosmos1qgpqyqszqgpqyqszqgpqyqszqgpqyqszrh8mx2"},
+			},
+		},
+		"set mint allowance": {
+			src: `{
+  "execute_gov_proposal": {
+    "title": "foo", "description": "bar",
+    "proposal": {
+      "set_mint_allowance": {
+        "contract": "cosmos1g6chpwdke3kz69x67jkak5y7gynneqm3nulfrd",
+        "limits": [{"denom": "ufury", "total_cap": "1000", "rate_limit": "10", "rate_period": 100}]
+      }}}}`,
+			expGovProposal: &types.SetMintAllowanceProposal{
+				Title:       "foo",
+				Description: "bar",
+				Contract:    "cosmos1g6chpwdke3kz69x67jkak5y7gynneqm3nulfrd",
+				Limits: []types.MintLimit{
+					{Denom: "ufury", TotalCap: sdk.NewInt(1000), RateLimit: sdk.NewInt(10), RatePeriod: 100},
+				},
+			},
+		},
 		"unsupported proposal type": {
 			src: `{
   "execute_gov_proposal": {
@@ -267,9 +391,27 @@ func TestGetProposalContent(t *testing.T) {
 	}
 }
 
+func TestUnmarshalWithAnyInvalidClientState(t *testing.T) {
+	ir := codectypes.NewInterfaceRegistry()
+	ibcclienttypes.RegisterInterfaces(ir)
+	src := `{
+"execute_gov_proposal": {
+    "title": "foo", "description": "bar",
+    "proposal": {
+      "register_upgrade": {
+        "name": "myUpgradeName",
+        "height": 1,
+        "upgraded_client_state": {"type_url": "/ibc.lightclients.tendermint.v1.Header","value": "GgA="}
+      }}}}`
+	var msg PetriMsg
+	gotErr := msg.UnmarshalWithAny([]byte(src), ir)
+	assert.Error(t, gotErr)
+}
+
 func TestConsensusParamsUpdateValidation(t *testing.T) {
 	// some integers
 	var one, two, three, four, five int64 = 1, 2, 3, 4, 5
+	var appVersion, zeroAppVersion uint64 = 1, 0
 	specs := map[string]struct {
 		src    ConsensusParamsUpdate
 		expErr *sdkerrors.Error
@@ -340,6 +482,56 @@ func TestConsensusParamsUpdateValidation(t *testing.T) {
 				},
 			},
 		},
+		"validator - PubKeyTypes set": {
+			src: ConsensusParamsUpdate{
+				Validator: &ValidatorParams{
+					PubKeyTypes: []string{"ed25519", "secp256k1"},
+				},
+			},
+		},
+		"validator - empty": {
+			src: ConsensusParamsUpdate{
+				Validator: &ValidatorParams{},
+			},
+			expErr: wasmtypes.ErrEmpty,
+		},
+		"validator - unknown pub key type": {
+			src: ConsensusParamsUpdate{
+				Validator: &ValidatorParams{
+					PubKeyTypes: []string{"ed25519", "unknown"},
+				},
+			},
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"validator - duplicate pub key type": {
+			src: ConsensusParamsUpdate{
+				Validator: &ValidatorParams{
+					PubKeyTypes: []string{"ed25519", "ed25519"},
+				},
+			},
+			expErr: wasmtypes.ErrDuplicate,
+		},
+		"version - AppVersion set": {
+			src: ConsensusParamsUpdate{
+				Version: &VersionParams{
+					AppVersion: &appVersion,
+				},
+			},
+		},
+		"version - AppVersion zero": {
+			src: ConsensusParamsUpdate{
+				Version: &VersionParams{
+					AppVersion: &zeroAppVersion,
+				},
+			},
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"version - empty": {
+			src: ConsensusParamsUpdate{
+				Version: &VersionParams{},
+			},
+			expErr: wasmtypes.ErrEmpty,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/oldfurya/furya/x/twasm/contract"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+// MaxBankSendHookGas is the max gas a contract can consume for a single bank send hook callback.
+// A lower limit can be set via the callback gas limits.
+const MaxBankSendHookGas sdk.Gas = 200_000
+
+// bankSendHookCtxKey marks a context that is used by a bank send hook callback
+type bankSendHookCtxKey struct{}
+
+// BankSendHook is called before tokens are sent via the bank keeper
+type BankSendHook interface {
+	CallBankSendHooks(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error
+}
+
+// CallBankSendHooks sends the transfer details via sudo to all contracts registered for the bank send hook privilege.
+// An error returned by any contract rejects the transfer. Each callback runs in a cache context with a gas limit and
+// the gas consumed is charged to the caller. Transfers made while a hook is executed do not call the hooks again.
+func (k Keeper) CallBankSendHooks(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	if ctx.Value(bankSendHookCtxKey{}) != nil {
+		return nil
+	}
+	// collect first to not modify the store while iterating
+	var contracts []sdk.AccAddress
+	k.IteratePrivilegedContractsByType(ctx, types.PrivilegeBankSendHook, func(_ uint8, contractAddr sdk.AccAddress) bool {
+		contracts = append(contracts, contractAddr)
+		return false
+	})
+	if len(contracts) == 0 {
+		return nil
+	}
+	msgBz, err := json.Marshal(contract.PetriSudoMsg{BankSend: &contract.BankSend{
+		From:   from.String(),
+		To:     to.String(),
+		Amount: wasmkeeper.ConvertSdkCoinsToWasmCoins(amt),
+	}})
+	if err != nil {
+		return sdkerrors.Wrap(err, "marshal sudo msg")
+	}
+	for _, contractAddr := range contracts {
+		if err := k.callBankSendHook(ctx, contractAddr, msgBz); err != nil {
+			return sdkerrors.Wrapf(err, "bank send hook contract %s", contractAddr)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) callBankSendHook(ctx sdk.Context, contractAddr sdk.AccAddress, msgBz []byte) error {
+	gasLimit := k.CallbackGasLimit(ctx, types.PrivilegeBankSendHook, contractAddr)
+	if gasLimit == 0 || gasLimit > MaxBankSendHookGas {
+		gasLimit = MaxBankSendHookGas
+	}
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithValue(bankSendHookCtxKey{}, true)
+	var gasUsed sdk.Gas
+	err := ExecuteWithGasLimit(cacheCtx, gasLimit, func(hookCtx sdk.Context) error {
+		defer func() { gasUsed = hookCtx.GasMeter().GasConsumedToLimit() }()
+		_, err := k.Sudo(hookCtx, contractAddr, msgBz)
+		return err
+	})
+	ctx.GasMeter().ConsumeGas(gasUsed, "bank send hook")
+	if err != nil {
+		return err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+var _ bankkeeper.Keeper = HookedBankKeeper{}
+
+// HookedBankKeeper decorates the bank keeper to call the bank send hooks before any account to account transfer.
+// Transfers from or to module accounts via the module methods are not intercepted.
+type HookedBankKeeper struct {
+	bankkeeper.Keeper
+	hook BankSendHook
+}
+
+// NewHookedBankKeeper constructor
+func NewHookedBankKeeper(k bankkeeper.Keeper, hook BankSendHook) HookedBankKeeper {
+	return HookedBankKeeper{Keeper: k, hook: hook}
+}
+
+// SendCoins calls the bank send hooks before the tokens are transferred
+func (k HookedBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.hook.CallBankSendHooks(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins calls the bank send hooks before the tokens are transferred.
+// With a single input, the hooks are called for every output with the input as sender. With multiple inputs, the
+// sender can not be assigned to a recipient so that the hooks are called for every input with an empty recipient
+// and for every output with an empty sender.
+func (k HookedBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	var from sdk.AccAddress
+	if len(inputs) == 1 {
+		var err error
+		if from, err = sdk.AccAddressFromBech32(inputs[0].Address); err != nil {
+			return sdkerrors.Wrap(err, "input address")
+		}
+	} else {
+		for _, in := range inputs {
+			inAddr, err := sdk.AccAddressFromBech32(in.Address)
+			if err != nil {
+				return sdkerrors.Wrap(err, "input address")
+			}
+			if err := k.hook.CallBankSendHooks(ctx, inAddr, nil, in.Coins); err != nil {
+				return err
+			}
+		}
+	}
+	for _, out := range outputs {
+		outAddr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return sdkerrors.Wrap(err, "output address")
+		}
+		if err := k.hook.CallBankSendHooks(ctx, from, outAddr, out.Coins); err != nil {
+			return err
+		}
+	}
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oldfurya/furya/x/twasm/contract"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+func TestCallBankSendHooks(t *testing.T) {
+	from, to := RandomAddress(t), RandomAddress(t)
+	amount := sdk.NewCoins(sdk.NewCoin("ufury", sdk.NewInt(1)))
+	specs := map[string]struct {
+		registered int
+		sudoErr    error
+		expCalls   int
+		expErr     bool
+	}{
+		"no contracts registered": {},
+		"all contracts called": {
+			registered: 2,
+			expCalls:   2,
+		},
+		"rejected by contract": {
+			registered: 2,
+			sudoErr:    errors.New("testing"),
+			expCalls:   1,
+			expErr:     true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var capturedMsgs []contract.PetriSudoMsg
+			mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+				m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					var msg contract.PetriSudoMsg
+					require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+					capturedMsgs = append(capturedMsgs, msg)
+					return &wasmvmtypes.Response{}, 0, spec.sudoErr
+				}
+			})
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+			k := keepers.TWasmKeeper
+			for i := 0; i < spec.registered; i++ {
+				_, contractAddr := seedTestContract(t, ctx, k)
+				_, err := k.appendToPrivilegedContracts(ctx, types.PrivilegeBankSendHook, contractAddr)
+				require.NoError(t, err)
+			}
+
+			// when
+			gotErr := k.CallBankSendHooks(ctx, from, to, amount)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+			} else {
+				require.NoError(t, gotErr)
+			}
+			require.Len(t, capturedMsgs, spec.expCalls)
+			for _, msg := range capturedMsgs {
+				exp := contract.BankSend{From: from.String(), To: to.String(), Amount: wasmvmtypes.Coins{{Denom: "ufury", Amount: "1"}}}
+				require.NotNil(t, msg.BankSend)
+				assert.Equal(t, exp, *msg.BankSend)
+			}
+		})
+	}
+}
+
+func TestHookedBankKeeper(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	myAddr, otherAddr, anyAddr := RandomAddress(t), RandomAddress(t), RandomAddress(t)
+	keepers.Faucet.Fund(ctx, myAddr, sdk.NewCoin("ufury", sdk.NewInt(100)))
+	keepers.Faucet.Fund(ctx, otherAddr, sdk.NewCoin("ufury", sdk.NewInt(100)))
+
+	type hookCall struct {
+		from, to sdk.AccAddress
+		amount   sdk.Coins
+	}
+	coins := func(n int64) sdk.Coins { return sdk.NewCoins(sdk.NewCoin("ufury", sdk.NewInt(n))) }
+	specs := map[string]struct {
+		exec     func(ctx sdk.Context, k HookedBankKeeper) error
+		hookErr  error
+		expCalls []hookCall
+		expErr   bool
+	}{
+		"send coins": {
+			exec: func(ctx sdk.Context, k HookedBankKeeper) error {
+				return k.SendCoins(ctx, myAddr, anyAddr, coins(1))
+			},
+			expCalls: []hookCall{{from: myAddr, to: anyAddr, amount: coins(1)}},
+		},
+		"send coins rejected": {
+			exec: func(ctx sdk.Context, k HookedBankKeeper) error {
+				return k.SendCoins(ctx, myAddr, anyAddr, coins(1))
+			},
+			hookErr:  errors.New("testing"),
+			expCalls: []hookCall{{from: myAddr, to: anyAddr, amount: coins(1)}},
+			expErr:   true,
+		},
+		"multi send with single input": {
+			exec: func(ctx sdk.Context, k HookedBankKeeper) error {
+				return k.InputOutputCoins(ctx,
+					[]banktypes.Input{banktypes.NewInput(myAddr, coins(3))},
+					[]banktypes.Output{banktypes.NewOutput(otherAddr, coins(1)), banktypes.NewOutput(anyAddr, coins(2))},
+				)
+			},
+			expCalls: []hookCall{
+				{from: myAddr, to: otherAddr, amount: coins(1)},
+				{from: myAddr, to: anyAddr, amount: coins(2)},
+			},
+		},
+		"multi send with multiple inputs": {
+			exec: func(ctx sdk.Context, k HookedBankKeeper) error {
+				return k.InputOutputCoins(ctx,
+					[]banktypes.Input{banktypes.NewInput(myAddr, coins(1)), banktypes.NewInput(otherAddr, coins(2))},
+					[]banktypes.Output{banktypes.NewOutput(anyAddr, coins(3))},
+				)
+			},
+			expCalls: []hookCall{
+				{from: myAddr, amount: coins(1)},
+				{from: otherAddr, amount: coins(2)},
+				{to: anyAddr, amount: coins(3)},
+			},
+		},
+		"multi send rejected": {
+			exec: func(ctx sdk.Context, k HookedBankKeeper) error {
+				return k.InputOutputCoins(ctx,
+					[]banktypes.Input{banktypes.NewInput(myAddr, coins(1))},
+					[]banktypes.Output{banktypes.NewOutput(anyAddr, coins(1))},
+				)
+			},
+			hookErr:  errors.New("testing"),
+			expCalls: []hookCall{{from: myAddr, to: anyAddr, amount: coins(1)}},
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			var capturedCalls []hookCall
+			hook := bankSendHookFn(func(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+				capturedCalls = append(capturedCalls, hookCall{from: from, to: to, amount: amt})
+				return spec.hookErr
+			})
+			k := NewHookedBankKeeper(keepers.BankKeeper, hook)
+			balanceBefore := keepers.BankKeeper.GetBalance(ctx, anyAddr, "ufury")
+
+			// when
+			gotErr := spec.exec(ctx, k)
+
+			// then
+			assert.Equal(t, spec.expCalls, capturedCalls)
+			balanceAfter := keepers.BankKeeper.GetBalance(ctx, anyAddr, "ufury")
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Equal(t, balanceBefore, balanceAfter)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, balanceAfter.IsGTE(balanceBefore), "balance not updated")
+			assert.False(t, balanceAfter.IsEqual(balanceBefore), "balance not updated")
+		})
+	}
+}
+
+type bankSendHookFn func(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error
+
+func (b bankSendHookFn) CallBankSendHooks(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	return b(ctx, from, to, amt)
+}
+
+func TestBankSendHookContractSendsTokens(t *testing.T) {
+	myAddr, recipientAddr, hookRecipientAddr := RandomAddress(t), RandomAddress(t), RandomAddress(t)
+	coins := func(n int64) sdk.Coins { return sdk.NewCoins(sdk.NewCoin("ufury", sdk.NewInt(n))) }
+	specs := map[string]struct {
+		hookGasUsed func(gasLimit uint64) uint64
+		expErr      bool
+	}{
+		"nested transfer does not call hook again": {
+			hookGasUsed: func(uint64) uint64 { return 1 },
+		},
+		"hook exceeds gas limit": {
+			hookGasUsed: func(gasLimit uint64) uint64 { return gasLimit + 1 },
+			expErr:      true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var sudoCalls int
+			mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+				m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					sudoCalls++
+					return &wasmvmtypes.Response{
+						Messages: []wasmvmtypes.SubMsg{{
+							ReplyOn: wasmvmtypes.ReplyNever,
+							Msg: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+								ToAddress: hookRecipientAddr.String(),
+								Amount:    wasmvmtypes.Coins{{Denom: "ufury", Amount: "1"}},
+							}}},
+						}},
+					}, spec.hookGasUsed(gasLimit), nil
+				}
+			})
+			// the hooked bank keeper is used by the message handler as in the app
+			var hooked HookedBankKeeper
+			bankMsgHandler := &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+					require.NotNil(t, msg.Bank)
+					to, err := sdk.AccAddressFromBech32(msg.Bank.Send.ToAddress)
+					require.NoError(t, err)
+					return nil, nil, hooked.SendCoins(ctx, contractAddr, to, coins(1))
+				},
+			}
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock), wasmkeeper.WithMessageHandler(bankMsgHandler))
+			k := keepers.TWasmKeeper
+			hooked = NewHookedBankKeeper(keepers.BankKeeper, k)
+			_, contractAddr := seedTestContract(t, ctx, k)
+			_, err := k.appendToPrivilegedContracts(ctx, types.PrivilegeBankSendHook, contractAddr)
+			require.NoError(t, err)
+			keepers.Faucet.Fund(ctx, myAddr, sdk.NewCoin("ufury", sdk.NewInt(10)))
+			keepers.Faucet.Fund(ctx, contractAddr, sdk.NewCoin("ufury", sdk.NewInt(10)))
+			// infinite gas meter as in begin/ end blocker
+			ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+			// when
+			gotErr := hooked.SendCoins(ctx, myAddr, recipientAddr, coins(1))
+
+			// then
+			assert.Equal(t, 1, sudoCalls)
+			assert.NotZero(t, ctx.GasMeter().GasConsumed())
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.True(t, sdkerrors.ErrOutOfGas.Is(gotErr), gotErr)
+				assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, hookRecipientAddr).IsZero())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, coins(1), keepers.BankKeeper.GetAllBalances(ctx, recipientAddr))
+			assert.Equal(t, coins(1), keepers.BankKeeper.GetAllBalances(ctx, hookRecipientAddr))
+		})
+	}
+}
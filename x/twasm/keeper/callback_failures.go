@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oldfurya/furya/x/twasm/contract"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+// TrackCallbackFailure increments the consecutive failure counter for the contract and privilege type.
+// Returns true when the configured threshold is reached and the privilege should be quarantined.
+func (k Keeper) TrackCallbackFailure(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool {
+	failures := k.GetCallbackFailures(ctx, privilegeType, contractAddr) + 1
+	store := ctx.KVStore(k.storeKey)
+	store.Set(callbackFailuresKey(privilegeType, contractAddr), sdk.Uint64ToBigEndian(uint64(failures)))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCallbackFailed,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyCallbackType, privilegeType.String()),
+		sdk.NewAttribute(types.AttributeKeyFailures, fmt.Sprintf("%d", failures)),
+	))
+	threshold := k.GetTWasmParams(ctx).CallbackFailureThreshold
+	return threshold != 0 && failures >= threshold
+}
+
+// ResetCallbackFailures clears the consecutive failure counter for the contract and privilege type
+func (k Keeper) ResetCallbackFailures(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := callbackFailuresKey(privilegeType, contractAddr)
+	if store.Has(key) {
+		store.Delete(key)
+	}
+}
+
+// GetCallbackFailures returns the number of consecutive failed callbacks for the contract and privilege type
+func (k Keeper) GetCallbackFailures(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(callbackFailuresKey(privilegeType, contractAddr))
+	if bz == nil {
+		return 0
+	}
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+// IterateCallbackFailures iterates through all failure counters by privilege type and contract address ASC
+func (k Keeper) IterateCallbackFailures(ctx sdk.Context, cb func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint32) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), callbackFailuresPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		// cb returns true to stop early
+		if cb(types.PrivilegeType(key[0]), key[1:], uint32(sdk.BigEndianToUint64(iter.Value()))) {
+			return
+		}
+	}
+}
+
+// QuarantinePrivilege releases the privilege for a contract with too many failed callbacks and notifies the contract
+// with a PrivilegeChangeMsg{Demoted{}} sudo message. A failing sudo call does not stop the quarantine.
+// The contract keeps the privileged flag and can register for the privilege again.
+func (k Keeper) QuarantinePrivilege(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error {
+	details, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+	gasLimit := k.CallbackGasLimit(ctx, privilegeType, contractAddr)
+	details.IterateRegisteredPrivileges(func(c types.PrivilegeType, pos uint8) bool {
+		if c != privilegeType {
+			return false
+		}
+		k.removePrivilegeRegistration(ctx, c, pos, contractAddr)
+		details.RemoveRegisteredPrivilege(c, pos)
+		return false
+	})
+	if err := k.setContractDetails(ctx, contractAddr, details); err != nil {
+		return sdkerrors.Wrap(err, "store contract info extension")
+	}
+	k.ResetCallbackFailures(ctx, privilegeType, contractAddr)
+	k.notifyDemoted(ctx, contractAddr, gasLimit)
+
+	k.Logger(ctx).Info("Quarantine privilege", "contractAddr", contractAddr.String(), "type", privilegeType.String())
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeQuarantined,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyCallbackType, privilegeType.String()),
+	))
+	return nil
+}
+
+// notifyDemoted sends the PrivilegeChangeMsg{Demoted{}} sudo message to the contract. State changes are only committed
+// on success, errors and panics are logged and dropped.
+func (k Keeper) notifyDemoted(ctx sdk.Context, contractAddr sdk.AccAddress, gasLimit sdk.Gas) {
+	defer func() {
+		if r := recover(); r != nil {
+			k.Logger(ctx).Error("panic when notifying quarantined contract", "cause", r, "contractAddr", contractAddr.String())
+		}
+	}()
+	msgBz, err := json.Marshal(contract.PetriSudoMsg{PrivilegeChange: &contract.PrivilegeChangeMsg{Demoted: &struct{}{}}})
+	if err != nil {
+		panic(err) // can not happen
+	}
+	cacheCtx, commit := ctx.CacheContext()
+	err = ExecuteWithGasLimit(cacheCtx, gasLimit, func(ctx sdk.Context) error {
+		_, err := k.Sudo(ctx, contractAddr, msgBz)
+		return err
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to notify quarantined contract", "cause", err, "contractAddr", contractAddr.String())
+		return
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// callbackFailuresKey returns the key for the failure counter
+// `<prefix><privilegeType><contractAddr>`
+func callbackFailuresKey(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) []byte {
+	r := make([]byte, 0, len(callbackFailuresPrefix)+1+len(contractAddr))
+	r = append(r, callbackFailuresPrefix...)
+	r = append(r, byte(privilegeType))
+	return append(r, contractAddr...)
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oldfurya/furya/x/twasm/contract"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+func TestTrackCallbackFailure(t *testing.T) {
+	myAddr := RandomAddress(t)
+	specs := map[string]struct {
+		threshold     uint32
+		setup         func(ctx sdk.Context, k *Keeper)
+		expQuarantine bool
+		expFailures   uint32
+	}{
+		"first failure": {
+			expFailures: 1,
+		},
+		"consecutive failure": {
+			setup: func(ctx sdk.Context, k *Keeper) {
+				k.TrackCallbackFailure(ctx, types.PrivilegeTypeBeginBlock, myAddr)
+			},
+			expFailures: 2,
+		},
+		"other type not counted": {
+			setup: func(ctx sdk.Context, k *Keeper) {
+				k.TrackCallbackFailure(ctx, types.PrivilegeTypeEndBlock, myAddr)
+			},
+			expFailures: 1,
+		},
+		"reset": {
+			setup: func(ctx sdk.Context, k *Keeper) {
+				k.TrackCallbackFailure(ctx, types.PrivilegeTypeBeginBlock, myAddr)
+				k.ResetCallbackFailures(ctx, types.PrivilegeTypeBeginBlock, myAddr)
+			},
+			expFailures: 1,
+		},
+		"threshold reached": {
+			threshold: 2,
+			setup: func(ctx sdk.Context, k *Keeper) {
+				k.TrackCallbackFailure(ctx, types.PrivilegeTypeBeginBlock, myAddr)
+			},
+			expQuarantine: true,
+			expFailures:   2,
+		},
+		"threshold not reached": {
+			threshold:   2,
+			expFailures: 1,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+			k := keepers.TWasmKeeper
+			k.SetTWasmParams(ctx, types.TWasmParams{CallbackFailureThreshold: spec.threshold})
+			if spec.setup != nil {
+				spec.setup(ctx, k)
+			}
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotQuarantine := k.TrackCallbackFailure(ctx, types.PrivilegeTypeBeginBlock, myAddr)
+
+			// then
+			assert.Equal(t, spec.expQuarantine, gotQuarantine)
+			assert.Equal(t, spec.expFailures, k.GetCallbackFailures(ctx, types.PrivilegeTypeBeginBlock, myAddr))
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeCallbackFailed, em.Events()[0].Type)
+		})
+	}
+}
+
+func TestIterateCallbackFailures(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	myAddr := RandomAddress(t)
+	k.TrackCallbackFailure(ctx, types.PrivilegeTypeEndBlock, myAddr)
+	k.TrackCallbackFailure(ctx, types.PrivilegeTypeBeginBlock, myAddr)
+	k.TrackCallbackFailure(ctx, types.PrivilegeTypeEndBlock, myAddr)
+
+	type tuple struct {
+		t types.PrivilegeType
+		a sdk.AccAddress
+		f uint32
+	}
+	var captured []tuple
+	// when
+	k.IterateCallbackFailures(ctx, func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint32) bool {
+		captured = append(captured, tuple{t: privilegeType, a: contractAddr, f: failures})
+		return false
+	})
+	// then
+	exp := []tuple{
+		{t: types.PrivilegeTypeBeginBlock, a: myAddr, f: 1},
+		{t: types.PrivilegeTypeEndBlock, a: myAddr, f: 2},
+	}
+	assert.Equal(t, exp, captured)
+}
+
+func TestQuarantinePrivilege(t *testing.T) {
+	specs := map[string]struct {
+		sudoErr       error
+		sudoPanic     bool
+		expSudoCommit bool
+	}{
+		"demoted sudo succeeds": {
+			expSudoCommit: true,
+		},
+		"demoted sudo fails": {
+			sudoErr: errors.New("testing"),
+		},
+		"demoted sudo panics": {
+			sudoPanic: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var capturedSudoMsgs []contract.PetriSudoMsg
+			mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+				m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					var msg contract.PetriSudoMsg
+					require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+					capturedSudoMsgs = append(capturedSudoMsgs, msg)
+					store.Set([]byte("demoted"), []byte("true"))
+					if spec.sudoPanic {
+						panic("testing")
+					}
+					if spec.sudoErr != nil {
+						return nil, 0, spec.sudoErr
+					}
+					return &wasmvmtypes.Response{}, 0, nil
+				}
+			})
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+			k := keepers.TWasmKeeper
+			_, myAddr := seedTestContract(t, ctx, k)
+			k.setPrivilegedFlag(ctx, myAddr)
+
+			details := types.PetriContractDetails{}
+			for _, p := range []types.PrivilegeType{types.PrivilegeTypeBeginBlock, types.PrivilegeTypeEndBlock} {
+				pos, err := k.appendToPrivilegedContracts(ctx, p, myAddr)
+				require.NoError(t, err)
+				details.AddRegisteredPrivilege(p, pos)
+			}
+			require.NoError(t, k.setContractDetails(ctx, myAddr, &details))
+			k.TrackCallbackFailure(ctx, types.PrivilegeTypeBeginBlock, myAddr)
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotErr := k.QuarantinePrivilege(ctx, types.PrivilegeTypeBeginBlock, myAddr)
+
+			// then
+			require.NoError(t, gotErr)
+			assert.True(t, k.IsPrivileged(ctx, myAddr))
+			assert.False(t, k.ExistsAnyPrivilegedContract(ctx, types.PrivilegeTypeBeginBlock))
+			assert.True(t, k.ExistsAnyPrivilegedContract(ctx, types.PrivilegeTypeEndBlock))
+			gotDetails, err := k.getContractDetails(ctx, myAddr)
+			require.NoError(t, err)
+			assert.False(t, gotDetails.HasRegisteredPrivilege(types.PrivilegeTypeBeginBlock))
+			assert.True(t, gotDetails.HasRegisteredPrivilege(types.PrivilegeTypeEndBlock))
+			assert.Equal(t, uint32(0), k.GetCallbackFailures(ctx, types.PrivilegeTypeBeginBlock, myAddr))
+			assert.Equal(t, types.EventTypeQuarantined, em.Events()[len(em.Events())-1].Type)
+			// and the contract was notified
+			require.Len(t, capturedSudoMsgs, 1)
+			require.NotNil(t, capturedSudoMsgs[0].PrivilegeChange)
+			assert.NotNil(t, capturedSudoMsgs[0].PrivilegeChange.Demoted)
+			assert.Equal(t, spec.expSudoCommit, k.QueryRaw(ctx, myAddr, []byte("demoted")) != nil)
+		})
+	}
+}
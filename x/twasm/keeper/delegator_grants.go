@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+// GrantDelegator authorizes the contract to delegate the tokens of the staker. An existing grant is replaced.
+func (k Keeper) GrantDelegator(ctx sdk.Context, grant types.DelegatorGrant) error {
+	if err := grant.ValidateBasic(); err != nil {
+		return err
+	}
+	if grant.IsExpired(ctx) {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "expiration must be in the future")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(grant.ContractAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if k.GetContractInfo(ctx, contractAddr) == nil {
+		return sdkerrors.Wrap(wasmtypes.ErrNotFound, "contract")
+	}
+	k.storeDelegatorGrant(ctx, grant)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGrantDelegator,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, grant.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyStaker, grant.Staker),
+	))
+	return nil
+}
+
+// RevokeDelegator removes the authorization of the contract to delegate the tokens of the staker
+func (k Keeper) RevokeDelegator(ctx sdk.Context, staker, contractAddr sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := delegatorGrantKey(staker, contractAddr)
+	if !store.Has(key) {
+		return sdkerrors.Wrap(wasmtypes.ErrNotFound, "delegator grant")
+	}
+	store.Delete(key)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevokeDelegator,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyStaker, staker.String()),
+	))
+	return nil
+}
+
+// GetDelegatorGrant returns the grant of the staker for the contract. Returns nil when not found.
+func (k Keeper) GetDelegatorGrant(ctx sdk.Context, staker, contractAddr sdk.AccAddress) *types.DelegatorGrant {
+	bz := ctx.KVStore(k.storeKey).Get(delegatorGrantKey(staker, contractAddr))
+	if bz == nil {
+		return nil
+	}
+	var grant types.DelegatorGrant
+	k.cdc.MustUnmarshal(bz, &grant)
+	return &grant
+}
+
+// HasDelegatorGrant returns true when the staker has granted the contract to delegate the tokens and the grant
+// is not expired
+func (k Keeper) HasDelegatorGrant(ctx sdk.Context, staker, contractAddr sdk.AccAddress) bool {
+	grant := k.GetDelegatorGrant(ctx, staker, contractAddr)
+	return grant != nil && !grant.IsExpired(ctx)
+}
+
+// IterateDelegatorGrants iterates through all delegator grants ordered by staker
+func (k Keeper) IterateDelegatorGrants(ctx sdk.Context, cb func(grant types.DelegatorGrant) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegatorGrantPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.DelegatorGrant
+		k.cdc.MustUnmarshal(iter.Value(), &grant)
+		// cb returns true to stop early
+		if cb(grant) {
+			return
+		}
+	}
+}
+
+// PaginateDelegatorGrants iterates through a page of delegator grants. When a staker is given then only the grants
+// of this staker are returned.
+func (k Keeper) PaginateDelegatorGrants(ctx sdk.Context, staker sdk.AccAddress, pageReq *query.PageRequest, cb func(grant types.DelegatorGrant)) (*query.PageResponse, error) {
+	prefixKey := delegatorGrantPrefix
+	if len(staker) != 0 {
+		prefixKey = delegatorGrantPrefixForStaker(staker)
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	return query.Paginate(prefixStore, pageReq, func(_ []byte, value []byte) error {
+		var grant types.DelegatorGrant
+		k.cdc.MustUnmarshal(value, &grant)
+		cb(grant)
+		return nil
+	})
+}
+
+// importDelegatorGrants stores the delegator grants from genesis
+func (k Keeper) importDelegatorGrants(ctx sdk.Context, grants []types.DelegatorGrant) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, g := range grants {
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "grant of %s for %s", g.Staker, g.ContractAddress)
+		}
+		staker, contractAddr := sdk.MustAccAddressFromBech32(g.Staker), sdk.MustAccAddressFromBech32(g.ContractAddress)
+		if store.Has(delegatorGrantKey(staker, contractAddr)) {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "grant of %s for %s", g.Staker, g.ContractAddress)
+		}
+		k.storeDelegatorGrant(ctx, g)
+	}
+	return nil
+}
+
+func (k Keeper) storeDelegatorGrant(ctx sdk.Context, grant types.DelegatorGrant) {
+	key := delegatorGrantKey(sdk.MustAccAddressFromBech32(grant.Staker), sdk.MustAccAddressFromBech32(grant.ContractAddress))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&grant))
+}
+
+// delegatorGrantKey returns the key for a grant
+// `<prefix><len><staker><contractAddr>`
+func delegatorGrantKey(staker, contractAddr sdk.AccAddress) []byte {
+	return append(delegatorGrantPrefixForStaker(staker), contractAddr...)
+}
+
+// delegatorGrantPrefixForStaker returns `<prefix><len><staker>`
+func delegatorGrantPrefixForStaker(staker sdk.AccAddress) []byte {
+	return append(append([]byte{}, delegatorGrantPrefix...), address.MustLengthPrefix(staker)...)
+}
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+func TestGrantDelegator(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	_, myContractAddr := seedTestContract(t, ctx, k)
+	myStakerAddr := RandomAddress(t)
+	future, past := ctx.BlockTime().Add(time.Hour), ctx.BlockTime().Add(-time.Second)
+
+	specs := map[string]struct {
+		src    types.DelegatorGrant
+		expErr *sdkerrors.Error
+	}{
+		"without expiration": {
+			src: types.DelegatorGrant{Staker: myStakerAddr.String(), ContractAddress: myContractAddr.String()},
+		},
+		"with expiration": {
+			src: types.DelegatorGrant{Staker: myStakerAddr.String(), ContractAddress: myContractAddr.String(), Expiration: &future},
+		},
+		"expired": {
+			src:    types.DelegatorGrant{Staker: myStakerAddr.String(), ContractAddress: myContractAddr.String(), Expiration: &past},
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"unknown contract": {
+			src:    types.DelegatorGrant{Staker: myStakerAddr.String(), ContractAddress: RandomAddress(t).String()},
+			expErr: wasmtypes.ErrNotFound,
+		},
+		"invalid staker": {
+			src:    types.DelegatorGrant{Staker: "invalid", ContractAddress: myContractAddr.String()},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			// when
+			gotErr := k.GrantDelegator(ctx, spec.src)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				assert.False(t, k.HasDelegatorGrant(ctx, myStakerAddr, myContractAddr))
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, k.HasDelegatorGrant(ctx, myStakerAddr, myContractAddr))
+			assert.Equal(t, &spec.src, k.GetDelegatorGrant(ctx, myStakerAddr, myContractAddr))
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeGrantDelegator, em.Events()[0].Type)
+		})
+	}
+}
+
+func TestRevokeDelegator(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	_, myContractAddr := seedTestContract(t, ctx, k)
+	myStakerAddr := RandomAddress(t)
+	require.NoError(t, k.GrantDelegator(ctx, types.DelegatorGrant{Staker: myStakerAddr.String(), ContractAddress: myContractAddr.String()}))
+
+	specs := map[string]struct {
+		staker sdk.AccAddress
+		expErr *sdkerrors.Error
+	}{
+		"granted": {
+			staker: myStakerAddr,
+		},
+		"not granted": {
+			staker: RandomAddress(t),
+			expErr: wasmtypes.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			// when
+			gotErr := k.RevokeDelegator(ctx, spec.staker, myContractAddr)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				assert.True(t, k.HasDelegatorGrant(ctx, myStakerAddr, myContractAddr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.False(t, k.HasDelegatorGrant(ctx, myStakerAddr, myContractAddr))
+			assert.Nil(t, k.GetDelegatorGrant(ctx, myStakerAddr, myContractAddr))
+		})
+	}
+}
+
+func TestHasDelegatorGrant(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	_, myContractAddr := seedTestContract(t, ctx, k)
+	myStakerAddr := RandomAddress(t)
+	expiration := ctx.BlockTime().Add(time.Hour)
+	require.NoError(t, k.GrantDelegator(ctx, types.DelegatorGrant{Staker: myStakerAddr.String(), ContractAddress: myContractAddr.String(), Expiration: &expiration}))
+
+	specs := map[string]struct {
+		staker       sdk.AccAddress
+		contractAddr sdk.AccAddress
+		blockTime    time.Time
+		exp          bool
+	}{
+		"granted": {
+			staker:       myStakerAddr,
+			contractAddr: myContractAddr,
+			blockTime:    expiration.Add(-time.Nanosecond),
+			exp:          true,
+		},
+		"expired": {
+			staker:       myStakerAddr,
+			contractAddr: myContractAddr,
+			blockTime:    expiration,
+		},
+		"other contract": {
+			staker:       myStakerAddr,
+			contractAddr: RandomAddress(t),
+			blockTime:    ctx.BlockTime(),
+		},
+		"other staker": {
+			staker:       RandomAddress(t),
+			contractAddr: myContractAddr,
+			blockTime:    ctx.BlockTime(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := k.HasDelegatorGrant(ctx.WithBlockTime(spec.blockTime), spec.staker, spec.contractAddr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestPaginateDelegatorGrants(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	_, myContractAddr := seedTestContract(t, ctx, k)
+	_, myOtherContractAddr := seedTestContract(t, ctx, k)
+	myStakerAddr, myOtherStakerAddr := RandomAddress(t), RandomAddress(t)
+	grants := []types.DelegatorGrant{
+		{Staker: myStakerAddr.String(), ContractAddress: myContractAddr.String()},
+		{Staker: myStakerAddr.String(), ContractAddress: myOtherContractAddr.String()},
+		{Staker: myOtherStakerAddr.String(), ContractAddress: myContractAddr.String()},
+	}
+	require.NoError(t, k.importDelegatorGrants(ctx, grants))
+
+	specs := map[string]struct {
+		staker   sdk.AccAddress
+		pageReq  *query.PageRequest
+		expCount int
+	}{
+		"all": {
+			expCount: 3,
+		},
+		"by staker": {
+			staker:   myStakerAddr,
+			expCount: 2,
+		},
+		"paginated": {
+			pageReq:  &query.PageRequest{Limit: 1},
+			expCount: 1,
+		},
+		"unknown staker": {
+			staker: RandomAddress(t),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var got []types.DelegatorGrant
+			_, gotErr := k.PaginateDelegatorGrants(ctx, spec.staker, spec.pageReq, func(grant types.DelegatorGrant) {
+				got = append(got, grant)
+			})
+			require.NoError(t, gotErr)
+			assert.Len(t, got, spec.expCount)
+			for _, g := range got {
+				if len(spec.staker) != 0 {
+					assert.Equal(t, spec.staker.String(), g.Staker)
+				}
+			}
+		})
+	}
+	// duplicates are rejected on import
+	assert.Error(t, k.importDelegatorGrants(ctx, grants[:1]))
+}
@@ -34,6 +34,7 @@ func InitGenesis(
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "wasm")
 	}
+	keeper.SetTWasmParams(ctx, data.TWasmParams)
 
 	// import privileges from dumped contract infos
 	for i, m := range data.Contracts {
@@ -60,11 +61,7 @@ func InitGenesis(
 			if model == nil {
 				return nil, sdkerrors.Wrapf(wasmtypes.ErrInvalidGenesis, "custom state model not set for %s", m.ContractAddress)
 			}
-			bz, err := json.Marshal(contract.PetriSudoMsg{Import: &model.Msg})
-			if err != nil {
-				return nil, sdkerrors.Wrapf(err, "marshal state import for %s", m.ContractAddress)
-			}
-			if _, err = keeper.Keeper.Sudo(ctx, addr, bz); err != nil {
+			if err := keeper.importCustomState(ctx, addr, *model); err != nil {
 				return nil, sdkerrors.Wrapf(err, "init custom state for %s", m.ContractAddress)
 			}
 		}
@@ -82,6 +79,19 @@ func InitGenesis(
 		}
 	}
 
+	if err := keeper.importMintState(ctx, data.MintAllowances, data.MintUsages); err != nil {
+		return nil, sdkerrors.Wrap(err, "mint state")
+	}
+	if err := keeper.importScheduledCallbacks(ctx, data.ScheduledCallbacks); err != nil {
+		return nil, sdkerrors.Wrap(err, "scheduled callbacks")
+	}
+	if err := keeper.importTimelockedGovProposals(ctx, data.TimelockedGovProposals, data.TimelockedGovProposalSequence); err != nil {
+		return nil, sdkerrors.Wrap(err, "timelocked gov proposals")
+	}
+	if err := keeper.importDelegatorGrants(ctx, data.DelegatorGrants); err != nil {
+		return nil, sdkerrors.Wrap(err, "delegator grants")
+	}
+
 	// cache requested contracts
 	for _, codeID := range data.PinnedCodeIDs {
 		if err := keeper.contractKeeper.PinCode(ctx, codeID); err != nil {
@@ -113,22 +123,42 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		if err != nil {
 			panic(fmt.Sprintf("address %s: %s", v.ContractAddress, err))
 		}
-		bz, err := json.Marshal(contract.PetriSudoMsg{Export: &struct{}{}})
-		if err != nil {
-			panic(sdkerrors.Wrapf(err, "marshal state export for %s", c.String()))
-		}
-		got, err := keeper.Keeper.Sudo(ctx, c, bz)
+		model, err := keeper.exportCustomState(ctx, c, details.HasRegisteredPrivilege(types.PrivilegeStatePageExporter))
 		if err != nil {
-			panic(sdkerrors.Wrapf(err, "export custom state for %s with %q", c.String(), string(bz)))
+			panic(sdkerrors.Wrapf(err, "export custom state for %s", c.String()))
 		}
-		contracts[i].ContractState = &types.Contract_CustomModel{CustomModel: &types.CustomModel{Msg: got}}
+		contracts[i].ContractState = &types.Contract_CustomModel{CustomModel: model}
 	}
 	genState := types.GenesisState{
-		Params:    wasmState.Params,
-		Codes:     wasmState.Codes,
-		Contracts: contracts,
-		Sequences: wasmState.Sequences,
-		GenMsgs:   wasmState.GenMsgs,
+		Params:      wasmState.Params,
+		Codes:       wasmState.Codes,
+		Contracts:   contracts,
+		Sequences:   wasmState.Sequences,
+		GenMsgs:     wasmState.GenMsgs,
+		TWasmParams: keeper.GetTWasmParams(ctx),
+	}
+	keeper.IterateMintAllowances(ctx, func(allowance types.MintAllowance) bool {
+		genState.MintAllowances = append(genState.MintAllowances, allowance)
+		return false
+	})
+	keeper.IterateMintUsages(ctx, nil, func(usage types.MintUsage) bool {
+		genState.MintUsages = append(genState.MintUsages, usage)
+		return false
+	})
+	keeper.IterateScheduledCallbacks(ctx, func(callback types.ScheduledCallback) bool {
+		genState.ScheduledCallbacks = append(genState.ScheduledCallbacks, callback)
+		return false
+	})
+	keeper.IterateTimelockedGovProposals(ctx, func(proposal types.TimelockedGovProposal) bool {
+		genState.TimelockedGovProposals = append(genState.TimelockedGovProposals, proposal)
+		return false
+	})
+	keeper.IterateDelegatorGrants(ctx, func(grant types.DelegatorGrant) bool {
+		genState.DelegatorGrants = append(genState.DelegatorGrants, grant)
+		return false
+	})
+	if ctx.KVStore(keeper.storeKey).Has(timelockedGovProposalSequenceKey) {
+		genState.TimelockedGovProposalSequence = keeper.GetTimelockedGovProposalSequence(ctx)
 	}
 
 	// pinned is stored in code info
@@ -139,3 +169,88 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 	// the sudo promote call is executed again so that the contract can register additional privileges
 	return &genState
 }
+
+// importCustomState passes the custom model to the contract. Chunks are imported page by page, otherwise the
+// single message is imported.
+func (k Keeper) importCustomState(ctx sdk.Context, contractAddr sdk.AccAddress, model types.CustomModel) error {
+	if len(model.Chunks) == 0 {
+		bz, err := json.Marshal(contract.PetriSudoMsg{Import: &model.Msg})
+		if err != nil {
+			return sdkerrors.Wrap(err, "marshal state import")
+		}
+		_, err = k.Keeper.Sudo(ctx, contractAddr, bz)
+		return err
+	}
+	for i, chunk := range model.Chunks {
+		bz, err := json.Marshal(contract.PetriSudoMsg{ImportPage: &contract.ImportPage{Data: chunk, Last: i == len(model.Chunks)-1}})
+		if err != nil {
+			return sdkerrors.Wrapf(err, "marshal state import page %d", i)
+		}
+		if _, err := k.Keeper.Sudo(ctx, contractAddr, bz); err != nil {
+			return sdkerrors.Wrapf(err, "import page %d", i)
+		}
+	}
+	return nil
+}
+
+// maxExportPages is the max number of pages exported for a contract. The export is aborted when exceeded.
+const maxExportPages = 10_000
+
+// exportCustomState returns the custom model of the contract. The state is exported page by page when the contract
+// has registered the state_page_exporter privilege, otherwise with a single message.
+func (k Keeper) exportCustomState(ctx sdk.Context, contractAddr sdk.AccAddress, paged bool) (*types.CustomModel, error) {
+	if !paged {
+		bz, err := json.Marshal(contract.PetriSudoMsg{Export: &struct{}{}})
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "marshal state export")
+		}
+		got, err := k.Keeper.Sudo(ctx, contractAddr, bz)
+		if err != nil {
+			return nil, err
+		}
+		return &types.CustomModel{Msg: got}, nil
+	}
+	var (
+		chunks      []wasmtypes.RawContractMessage
+		cursor      []byte
+		seenCursors = make(map[string]struct{})
+	)
+	for {
+		if len(chunks) == maxExportPages {
+			return nil, sdkerrors.Wrapf(wasmtypes.ErrLimit, "max %d pages", maxExportPages)
+		}
+		page, err := k.exportCustomStatePage(ctx, contractAddr, cursor)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "export page %d", len(chunks))
+		}
+		chunks = append(chunks, page.Data)
+		cursor = page.NextCursor
+		if len(cursor) == 0 {
+			return &types.CustomModel{Chunks: chunks}, nil
+		}
+		if _, exists := seenCursors[string(cursor)]; exists {
+			return nil, sdkerrors.Wrapf(wasmtypes.ErrInvalid, "cursor cycle on page %d", len(chunks))
+		}
+		seenCursors[string(cursor)] = struct{}{}
+	}
+}
+
+// exportCustomStatePage returns the page of the contract state that starts at the cursor
+func (k Keeper) exportCustomStatePage(ctx sdk.Context, contractAddr sdk.AccAddress, cursor []byte) (*contract.ExportPageResponse, error) {
+	bz, err := json.Marshal(contract.PetriSudoMsg{ExportPage: &contract.ExportPage{Cursor: cursor}})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "marshal state export page")
+	}
+	got, err := k.Keeper.Sudo(ctx, contractAddr, bz)
+	if err != nil {
+		return nil, err
+	}
+	var page contract.ExportPageResponse
+	if err := json.Unmarshal(got, &page); err != nil {
+		return nil, sdkerrors.Wrap(wasmtypes.ErrInvalid, err.Error())
+	}
+	if err := page.Data.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(err, "page data")
+	}
+	return &page, nil
+}
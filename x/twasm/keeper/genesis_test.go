@@ -4,7 +4,9 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
@@ -168,6 +170,37 @@ func TestInitGenesis(t *testing.T) {
 			}),
 			expCallbackReg: []registeredCallback{{pos: 1, cbt: types.PrivilegeStateExporterImporter, addr: genContractAddress(2, 2)}},
 		},
+		"privileged state importer contract imports chunks from dump": {
+			state: types.GenesisStateFixture(t, func(state *types.GenesisState) {
+				state.PrivilegedContractAddresses = nil
+				state.Contracts[1].ContractAddress = genContractAddress(2, 2).String()
+				state.Contracts[1].ContractState = &types.Contract_CustomModel{CustomModel: &types.CustomModel{
+					Chunks: []wasmtypes.RawContractMessage{[]byte(`{"page":1}`), []byte(`{"page":2}`)},
+				}}
+				err := state.Contracts[1].ContractInfo.SetExtension(&types.PetriContractDetails{
+					RegisteredPrivileges: []types.RegisteredPrivilege{{Position: 1, PrivilegeType: "state_exporter_importer"}},
+				})
+				require.NoError(t, err)
+			}),
+			wasmvm: NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+				m.PinFn = func(checksum cosmwasm.Checksum) error { return nil }
+				var pages []contract.ImportPage
+				m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					var msg contract.PetriSudoMsg
+					require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+					require.NotNil(t, msg.ImportPage)
+					pages = append(pages, *msg.ImportPage)
+					if len(pages) == 2 {
+						assert.Equal(t, []contract.ImportPage{
+							{Data: []byte(`{"page":1}`)},
+							{Data: []byte(`{"page":2}`), Last: true},
+						}, pages)
+					}
+					return &wasmvmtypes.Response{}, 0, nil
+				}
+			}),
+			expCallbackReg: []registeredCallback{{pos: 1, cbt: types.PrivilegeStateExporterImporter, addr: genContractAddress(2, 2)}},
+		},
 		"privileged state importer contract imports from dump with custom model removed": {
 			state: types.GenesisStateFixture(t, func(state *types.GenesisState) {
 				state.PrivilegedContractAddresses = nil
@@ -310,6 +343,7 @@ func TestExportGenesis(t *testing.T) {
 				m.PinFn = noopVMMock.PinFn
 				m.GetCodeFn = noopVMMock.GetCodeFn
 				m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					require.NotContains(t, string(sudoMsg), "export_page")
 					// return furya message with exported state
 					return &wasmvmtypes.Response{
 						Data: []byte(`{"my":"state"}`),
@@ -317,6 +351,82 @@ func TestExportGenesis(t *testing.T) {
 				}
 			}),
 		},
+		"privileged state exporter contract with pages": {
+			srcState: types.DeterministicGenesisStateFixture(t, func(state *types.GenesisState) {
+				state.PrivilegedContractAddresses = []string{genContractAddress(1, 1).String()}
+			}),
+			expState: types.DeterministicGenesisStateFixture(t, func(state *types.GenesisState) {
+				state.PrivilegedContractAddresses = nil
+				state.Contracts[1].ContractState = &types.Contract_CustomModel{CustomModel: &types.CustomModel{
+					Chunks: []wasmtypes.RawContractMessage{[]byte(`{"page":1}`), []byte(`{"page":2}`)},
+				}}
+				err := state.Contracts[1].ContractInfo.SetExtension(&types.PetriContractDetails{
+					RegisteredPrivileges: []types.RegisteredPrivilege{
+						{Position: 1, PrivilegeType: "state_exporter_importer"},
+						{Position: 1, PrivilegeType: "state_page_exporter"},
+					},
+				})
+				require.NoError(t, err)
+			}),
+			alterState: func(ctx sdk.Context, keepers TestKeepers) {
+				setContractPrivilege(t, ctx, keepers, genContractAddress(1, 1), types.PrivilegeStateExporterImporter)
+				setContractPrivilege(t, ctx, keepers, genContractAddress(1, 1), types.PrivilegeStatePageExporter)
+			},
+			mockVM: NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+				m.CreateFn = noopVMMock.CreateFn
+				m.PinFn = noopVMMock.PinFn
+				m.GetCodeFn = noopVMMock.GetCodeFn
+				m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					var msg contract.PetriSudoMsg
+					require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+					if msg.ExportPage == nil { // promotion on genesis import
+						return &wasmvmtypes.Response{}, 0, nil
+					}
+					// return the second and last page for the cursor
+					if string(msg.ExportPage.Cursor) == "next" {
+						return &wasmvmtypes.Response{Data: []byte(`{"data":{"page":2}}`)}, 0, nil
+					}
+					require.Empty(t, msg.ExportPage.Cursor)
+					return &wasmvmtypes.Response{Data: []byte(`{"data":{"page":1},"next_cursor":"bmV4dA=="}`)}, 0, nil
+				}
+			}),
+		},
+		"export with scheduled callbacks": {
+			srcState: types.DeterministicGenesisStateFixture(t, func(state *types.GenesisState) {
+				state.PrivilegedContractAddresses = nil
+				state.ScheduledCallbacks = []types.ScheduledCallback{
+					{ContractAddress: genContractAddress(1, 1).String(), ID: "b", Height: 2, Payload: []byte(`{}`)},
+					{ContractAddress: genContractAddress(1, 1).String(), ID: "a", Height: 1},
+				}
+			}),
+			expState: types.DeterministicGenesisStateFixture(t, func(state *types.GenesisState) {
+				state.PrivilegedContractAddresses = nil
+				state.ScheduledCallbacks = []types.ScheduledCallback{
+					{ContractAddress: genContractAddress(1, 1).String(), ID: "a", Height: 1},
+					{ContractAddress: genContractAddress(1, 1).String(), ID: "b", Height: 2, Payload: []byte(`{}`)},
+				}
+			}),
+			mockVM: noopVMMock,
+		},
+		"export with timelocked gov proposals": {
+			srcState: types.DeterministicGenesisStateFixture(t, func(state *types.GenesisState) {
+				state.PrivilegedContractAddresses = nil
+				state.TimelockedGovProposals = []types.TimelockedGovProposal{
+					types.TimelockedGovProposalFixture(t, func(p *types.TimelockedGovProposal) { p.ID, p.ExecuteTime = 2, time.Unix(2, 0).UTC() }),
+					types.TimelockedGovProposalFixture(t, func(p *types.TimelockedGovProposal) { p.ID, p.ExecuteTime = 1, time.Unix(3, 0).UTC() }),
+				}
+				state.TimelockedGovProposalSequence = 3
+			}),
+			expState: types.DeterministicGenesisStateFixture(t, func(state *types.GenesisState) {
+				state.PrivilegedContractAddresses = nil
+				state.TimelockedGovProposals = []types.TimelockedGovProposal{
+					types.TimelockedGovProposalFixture(t, func(p *types.TimelockedGovProposal) { p.ID, p.ExecuteTime = 2, time.Unix(2, 0).UTC() }),
+					types.TimelockedGovProposalFixture(t, func(p *types.TimelockedGovProposal) { p.ID, p.ExecuteTime = 1, time.Unix(3, 0).UTC() }),
+				}
+				state.TimelockedGovProposalSequence = 3
+			}),
+			mockVM: noopVMMock,
+		},
 		"export without privileged contracts": {
 			srcState: types.DeterministicGenesisStateFixture(t, func(state *types.GenesisState) {
 				state.PrivilegedContractAddresses = nil
@@ -346,6 +456,115 @@ func TestExportGenesis(t *testing.T) {
 	}
 }
 
+func TestExportCustomState(t *testing.T) {
+	pageResponse := func(data, nextCursor string) []byte {
+		bz, err := json.Marshal(contract.ExportPageResponse{Data: []byte(data), NextCursor: []byte(nextCursor)})
+		require.NoError(t, err)
+		return bz
+	}
+	specs := map[string]struct {
+		paged     bool
+		pages     func(cursor string) ([]byte, error) // response by cursor
+		exportErr error
+		exp       *types.CustomModel
+		expErr    bool
+	}{
+		"pages": {
+			paged: true,
+			pages: pagesByCursor(map[string][]byte{"": pageResponse(`{"page":1}`, "a"), "a": pageResponse(`{"page":2}`, "")}),
+			exp:   &types.CustomModel{Chunks: []wasmtypes.RawContractMessage{[]byte(`{"page":1}`), []byte(`{"page":2}`)}},
+		},
+		"single message": {
+			exp: &types.CustomModel{Msg: wasmtypes.RawContractMessage(`{"my":"state"}`)},
+		},
+		"single message fails": {
+			exportErr: errors.New("testing"),
+			expErr:    true,
+		},
+		"first page fails": {
+			paged: true,
+			pages: func(string) ([]byte, error) {
+				return nil, errors.New("testing")
+			},
+			expErr: true,
+		},
+		"first page invalid": {
+			paged:  true,
+			pages:  pagesByCursor(map[string][]byte{"": []byte(`{"my":"state"}`)}),
+			expErr: true,
+		},
+		"cursor not advanced": {
+			paged:  true,
+			pages:  pagesByCursor(map[string][]byte{"": pageResponse(`{"page":1}`, "a"), "a": pageResponse(`{"page":2}`, "a")}),
+			expErr: true,
+		},
+		"cursor cycle": {
+			paged: true,
+			pages: pagesByCursor(map[string][]byte{
+				"":  pageResponse(`{"page":1}`, "a"),
+				"a": pageResponse(`{"page":2}`, "b"),
+				"b": pageResponse(`{"page":3}`, "a"),
+			}),
+			expErr: true,
+		},
+		"max pages exceeded": {
+			paged: true,
+			pages: func(cursor string) ([]byte, error) {
+				return pageResponse(`{}`, cursor+"a"), nil // fresh cursor every time
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+				m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					var msg contract.PetriSudoMsg
+					require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+					if !spec.paged {
+						require.NotNil(t, msg.Export)
+						if spec.exportErr != nil {
+							return nil, 0, spec.exportErr
+						}
+						return &wasmvmtypes.Response{Data: []byte(`{"my":"state"}`)}, 0, nil
+					}
+					require.NotNil(t, msg.ExportPage)
+					rsp, err := spec.pages(string(msg.ExportPage.Cursor))
+					if err != nil {
+						return nil, 0, err
+					}
+					return &wasmvmtypes.Response{Data: rsp}, 0, nil
+				}
+			})
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+			k := keepers.TWasmKeeper
+			_, contractAddr := seedTestContract(t, ctx, k)
+
+			// when
+			got, gotErr := k.exportCustomState(ctx, contractAddr, spec.paged)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+// pagesByCursor returns the export page responses from the map. Fails for unknown cursors.
+func pagesByCursor(pages map[string][]byte) func(cursor string) ([]byte, error) {
+	return func(cursor string) ([]byte, error) {
+		rsp, ok := pages[cursor]
+		if !ok {
+			return nil, fmt.Errorf("unexpected cursor %q", cursor)
+		}
+		return rsp, nil
+	}
+}
+
 func setContractPrivilege(t *testing.T, ctx sdk.Context, keepers TestKeepers, contractAddr sdk.AccAddress, priv types.PrivilegeType) {
 	t.Helper()
 	var details types.PetriContractDetails
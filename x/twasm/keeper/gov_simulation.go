@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/oldfurya/furya/x/twasm/contract"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+// SimulateGovProposal dry-runs the gov proposal of a contract. The proposal is converted and validated like in the
+// `execute_gov_proposal` message and routed to the gov proposal handler in a cache context that is discarded.
+// Returns the error that the execution would fail with. Privileges and the timelock are not considered.
+// Proposals that would call into the wasm VM are rejected as the simulation runs within a contract query.
+func (k Keeper) SimulateGovProposal(ctx sdk.Context, sender sdk.AccAddress, exec contract.ExecuteGovProposal) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			// out of gas must not be converted into a regular error
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+		}
+	}()
+	if err := exec.UnpackInterfaces(k.cdc); err != nil {
+		return sdkerrors.Wrap(err, "unpack")
+	}
+	content := exec.GetProposalContent(sender)
+	if content == nil {
+		return sdkerrors.Wrap(wasmtypes.ErrUnknownMsg, "unsupported content type")
+	}
+	if err := content.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "content")
+	}
+	if callsVM(content) {
+		return sdkerrors.Wrapf(wasmtypes.ErrUnsupportedForContract, "simulation of %s proposals", content.ProposalType())
+	}
+	router := restrictParamsDecorator(k.govRouter)
+	if !router.HasRoute(content.ProposalRoute()) {
+		return sdkerrors.Wrap(govtypes.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+	// state changes and events are discarded
+	cacheCtx, _ := ctx.CacheContext()
+	return router.GetRoute(content.ProposalRoute())(cacheCtx, content)
+}
+
+// callsVM returns true for content types with handlers that store, instantiate or call contract code
+func callsVM(content govtypes.Content) bool {
+	switch content.(type) {
+	case *wasmtypes.StoreCodeProposal,
+		*wasmtypes.InstantiateContractProposal,
+		*wasmtypes.MigrateContractProposal,
+		*wasmtypes.SudoContractProposal,
+		*wasmtypes.ExecuteContractProposal,
+		*wasmtypes.PinCodesProposal,
+		*wasmtypes.UnpinCodesProposal,
+		*types.PromoteToPrivilegedContractProposal,
+		*types.DemotePrivilegedContractProposal:
+		return true
+	default:
+		return false
+	}
+}
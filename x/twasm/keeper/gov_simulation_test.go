@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oldfurya/furya/x/twasm/contract"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+func TestSimulateGovProposal(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := *keepers.TWasmKeeper
+	myKey := []byte("my-key")
+	var captured []govtypes.Content
+	k.govRouter = govtypes.NewRouter().
+		AddRoute(govtypes.RouterKey, func(ctx sdk.Context, content govtypes.Content) error {
+			captured = append(captured, content)
+			ctx.KVStore(k.storeKey).Set(myKey, []byte("value"))
+			switch content.GetTitle() {
+			case "fail":
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "testing")
+			case "panic":
+				panic("testing")
+			}
+			return nil
+		})
+	withTitle := func(title string) contract.ExecuteGovProposal {
+		return contract.ExecuteGovProposalFixture(func(p *contract.ExecuteGovProposal) {
+			p.Title = title
+		})
+	}
+	withProposal := func(mutator func(x *contract.GovProposal)) contract.ExecuteGovProposal {
+		return contract.ExecuteGovProposalFixture(func(p *contract.ExecuteGovProposal) {
+			p.Proposal = contract.GovProposalFixture(mutator)
+		})
+	}
+
+	specs := map[string]struct {
+		src         contract.ExecuteGovProposal
+		expErr      *sdkerrors.Error
+		expCaptured bool
+	}{
+		"all good": {
+			src:         contract.ExecuteGovProposalFixture(),
+			expCaptured: true,
+		},
+		"handler fails": {
+			src:         withTitle("fail"),
+			expErr:      sdkerrors.ErrInvalidRequest,
+			expCaptured: true,
+		},
+		"handler panics": {
+			src:         withTitle("panic"),
+			expErr:      sdkerrors.ErrPanic,
+			expCaptured: true,
+		},
+		"invalid content": {
+			src:    withTitle(""),
+			expErr: govtypes.ErrInvalidProposalContent,
+		},
+		"no content": {
+			src:    contract.ExecuteGovProposal{Title: "foo", Description: "bar"},
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+		"no handler for route": {
+			src: contract.ExecuteGovProposalFixture(func(p *contract.ExecuteGovProposal) {
+				p.Proposal = contract.GovProposalFixture(func(x *contract.GovProposal) {
+					x.ChangeParams = &[]proposaltypes.ParamChange{{Subspace: "foo", Key: "bar", Value: `{}`}}
+				})
+			}),
+			expErr: govtypes.ErrNoProposalHandlerExists,
+		},
+		"store code rejected": {
+			src: withProposal(func(x *contract.GovProposal) {
+				x.StoreCode = &wasmtypes.StoreCodeProposal{WASMByteCode: []byte{0x1}}
+			}),
+			expErr: wasmtypes.ErrUnsupportedForContract,
+		},
+		"migrate contract rejected": {
+			src: withProposal(func(x *contract.GovProposal) {
+				x.MigrateContract = &wasmtypes.MigrateContractProposal{Contract: RandomAddress(t).String(), CodeID: 1, Msg: []byte(`{}`)}
+			}),
+			expErr: wasmtypes.ErrUnsupportedForContract,
+		},
+		"pin codes rejected": {
+			src: withProposal(func(x *contract.GovProposal) {
+				x.PinCodes = &wasmtypes.PinCodesProposal{CodeIDs: []uint64{1}}
+			}),
+			expErr: wasmtypes.ErrUnsupportedForContract,
+		},
+		"unpin codes rejected": {
+			src: withProposal(func(x *contract.GovProposal) {
+				x.UnpinCodes = &wasmtypes.UnpinCodesProposal{CodeIDs: []uint64{1}}
+			}),
+			expErr: wasmtypes.ErrUnsupportedForContract,
+		},
+		"promote to privileged contract rejected": {
+			src: withProposal(func(x *contract.GovProposal) {
+				x.PromoteToPrivilegedContract = &types.PromoteToPrivilegedContractProposal{Contract: RandomAddress(t).String()}
+			}),
+			expErr: wasmtypes.ErrUnsupportedForContract,
+		},
+		"demote privileged contract rejected": {
+			src: withProposal(func(x *contract.GovProposal) {
+				x.DemotePrivilegedContract = &types.DemotePrivilegedContractProposal{Contract: RandomAddress(t).String()}
+			}),
+			expErr: wasmtypes.ErrUnsupportedForContract,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			captured = nil
+			ctx, _ := ctx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			// when
+			gotErr := k.SimulateGovProposal(ctx, RandomAddress(t), spec.src)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+			} else {
+				require.NoError(t, gotErr)
+			}
+			assert.Equal(t, spec.expCaptured, len(captured) == 1)
+			assert.False(t, ctx.KVStore(k.storeKey).Has(myKey))
+			assert.Empty(t, em.Events())
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"math"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -14,6 +15,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	poetypes "github.com/oldfurya/furya/x/poe/types"
 	"github.com/oldfurya/furya/x/twasm/contract"
@@ -27,11 +29,20 @@ type PetriWasmHandlerKeeper interface {
 	removePrivilegeRegistration(ctx sdk.Context, privilegeType types.PrivilegeType, pos uint8, contractAddr sdk.AccAddress) bool
 	setContractDetails(ctx sdk.Context, contract sdk.AccAddress, details *types.PetriContractDetails) error
 	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	ConsumeMintAllowance(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error
+	ScheduleCallback(ctx sdk.Context, callback types.ScheduledCallback) error
+	CancelScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, id string) error
+	GetTWasmParams(ctx sdk.Context) types.TWasmParams
+	QueueGovProposal(ctx sdk.Context, sender sdk.AccAddress, content govtypes.Content) (types.TimelockedGovProposal, error)
+	QueueConsensusParamsUpdate(ctx sdk.Context, sender sdk.AccAddress, update *contract.ConsensusParamsUpdate) (types.TimelockedGovProposal, error)
+	VetoGovProposal(ctx sdk.Context, vetoContract sdk.AccAddress, id uint64) error
+	HasDelegatorGrant(ctx sdk.Context, staker, contractAddr sdk.AccAddress) bool
 }
 
 // bankKeeper is a subset of the SDK bank keeper
 type bankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -53,6 +64,7 @@ type PetriHandler struct {
 	bankKeeper             bankKeeper
 	govRouter              govtypes.Router
 	consensusParamsUpdater ConsensusParamsUpdater
+	privileges             *PrivilegeRegistry
 }
 
 // NewPetriHandler constructor
@@ -62,6 +74,7 @@ func NewPetriHandler(
 	bankKeeper bankKeeper,
 	consensusParamsUpdater ConsensusParamsUpdater,
 	govRouter govtypes.Router,
+	privileges *PrivilegeRegistry,
 ) *PetriHandler {
 	return &PetriHandler{
 		cdc:                    cdc,
@@ -69,6 +82,7 @@ func NewPetriHandler(
 		govRouter:              restrictParamsDecorator(govRouter),
 		bankKeeper:             bankKeeper,
 		consensusParamsUpdater: consensusParamsUpdater,
+		privileges:             privileges,
 	}
 }
 
@@ -97,6 +111,9 @@ func (h PetriHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress,
 	case tMsg.MintTokens != nil:
 		evts, err := h.handleMintToken(ctx, contractAddr, tMsg.MintTokens)
 		return append(evts, em.Events()...), nil, err
+	case tMsg.BurnTokens != nil:
+		evts, err := h.handleBurnToken(ctx, contractAddr, tMsg.BurnTokens)
+		return append(evts, em.Events()...), nil, err
 	case tMsg.ConsensusParams != nil:
 		evts, err := h.handleConsensusParamsUpdate(ctx, contractAddr, tMsg.ConsensusParams)
 		return append(evts, em.Events()...), nil, err
@@ -106,6 +123,25 @@ func (h PetriHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress,
 	case tMsg.Undelegate != nil:
 		evts, err := h.handleUndelegate(ctx, contractAddr, tMsg.Undelegate)
 		return append(evts, em.Events()...), nil, err
+	case tMsg.ScheduleCallback != nil:
+		evts, err := h.handleScheduleCallback(ctx, contractAddr, tMsg.ScheduleCallback)
+		return append(evts, em.Events()...), nil, err
+	case tMsg.CancelCallback != nil:
+		evts, err := h.handleCancelCallback(ctx, contractAddr, tMsg.CancelCallback)
+		return append(evts, em.Events()...), nil, err
+	case tMsg.VetoGovProposal != nil:
+		err := h.handleVetoGovProposal(ctx, contractAddr, tMsg.VetoGovProposal)
+		return em.Events(), nil, err
+	}
+	// messages of the privilege extensions
+	if name, payload, ok := singleKeyObject(msg.Custom); ok {
+		if privilegeType, handler, ok := h.privileges.msgHandler(name); ok {
+			if err := h.assertHasPrivilege(ctx, contractAddr, privilegeType); err != nil {
+				return nil, nil, err
+			}
+			evts, data, err := handler(ctx, contractAddr, payload)
+			return append(evts, em.Events()...), data, err
+		}
 	}
 
 	return nil, nil, sdkerrors.Wrapf(wasmtypes.ErrUnknownMsg, "unknown type: %T", msg)
@@ -158,7 +194,7 @@ func (h PetriHandler) handlePrivilege(ctx sdk.Context, contractAddr sdk.AccAddre
 	}
 }
 
-// handle gov proposal execution
+// handle gov proposal execution. Proposals of a timelocked type are queued and executed in the end blocker.
 func (h PetriHandler) handleGovProposalExecution(ctx sdk.Context, contractAddr sdk.AccAddress, exec *contract.ExecuteGovProposal) error {
 	if err := h.assertHasPrivilege(ctx, contractAddr, types.PrivilegeTypeGovProposalExecutor); err != nil {
 		return err
@@ -174,6 +210,14 @@ func (h PetriHandler) handleGovProposalExecution(ctx sdk.Context, contractAddr s
 	if !h.govRouter.HasRoute(content.ProposalRoute()) {
 		return sdkerrors.Wrap(govtypes.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
+	if h.keeper.GetTWasmParams(ctx).IsTimelocked(content.ProposalType()) {
+		// fail early instead of on execution
+		if err := assertNoBaseParamsChange(content); err != nil {
+			return err
+		}
+		_, err := h.keeper.QueueGovProposal(ctx, contractAddr, content)
+		return sdkerrors.Wrap(err, "queue")
+	}
 	govHandler := h.govRouter.GetRoute(content.ProposalRoute())
 	return govHandler(ctx, content)
 }
@@ -195,6 +239,9 @@ func (h PetriHandler) handleMintToken(ctx sdk.Context, contractAddr sdk.AccAddre
 	if err := token.Validate(); err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error()), "mint tokens handler")
 	}
+	if err := h.keeper.ConsumeMintAllowance(ctx, contractAddr, token); err != nil {
+		return nil, sdkerrors.Wrap(err, "mint allowance")
+	}
 	if err := h.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token)); err != nil {
 		return nil, sdkerrors.Wrap(err, "mint")
 	}
@@ -211,7 +258,38 @@ func (h PetriHandler) handleMintToken(ctx sdk.Context, contractAddr sdk.AccAddre
 	)}, nil
 }
 
-// handle the consensus parameters update message
+// handle burn token message
+func (h PetriHandler) handleBurnToken(ctx sdk.Context, contractAddr sdk.AccAddress, burn *contract.BurnTokens) ([]sdk.Event, error) {
+	if err := h.assertHasPrivilege(ctx, contractAddr, types.PrivilegeTypeTokenBurner); err != nil {
+		return nil, err
+	}
+	amount, ok := sdk.NewIntFromString(burn.Amount)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, burn.Amount+burn.Denom)
+	}
+	token := sdk.Coin{Denom: burn.Denom, Amount: amount}
+	if err := token.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error()), "burn tokens handler")
+	}
+	if !token.IsPositive() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	if err := h.bankKeeper.SendCoinsFromAccountToModule(ctx, contractAddr, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return nil, sdkerrors.Wrap(err, "contract to module")
+	}
+	if err := h.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return nil, sdkerrors.Wrap(err, "burn")
+	}
+
+	return sdk.Events{sdk.NewEvent(
+		types.EventTypeBurnTokens,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, token.String()),
+	)}, nil
+}
+
+// handle the consensus parameters update message. Updates are queued and applied in the end blocker when the
+// consensus params are a timelocked type.
 func (h PetriHandler) handleConsensusParamsUpdate(ctx sdk.Context, contractAddr sdk.AccAddress, pUpdate *contract.ConsensusParamsUpdate) ([]sdk.Event, error) {
 	if err := h.assertHasPrivilege(ctx, contractAddr, types.PrivilegeConsensusParamChanger); err != nil {
 		return nil, err
@@ -219,21 +297,42 @@ func (h PetriHandler) handleConsensusParamsUpdate(ctx sdk.Context, contractAddr
 	if err := pUpdate.ValidateBasic(); err != nil {
 		return nil, err
 	}
-	params := h.consensusParamsUpdater.GetConsensusParams(ctx)
-	h.consensusParamsUpdater.StoreConsensusParams(ctx, mergeConsensusParamsUpdate(params, pUpdate))
+	if h.keeper.GetTWasmParams(ctx).IsTimelocked(types.TimelockTypeConsensusParams) {
+		_, err := h.keeper.QueueConsensusParamsUpdate(ctx, contractAddr, pUpdate)
+		return nil, sdkerrors.Wrap(err, "queue")
+	}
+	params, err := mergeConsensusParamsUpdate(h.consensusParamsUpdater.GetConsensusParams(ctx), pUpdate)
+	if err != nil {
+		return nil, err
+	}
+	h.consensusParamsUpdater.StoreConsensusParams(ctx, params)
 	return nil, nil
 }
 
-func mergeConsensusParamsUpdate(src *abci.ConsensusParams, delta *contract.ConsensusParamsUpdate) *abci.ConsensusParams {
+// mergeConsensusParamsUpdate applies the delta to the source params and validates the result.
+// Invalid consensus params would halt the chain when passed to tendermint.
+func mergeConsensusParamsUpdate(src *abci.ConsensusParams, delta *contract.ConsensusParamsUpdate) (*abci.ConsensusParams, error) {
+	if src == nil {
+		return nil, sdkerrors.Wrap(wasmtypes.ErrNotFound, "consensus params")
+	}
 	if delta.Block != nil {
+		if src.Block == nil {
+			src.Block = &abci.BlockParams{}
+		}
 		if delta.Block.MaxBytes != nil {
 			src.Block.MaxBytes = *delta.Block.MaxBytes
 		}
 		if delta.Block.MaxGas != nil {
 			src.Block.MaxGas = *delta.Block.MaxGas
 		}
+		if err := baseapp.ValidateBlockParams(*src.Block); err != nil {
+			return nil, sdkerrors.Wrap(wasmtypes.ErrInvalid, err.Error())
+		}
 	}
 	if delta.Evidence != nil {
+		if src.Evidence == nil {
+			src.Evidence = &tmproto.EvidenceParams{}
+		}
 		if delta.Evidence.MaxAgeNumBlocks != nil {
 			src.Evidence.MaxAgeNumBlocks = *delta.Evidence.MaxAgeNumBlocks
 		}
@@ -243,8 +342,23 @@ func mergeConsensusParamsUpdate(src *abci.ConsensusParams, delta *contract.Conse
 		if delta.Evidence.MaxBytes != nil {
 			src.Evidence.MaxBytes = *delta.Evidence.MaxBytes
 		}
+		if err := baseapp.ValidateEvidenceParams(*src.Evidence); err != nil {
+			return nil, sdkerrors.Wrap(wasmtypes.ErrInvalid, err.Error())
+		}
+	}
+	if delta.Validator != nil {
+		src.Validator = &tmproto.ValidatorParams{PubKeyTypes: delta.Validator.PubKeyTypes}
+		if err := baseapp.ValidateValidatorParams(*src.Validator); err != nil {
+			return nil, sdkerrors.Wrap(wasmtypes.ErrInvalid, err.Error())
+		}
 	}
-	return src
+	if delta.Version != nil && delta.Version.AppVersion != nil {
+		if src.Version != nil && *delta.Version.AppVersion < src.Version.AppVersion {
+			return nil, sdkerrors.Wrapf(wasmtypes.ErrInvalid, "app version must not be lower than current %d", src.Version.AppVersion)
+		}
+		src.Version = &tmproto.VersionParams{AppVersion: *delta.Version.AppVersion}
+	}
+	return src, nil
 }
 
 // handle delegate token message
@@ -260,6 +374,9 @@ func (h PetriHandler) handleDelegate(ctx sdk.Context, contractAddr sdk.AccAddres
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
+	if !h.isDelegationAuthorized(ctx, fromAddr, contractAddr) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "staker must sign the tx or grant the contract")
+	}
 	if err := h.bankKeeper.DelegateCoinsFromAccountToModule(ctx, fromAddr, poetypes.BondedPoolName, amt); err != nil {
 		return nil, sdkerrors.Wrap(err, "delegate")
 	}
@@ -275,6 +392,16 @@ func (h PetriHandler) handleDelegate(ctx sdk.Context, contractAddr sdk.AccAddres
 	)}, nil
 }
 
+// isDelegationAuthorized returns true when the staker signed the tx or has granted the contract to delegate
+func (h PetriHandler) isDelegationAuthorized(ctx sdk.Context, staker, contractAddr sdk.AccAddress) bool {
+	for _, signer := range types.TxSigners(ctx) {
+		if signer.Equals(staker) {
+			return true
+		}
+	}
+	return h.keeper.HasDelegatorGrant(ctx, staker, contractAddr)
+}
+
 // handle undelegate token message
 func (h PetriHandler) handleUndelegate(ctx sdk.Context, contractAddr sdk.AccAddress, undelegate *contract.Undelegate) ([]sdk.Event, error) {
 	if err := h.assertHasPrivilege(ctx, contractAddr, types.PrivilegeDelegator); err != nil {
@@ -303,6 +430,64 @@ func (h PetriHandler) handleUndelegate(ctx sdk.Context, contractAddr sdk.AccAddr
 	)}, nil
 }
 
+// handle schedule callback message
+func (h PetriHandler) handleScheduleCallback(ctx sdk.Context, contractAddr sdk.AccAddress, schedule *contract.ScheduleCallback) ([]sdk.Event, error) {
+	if err := h.assertHasPrivilege(ctx, contractAddr, types.PrivilegeTypeScheduledCallback); err != nil {
+		return nil, err
+	}
+	callback := types.ScheduledCallback{
+		ContractAddress: contractAddr.String(),
+		ID:              schedule.ID,
+		Payload:         schedule.Payload,
+	}
+	if schedule.Height != nil {
+		if *schedule.Height > math.MaxInt64 {
+			return nil, sdkerrors.Wrap(wasmtypes.ErrInvalid, "height")
+		}
+		callback.Height = int64(*schedule.Height)
+	}
+	if schedule.Time != nil {
+		if *schedule.Time > math.MaxInt64 {
+			return nil, sdkerrors.Wrap(wasmtypes.ErrInvalid, "time")
+		}
+		t := time.Unix(0, int64(*schedule.Time)).UTC()
+		callback.Time = &t
+	}
+	if err := h.keeper.ScheduleCallback(ctx, callback); err != nil {
+		return nil, sdkerrors.Wrap(err, "schedule callback")
+	}
+	return sdk.Events{sdk.NewEvent(
+		types.EventTypeScheduleCallback,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyCallbackID, schedule.ID),
+	)}, nil
+}
+
+// handle cancel callback message
+func (h PetriHandler) handleCancelCallback(ctx sdk.Context, contractAddr sdk.AccAddress, cancel *contract.CancelCallback) ([]sdk.Event, error) {
+	if err := h.assertHasPrivilege(ctx, contractAddr, types.PrivilegeTypeScheduledCallback); err != nil {
+		return nil, err
+	}
+	if err := h.keeper.CancelScheduledCallback(ctx, contractAddr, cancel.ID); err != nil {
+		return nil, sdkerrors.Wrap(err, "cancel callback")
+	}
+	return sdk.Events{sdk.NewEvent(
+		types.EventTypeCancelCallback,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyCallbackID, cancel.ID),
+	)}, nil
+}
+
+// handle veto of a queued gov proposal. Only the configured veto contract is authorized. Like all PetriMsgs, the
+// message is only dispatched for privileged contracts so that the veto contract must be privileged, too.
+func (h PetriHandler) handleVetoGovProposal(ctx sdk.Context, contractAddr sdk.AccAddress, veto *contract.VetoGovProposal) error {
+	vetoContract := h.keeper.GetTWasmParams(ctx).GovProposalVetoContract
+	if vetoContract == "" || vetoContract != contractAddr.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not the gov proposal veto contract")
+	}
+	return sdkerrors.Wrap(h.keeper.VetoGovProposal(ctx, contractAddr, veto.ProposalID), "veto")
+}
+
 // assertHasPrivilege helper to assert that the contract has the required privilege
 func (h PetriHandler) assertHasPrivilege(ctx sdk.Context, contractAddr sdk.AccAddress, requiredPrivilege types.PrivilegeType) error {
 	contractInfo := h.keeper.GetContractInfo(ctx, contractAddr)
@@ -340,13 +525,8 @@ func (d restrictedParamsRouter) GetRoute(path string) (h govtypes.Handler) {
 	r := d.nested.GetRoute(path)
 	if path == paramproposal.RouterKey {
 		return func(ctx sdk.Context, content govtypes.Content) error {
-			if p, ok := content.(*paramproposal.ParameterChangeProposal); ok {
-				// prevent updates in baseapp subspace
-				for _, c := range p.Changes {
-					if c.Subspace == baseapp.Paramspace {
-						return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "base params can not be modified via params proposal")
-					}
-				}
+			if err := assertNoBaseParamsChange(content); err != nil {
+				return err
 			}
 			return r(ctx, content)
 		}
@@ -354,6 +534,18 @@ func (d restrictedParamsRouter) GetRoute(path string) (h govtypes.Handler) {
 	return r
 }
 
+// assertNoBaseParamsChange prevents updates in baseapp subspace
+func assertNoBaseParamsChange(content govtypes.Content) error {
+	if p, ok := content.(*paramproposal.ParameterChangeProposal); ok {
+		for _, c := range p.Changes {
+			if c.Subspace == baseapp.Paramspace {
+				return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "base params can not be modified via params proposal")
+			}
+		}
+	}
+	return nil
+}
+
 func (d restrictedParamsRouter) AddRoute(r string, h govtypes.Handler) (rtr govtypes.Router) {
 	panic("not supported")
 }
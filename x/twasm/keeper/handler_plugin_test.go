@@ -1,12 +1,19 @@
 package keeper
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"math"
 	"testing"
+	"time"
 
 	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvm "github.com/CosmWasm/wasmvm"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,6 +22,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/oldfurya/furya/x/twasm/contract"
 	"github.com/oldfurya/furya/x/twasm/types"
@@ -67,6 +76,19 @@ func TestPetriHandlesDispatchMsg(t *testing.T) {
 				sdk.NewAttribute(types.AttributeKeyRecipient, otherAddr.String()),
 			)},
 		},
+		"handle burn msg": {
+			src: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"burn_tokens":{"amount":"1","denom":"ufury"}}`),
+			},
+			setup: func(m *handlerPetriKeeperMock) {
+				setupHandlerKeeperMock(m, withPrivilegeSet(t, types.PrivilegeTypeTokenBurner))
+			},
+			expEvents: sdk.Events{sdk.NewEvent(
+				types.EventTypeBurnTokens,
+				sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, "1ufury"),
+			)},
+		},
 		"handle consensus params change msg": {
 			src: wasmvmtypes.CosmosMsg{
 				Custom: []byte(`{"consensus_params":{"block":{"max_gas":100000000}}}`),
@@ -81,6 +103,9 @@ func TestPetriHandlesDispatchMsg(t *testing.T) {
 			},
 			setup: func(m *handlerPetriKeeperMock) {
 				setupHandlerKeeperMock(m, withPrivilegeSet(t, types.PrivilegeDelegator))
+				m.HasDelegatorGrantFn = func(ctx sdk.Context, staker, contractAddr sdk.AccAddress) bool {
+					return true
+				}
 			},
 			expEvents: sdk.Events{sdk.NewEvent(
 				types.EventTypeDelegateTokens,
@@ -135,7 +160,7 @@ func TestPetriHandlesDispatchMsg(t *testing.T) {
 			mock := handlerPetriKeeperMock{}
 			consensusStoreMock := NoopConsensusParamsStoreMock()
 			spec.setup(&mock)
-			h := NewPetriHandler(cdc, mock, bankMock, consensusStoreMock, govRouter)
+			h := NewPetriHandler(cdc, mock, bankMock, consensusStoreMock, govRouter, nil)
 			em := sdk.NewEventManager()
 			ctx := sdk.Context{}.WithEventManager(em)
 
@@ -379,7 +404,7 @@ func TestPetriHandlesPrivilegeMsg(t *testing.T) {
 			capturedDetails, capturedRegistrations, capturedUnRegistrations = nil, nil, nil
 			mock := handlerPetriKeeperMock{}
 			spec.setup(&mock)
-			h := NewPetriHandler(nil, mock, nil, nil, nil)
+			h := NewPetriHandler(nil, mock, nil, nil, nil, nil)
 			var ctx sdk.Context
 			gotErr := h.handlePrivilege(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
@@ -400,6 +425,7 @@ func TestHandleGovProposalExecution(t *testing.T) {
 		setup                 func(m *handlerPetriKeeperMock)
 		expErr                *sdkerrors.Error
 		expCapturedGovContent []govtypes.Content
+		expQueuedGovContent   []govtypes.Content
 	}{
 		"all good": {
 			src:                   contract.ExecuteGovProposalFixture(),
@@ -471,18 +497,248 @@ func TestHandleGovProposalExecution(t *testing.T) {
 			setup:  withPrivilegeRegistered(types.PrivilegeTypeGovProposalExecutor),
 			expErr: sdkerrors.ErrUnauthorized,
 		},
+		"timelocked type queued": {
+			src: contract.ExecuteGovProposalFixture(),
+			setup: func(m *handlerPetriKeeperMock) {
+				withPrivilegeRegistered(types.PrivilegeTypeGovProposalExecutor)(m)
+				withTimelock(govtypes.ProposalTypeText)(m)
+			},
+			expQueuedGovContent: []govtypes.Content{&govtypes.TextProposal{Title: "foo", Description: "bar"}},
+		},
+		"other type not queued": {
+			src: contract.ExecuteGovProposalFixture(),
+			setup: func(m *handlerPetriKeeperMock) {
+				withPrivilegeRegistered(types.PrivilegeTypeGovProposalExecutor)(m)
+				withTimelock(proposaltypes.ProposalTypeChange)(m)
+			},
+			expCapturedGovContent: []govtypes.Content{&govtypes.TextProposal{Title: "foo", Description: "bar"}},
+		},
+		"timelocked consensus params rejected": {
+			src: contract.ExecuteGovProposalFixture(func(p *contract.ExecuteGovProposal) {
+				p.Proposal = contract.GovProposalFixture(func(x *contract.GovProposal) {
+					x.ChangeParams = &[]proposaltypes.ParamChange{
+						{
+							Subspace: "baseapp",
+							Key:      "BlockParams",
+							Value:    `{"max_bytes": "1"}`,
+						},
+					}
+				})
+			}),
+			setup: func(m *handlerPetriKeeperMock) {
+				withPrivilegeRegistered(types.PrivilegeTypeGovProposalExecutor)(m)
+				withTimelock(proposaltypes.ProposalTypeChange)(m)
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			cdc := MakeEncodingConfig(t).Codec
 			mock := handlerPetriKeeperMock{}
 			spec.setup(&mock)
+			var queued []govtypes.Content
+			if mock.QueueGovProposalFn != nil {
+				mock.QueueGovProposalFn = func(ctx sdk.Context, sender sdk.AccAddress, content govtypes.Content) (types.TimelockedGovProposal, error) {
+					queued = append(queued, content)
+					return types.TimelockedGovProposal{}, nil
+				}
+			}
 			router := &CapturingGovRouter{}
-			h := NewPetriHandler(cdc, mock, nil, nil, router)
+			h := NewPetriHandler(cdc, mock, nil, nil, router, nil)
 			var ctx sdk.Context
 			gotErr := h.handleGovProposalExecution(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
 			assert.Equal(t, spec.expCapturedGovContent, router.captured)
+			assert.Equal(t, spec.expQueuedGovContent, queued)
+		})
+	}
+}
+
+func TestHandleVetoGovProposal(t *testing.T) {
+	myContractAddr := RandomAddress(t)
+	specs := map[string]struct {
+		vetoContract string
+		expErr       *sdkerrors.Error
+		expVetoed    bool
+	}{
+		"veto contract": {
+			vetoContract: myContractAddr.String(),
+			expVetoed:    true,
+		},
+		"other contract": {
+			vetoContract: types.RandomBech32Address(t),
+			expErr:       sdkerrors.ErrUnauthorized,
+		},
+		"no veto contract configured": {
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotID uint64
+			mock := handlerPetriKeeperMock{
+				GetTWasmParamsFn: func(ctx sdk.Context) types.TWasmParams {
+					p := types.DefaultTWasmParams()
+					p.GovProposalVetoContract = spec.vetoContract
+					return p
+				},
+				VetoGovProposalFn: func(ctx sdk.Context, vetoContract sdk.AccAddress, id uint64) error {
+					gotID = id
+					return nil
+				},
+			}
+			h := NewPetriHandler(nil, mock, nil, nil, nil, nil)
+			var ctx sdk.Context
+			gotErr := h.handleVetoGovProposal(ctx, myContractAddr, &contract.VetoGovProposal{ProposalID: 7})
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+			if spec.expVetoed {
+				assert.Equal(t, uint64(7), gotID)
+			} else {
+				assert.Equal(t, uint64(0), gotID)
+			}
+		})
+	}
+}
+
+func TestHandlePrivilegeExtensionMsg(t *testing.T) {
+	myContractAddr := RandomAddress(t)
+	var capturedMsg json.RawMessage
+	privileges := NewPrivilegeRegistry()
+	myPrivilegeType := privileges.Register(PrivilegeExtension{
+		ID:   0xf0,
+		Name: "testing_privilege",
+		MsgHandler: func(ctx sdk.Context, contractAddr sdk.AccAddress, msg json.RawMessage) ([]sdk.Event, [][]byte, error) {
+			require.Equal(t, myContractAddr, contractAddr)
+			capturedMsg = msg
+			ctx.EventManager().EmitEvent(sdk.NewEvent("testing_ctx"))
+			return []sdk.Event{sdk.NewEvent("testing")}, [][]byte{[]byte("data")}, nil
+		},
+	})
+	privileges.Register(PrivilegeExtension{ID: 0xf1, Name: "testing_privilege_without_handler"})
+
+	specs := map[string]struct {
+		src         string
+		setup       func(m *handlerPetriKeeperMock)
+		expErr      *sdkerrors.Error
+		expCaptured json.RawMessage
+	}{
+		"privilege registered": {
+			src:         `{"testing_privilege":{"foo":"bar"}}`,
+			setup:       withPrivilegeRegistered(myPrivilegeType),
+			expCaptured: json.RawMessage(`{"foo":"bar"}`),
+		},
+		"privilege not registered": {
+			src:    `{"testing_privilege":{"foo":"bar"}}`,
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeEndBlock),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"no msg handler": {
+			src:    `{"testing_privilege_without_handler":{}}`,
+			setup:  withPrivilegeRegistered(myPrivilegeType),
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+		"unknown msg": {
+			src:    `{"unknown":{}}`,
+			setup:  withPrivilegeRegistered(myPrivilegeType),
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			capturedMsg = nil
+			mock := handlerPetriKeeperMock{
+				IsPrivilegedFn: func(ctx sdk.Context, contract sdk.AccAddress) bool {
+					return true
+				},
+			}
+			spec.setup(&mock)
+			h := NewPetriHandler(MakeEncodingConfig(t).Codec, mock, nil, nil, nil, privileges)
+			ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+			// when
+			gotEvents, gotData, gotErr := h.DispatchMsg(ctx, myContractAddr, "", wasmvmtypes.CosmosMsg{Custom: []byte(spec.src)})
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				assert.Nil(t, capturedMsg)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.JSONEq(t, string(spec.expCaptured), string(capturedMsg))
+			assert.Equal(t, []sdk.Event{sdk.NewEvent("testing"), sdk.NewEvent("testing_ctx")}, gotEvents)
+			assert.Equal(t, [][]byte{[]byte("data")}, gotData)
+		})
+	}
+}
+
+func TestHandleGovProposalExecutionWithWasmProposals(t *testing.T) {
+	var capturedSudoMsgs, capturedExecuteMsgs [][]byte
+	var capturedSenders []string
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+		m.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+			capturedSudoMsgs = append(capturedSudoMsgs, sudoMsg)
+			return &wasmvmtypes.Response{}, 0, nil
+		}
+		m.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+			capturedExecuteMsgs = append(capturedExecuteMsgs, executeMsg)
+			capturedSenders = append(capturedSenders, info.Sender)
+			return &wasmvmtypes.Response{}, 0, nil
+		}
+	})))
+	k := keepers.TWasmKeeper
+	codeID, myContractAddr := seedTestContract(t, ctx, k)
+	_, myExecutorAddr := seedTestContract(t, ctx, k)
+	var details types.PetriContractDetails
+	details.AddRegisteredPrivilege(types.PrivilegeTypeGovProposalExecutor, 1)
+	require.NoError(t, k.setContractDetails(ctx, myExecutorAddr, &details))
+
+	router := govtypes.NewRouter().AddRoute(wasmtypes.RouterKey, NewProposalHandler(*k))
+	h := NewPetriHandler(MakeEncodingConfig(t).Codec, k, keepers.BankKeeper, nil, router, nil)
+
+	specs := map[string]struct {
+		src       contract.GovProposal
+		assertRes func(t *testing.T, ctx sdk.Context)
+	}{
+		"sudo contract": {
+			src: contract.GovProposalFixture(func(x *contract.GovProposal) {
+				x.SudoContract = &wasmtypes.SudoContractProposal{Contract: myContractAddr.String(), Msg: []byte(`{"foo":"bar"}`)}
+			}),
+			assertRes: func(t *testing.T, ctx sdk.Context) {
+				assert.Equal(t, [][]byte{[]byte(`{"foo":"bar"}`)}, capturedSudoMsgs)
+			},
+		},
+		"execute contract": {
+			src: contract.GovProposalFixture(func(x *contract.GovProposal) {
+				x.ExecuteContract = &wasmtypes.ExecuteContractProposal{Contract: myContractAddr.String(), Msg: []byte(`{"foo":"bar"}`)}
+			}),
+			assertRes: func(t *testing.T, ctx sdk.Context) {
+				assert.Equal(t, [][]byte{[]byte(`{"foo":"bar"}`)}, capturedExecuteMsgs)
+				assert.Equal(t, []string{myExecutorAddr.String()}, capturedSenders)
+			},
+		},
+		"update instantiate config": {
+			src: contract.GovProposalFixture(func(x *contract.GovProposal) {
+				x.UpdateInstantiateConfig = &wasmtypes.UpdateInstantiateConfigProposal{
+					AccessConfigUpdates: []wasmtypes.AccessConfigUpdate{{CodeID: codeID, InstantiatePermission: wasmtypes.AllowNobody}},
+				}
+			}),
+			assertRes: func(t *testing.T, ctx sdk.Context) {
+				assert.Equal(t, wasmtypes.AllowNobody, k.GetCodeInfo(ctx, codeID).InstantiateConfig)
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			capturedSudoMsgs, capturedExecuteMsgs, capturedSenders = nil, nil, nil
+			ctx, _ := ctx.CacheContext()
+			src := contract.ExecuteGovProposalFixture(func(p *contract.ExecuteGovProposal) {
+				p.Proposal = spec.src
+			})
+			// when
+			gotErr := h.handleGovProposalExecution(ctx, myExecutorAddr, &src)
+			// then
+			require.NoError(t, gotErr)
+			spec.assertRes(t, ctx)
 		})
 	}
 }
@@ -561,6 +817,20 @@ func TestHandleMintToken(t *testing.T) {
 			},
 			expErr: sdkerrors.ErrInvalidAddress,
 		},
+		"mint allowance exceeded": {
+			src: contract.MintTokens{
+				Denom:         "foo",
+				Amount:        "123",
+				RecipientAddr: myRecipientAddr.String(),
+			},
+			setup: func(k *handlerPetriKeeperMock) {
+				withPrivilegeRegistered(types.PrivilegeTypeTokenMinter)(k)
+				k.ConsumeMintAllowanceFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error {
+					return wasmtypes.ErrLimit
+				}
+			},
+			expErr: wasmtypes.ErrLimit,
+		},
 		"no content": {
 			src:    contract.MintTokens{},
 			setup:  withPrivilegeRegistered(types.PrivilegeTypeTokenMinter),
@@ -588,7 +858,7 @@ func TestHandleMintToken(t *testing.T) {
 			mock := BankMock{MintCoinsFn: mintFn, SendCoinsFromModuleToAccountFn: sendFn}
 			keeperMock := handlerPetriKeeperMock{}
 			spec.setup(&keeperMock)
-			h := NewPetriHandler(cdc, keeperMock, mock, nil, nil)
+			h := NewPetriHandler(cdc, keeperMock, mock, nil, nil, nil)
 			var ctx sdk.Context
 			gotEvts, gotErr := h.handleMintToken(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
@@ -607,17 +877,100 @@ func TestHandleMintToken(t *testing.T) {
 	}
 }
 
+func TestHandleBurnToken(t *testing.T) {
+	myContractAddr := RandomAddress(t)
+	specs := map[string]struct {
+		src            contract.BurnTokens
+		setup          func(k *handlerPetriKeeperMock)
+		expErr         *sdkerrors.Error
+		expBurnedCoins sdk.Coins
+	}{
+		"all good": {
+			src:            contract.BurnTokens{Denom: "foo", Amount: "123"},
+			setup:          withPrivilegeRegistered(types.PrivilegeTypeTokenBurner),
+			expBurnedCoins: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(123))),
+		},
+		"unauthorized contract": {
+			src:    contract.BurnTokens{Denom: "foo", Amount: "123"},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeTokenMinter),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"invalid denom": {
+			src:    contract.BurnTokens{Denom: "&&&foo", Amount: "123"},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeTokenBurner),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"invalid amount": {
+			src:    contract.BurnTokens{Denom: "foo", Amount: "not-a-number"},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeTokenBurner),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"zero amount": {
+			src:    contract.BurnTokens{Denom: "foo", Amount: "0"},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeTokenBurner),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"no content": {
+			src:    contract.BurnTokens{},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeTokenBurner),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"unknown origin contract": {
+			src: contract.BurnTokens{Denom: "foo", Amount: "123"},
+			setup: func(m *handlerPetriKeeperMock) {
+				m.GetContractInfoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+					return nil
+				}
+			},
+			expErr: wasmtypes.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cdc := MakeEncodingConfig(t).Codec
+			sendFn, capturedSentCoins := CaptureSentCoinsFromAccountFn()
+			var capturedBurnedCoins []sdk.Coins
+			burnFn := func(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+				require.Equal(t, types.ModuleName, moduleName)
+				capturedBurnedCoins = append(capturedBurnedCoins, amt)
+				return nil
+			}
+			mock := BankMock{BurnCoinsFn: burnFn, SendCoinsFromAccountToModuleFn: sendFn}
+			keeperMock := handlerPetriKeeperMock{}
+			spec.setup(&keeperMock)
+			h := NewPetriHandler(cdc, keeperMock, mock, nil, nil, nil)
+			var ctx sdk.Context
+			gotEvts, gotErr := h.handleBurnToken(ctx, myContractAddr, &spec.src)
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				assert.Len(t, gotEvts, 0)
+				assert.Empty(t, capturedBurnedCoins)
+				return
+			}
+			require.Len(t, *capturedSentCoins, 1)
+			assert.Equal(t, spec.expBurnedCoins, (*capturedSentCoins)[0].coins)
+			assert.Equal(t, types.ModuleName, (*capturedSentCoins)[0].recipientModule)
+			assert.Equal(t, []sdk.Coins{spec.expBurnedCoins}, capturedBurnedCoins)
+			require.Len(t, gotEvts, 1)
+			assert.Equal(t, types.EventTypeBurnTokens, gotEvts[0].Type)
+		})
+	}
+}
+
 func TestHandleConsensusParamsUpdate(t *testing.T) {
 	var (
 		myContractAddr = RandomAddress(t)
 		// some integers
-		one, two, three, four, five int64 = 1, 2, 3, 4, 5
+		zero, one, two, three, four, five int64  = 0, 1, 2, 3, 4, 5
+		appVersion, lowerAppVersion       uint64 = 2, 1
 	)
 	specs := map[string]struct {
 		src       contract.ConsensusParamsUpdate
 		setup     func(k *handlerPetriKeeperMock)
+		current   func(c *abci.ConsensusParams)
 		expErr    *sdkerrors.Error
 		expStored *abci.ConsensusParams
+		expQueued bool
 	}{
 		"all good": {
 			src: contract.ConsensusParamsUpdate{
@@ -654,29 +1007,121 @@ func TestHandleConsensusParamsUpdate(t *testing.T) {
 			},
 			expErr: sdkerrors.ErrUnauthorized,
 		},
+		"validator and version params": {
+			src: contract.ConsensusParamsUpdate{
+				Validator: &contract.ValidatorParams{
+					PubKeyTypes: []string{tmtypes.ABCIPubKeyTypeEd25519, tmtypes.ABCIPubKeyTypeSecp256k1},
+				},
+				Version: &contract.VersionParams{
+					AppVersion: &appVersion,
+				},
+			},
+			setup: withPrivilegeRegistered(types.PrivilegeConsensusParamChanger),
+			expStored: types.ConsensusParamsFixture(func(c *abci.ConsensusParams) {
+				c.Validator.PubKeyTypes = []string{tmtypes.ABCIPubKeyTypeEd25519, tmtypes.ABCIPubKeyTypeSecp256k1}
+				c.Version = &tmproto.VersionParams{AppVersion: 2}
+			}),
+		},
+		"same app version": {
+			src: contract.ConsensusParamsUpdate{
+				Version: &contract.VersionParams{
+					AppVersion: &appVersion,
+				},
+			},
+			setup: withPrivilegeRegistered(types.PrivilegeConsensusParamChanger),
+			current: func(c *abci.ConsensusParams) {
+				c.Version = &tmproto.VersionParams{AppVersion: 2}
+			},
+			expStored: types.ConsensusParamsFixture(func(c *abci.ConsensusParams) {
+				c.Version = &tmproto.VersionParams{AppVersion: 2}
+			}),
+		},
+		"lower app version": {
+			src: contract.ConsensusParamsUpdate{
+				Version: &contract.VersionParams{
+					AppVersion: &lowerAppVersion,
+				},
+			},
+			setup: withPrivilegeRegistered(types.PrivilegeConsensusParamChanger),
+			current: func(c *abci.ConsensusParams) {
+				c.Version = &tmproto.VersionParams{AppVersion: 2}
+			},
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"invalid merged block params": {
+			src: contract.ConsensusParamsUpdate{
+				Block: &contract.BlockParams{
+					MaxBytes: &zero,
+				},
+			},
+			setup:  withPrivilegeRegistered(types.PrivilegeConsensusParamChanger),
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"invalid merged evidence params": {
+			src: contract.ConsensusParamsUpdate{
+				Evidence: &contract.EvidenceParams{
+					MaxAgeDuration: &zero,
+				},
+			},
+			setup:  withPrivilegeRegistered(types.PrivilegeConsensusParamChanger),
+			expErr: wasmtypes.ErrInvalid,
+		},
 		"invalid msg": {
 			src:    contract.ConsensusParamsUpdate{},
 			setup:  withPrivilegeRegistered(types.PrivilegeConsensusParamChanger),
 			expErr: wasmtypes.ErrEmpty,
 		},
+		"timelocked": {
+			src: contract.ConsensusParamsUpdate{
+				Block: &contract.BlockParams{
+					MaxBytes: &one,
+				},
+			},
+			setup: func(k *handlerPetriKeeperMock) {
+				withPrivilegeRegistered(types.PrivilegeConsensusParamChanger)(k)
+				k.GetTWasmParamsFn = func(ctx sdk.Context) types.TWasmParams {
+					p := types.DefaultTWasmParams()
+					p.GovProposalTimelockBlocks = 1
+					p.TimelockedProposalTypes = []string{types.TimelockTypeConsensusParams}
+					return p
+				}
+			},
+			expQueued: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			cdc := MakeEncodingConfig(t).Codec
+			var gotQueued *contract.ConsensusParamsUpdate
 			var gotStored *abci.ConsensusParams
 			mock := ConsensusParamsStoreMock{
-				GetConsensusParamsFn:   func(ctx sdk.Context) *abci.ConsensusParams { return types.ConsensusParamsFixture() },
+				GetConsensusParamsFn: func(ctx sdk.Context) *abci.ConsensusParams {
+					if spec.current != nil {
+						return types.ConsensusParamsFixture(spec.current)
+					}
+					return types.ConsensusParamsFixture()
+				},
 				StoreConsensusParamsFn: func(ctx sdk.Context, cp *abci.ConsensusParams) { gotStored = cp },
 			}
 
-			keeperMock := handlerPetriKeeperMock{}
+			keeperMock := handlerPetriKeeperMock{
+				QueueConsensusParamsUpdateFn: func(ctx sdk.Context, sender sdk.AccAddress, update *contract.ConsensusParamsUpdate) (types.TimelockedGovProposal, error) {
+					gotQueued = update
+					return types.TimelockedGovProposal{}, nil
+				},
+			}
 			spec.setup(&keeperMock)
-			h := NewPetriHandler(cdc, keeperMock, nil, mock, nil)
+			h := NewPetriHandler(cdc, keeperMock, nil, mock, nil, nil)
 			var ctx sdk.Context
 			gotEvts, gotErr := h.handleConsensusParamsUpdate(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
 			assert.Len(t, gotEvts, 0)
 			assert.Equal(t, spec.expStored, gotStored)
+			if spec.expQueued {
+				assert.Equal(t, &spec.src, gotQueued)
+			} else {
+				assert.Nil(t, gotQueued)
+			}
 		})
 	}
 }
@@ -684,8 +1129,17 @@ func TestHandleConsensusParamsUpdate(t *testing.T) {
 func TestHandleDelegate(t *testing.T) {
 	myContractAddr := RandomAddress(t)
 	myStakerAddr := RandomAddress(t)
+	withDelegatorGrant := func(granted bool) func(k *handlerPetriKeeperMock) {
+		return func(k *handlerPetriKeeperMock) {
+			withPrivilegeRegistered(types.PrivilegeDelegator)(k)
+			k.HasDelegatorGrantFn = func(ctx sdk.Context, staker, contractAddr sdk.AccAddress) bool {
+				return granted && staker.Equals(myStakerAddr) && contractAddr.Equals(myContractAddr)
+			}
+		}
+	}
 	specs := map[string]struct {
 		src               contract.Delegate
+		txSigners         []sdk.AccAddress
 		setup             func(k *handlerPetriKeeperMock)
 		expErr            *sdkerrors.Error
 		expSentCoins      sdk.Coins
@@ -699,6 +1153,7 @@ func TestHandleDelegate(t *testing.T) {
 				},
 				StakerAddr: myStakerAddr.String(),
 			},
+			txSigners:    []sdk.AccAddress{RandomAddress(t), myStakerAddr},
 			setup:        withPrivilegeRegistered(types.PrivilegeDelegator),
 			expSentCoins: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(123))),
 			expDelegatedCoins: capturedSentCoinsFromAddress{
@@ -706,6 +1161,33 @@ func TestHandleDelegate(t *testing.T) {
 				coins:           sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(123))),
 			},
 		},
+		"granted by staker": {
+			src: contract.Delegate{
+				Funds: wasmvmtypes.Coin{
+					Denom:  "foo",
+					Amount: "123",
+				},
+				StakerAddr: myStakerAddr.String(),
+			},
+			setup:        withDelegatorGrant(true),
+			expSentCoins: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(123))),
+			expDelegatedCoins: capturedSentCoinsFromAddress{
+				recipientModule: "bonded_tokens_pool",
+				coins:           sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(123))),
+			},
+		},
+		"staker neither signer nor granted": {
+			src: contract.Delegate{
+				Funds: wasmvmtypes.Coin{
+					Denom:  "foo",
+					Amount: "123",
+				},
+				StakerAddr: myStakerAddr.String(),
+			},
+			txSigners: []sdk.AccAddress{RandomAddress(t)},
+			setup:     withDelegatorGrant(false),
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
 		"unauthorized contract": {
 			src: contract.Delegate{
 				Funds: wasmvmtypes.Coin{
@@ -797,8 +1279,8 @@ func TestHandleDelegate(t *testing.T) {
 			mock := BankMock{DelegateCoinsFromAccountToModuleFn: delegateFn, SendCoinsFromModuleToAccountFn: sendFn}
 			keeperMock := handlerPetriKeeperMock{}
 			spec.setup(&keeperMock)
-			h := NewPetriHandler(cdc, keeperMock, mock, nil, nil)
-			var ctx sdk.Context
+			h := NewPetriHandler(cdc, keeperMock, mock, nil, nil, nil)
+			ctx := types.WithTxSigners(sdk.Context{}.WithContext(context.Background()), spec.txSigners)
 			gotEvts, gotErr := h.handleDelegate(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
 			if spec.expErr != nil {
@@ -932,7 +1414,7 @@ func TestHandleUndelegate(t *testing.T) {
 			mock := BankMock{UndelegateCoinsFromModuleToAccountFn: undelegateFn, SendCoinsFromAccountToModuleFn: sendFn}
 			keeperMock := handlerPetriKeeperMock{}
 			spec.setup(&keeperMock)
-			h := NewPetriHandler(cdc, keeperMock, mock, nil, nil)
+			h := NewPetriHandler(cdc, keeperMock, mock, nil, nil, nil)
 			var ctx sdk.Context
 			gotEvts, gotErr := h.handleUndelegate(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
@@ -951,6 +1433,136 @@ func TestHandleUndelegate(t *testing.T) {
 	}
 }
 
+func TestHandleScheduleCallback(t *testing.T) {
+	var (
+		myContractAddr = RandomAddress(t)
+		myHeight       = uint64(100)
+		myTime         = uint64(1_000_000_000)
+		overflow       = uint64(math.MaxInt64) + 1
+	)
+	myTimestamp := time.Unix(1, 0).UTC()
+	specs := map[string]struct {
+		src         contract.ScheduleCallback
+		setup       func(k *handlerPetriKeeperMock)
+		keeperErr   error
+		expErr      *sdkerrors.Error
+		expCallback *types.ScheduledCallback
+	}{
+		"by height": {
+			src:   contract.ScheduleCallback{ID: "my-id", Height: &myHeight, Payload: []byte(`{}`)},
+			setup: withPrivilegeRegistered(types.PrivilegeTypeScheduledCallback),
+			expCallback: &types.ScheduledCallback{
+				ContractAddress: myContractAddr.String(),
+				ID:              "my-id",
+				Height:          100,
+				Payload:         []byte(`{}`),
+			},
+		},
+		"by time": {
+			src:   contract.ScheduleCallback{ID: "my-id", Time: &myTime},
+			setup: withPrivilegeRegistered(types.PrivilegeTypeScheduledCallback),
+			expCallback: &types.ScheduledCallback{
+				ContractAddress: myContractAddr.String(),
+				ID:              "my-id",
+				Time:            &myTimestamp,
+			},
+		},
+		"height overflow": {
+			src:    contract.ScheduleCallback{ID: "my-id", Height: &overflow},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeScheduledCallback),
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"time overflow": {
+			src:    contract.ScheduleCallback{ID: "my-id", Time: &overflow},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeScheduledCallback),
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"keeper rejects": {
+			src:       contract.ScheduleCallback{ID: "my-id", Height: &myHeight},
+			setup:     withPrivilegeRegistered(types.PrivilegeTypeScheduledCallback),
+			keeperErr: wasmtypes.ErrDuplicate,
+			expErr:    wasmtypes.ErrDuplicate,
+		},
+		"unauthorized contract": {
+			src:    contract.ScheduleCallback{ID: "my-id", Height: &myHeight},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeEndBlock),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotCallback *types.ScheduledCallback
+			keeperMock := handlerPetriKeeperMock{
+				ScheduleCallbackFn: func(ctx sdk.Context, callback types.ScheduledCallback) error {
+					gotCallback = &callback
+					return spec.keeperErr
+				},
+			}
+			spec.setup(&keeperMock)
+			h := NewPetriHandler(nil, keeperMock, nil, nil, nil, nil)
+			var ctx sdk.Context
+			gotEvts, gotErr := h.handleScheduleCallback(ctx, myContractAddr, &spec.src)
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				assert.Len(t, gotEvts, 0)
+				return
+			}
+			assert.Equal(t, spec.expCallback, gotCallback)
+			expEvt := sdk.NewEvent(types.EventTypeScheduleCallback,
+				sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, myContractAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyCallbackID, spec.src.ID),
+			)
+			assert.Equal(t, []sdk.Event{expEvt}, gotEvts)
+		})
+	}
+}
+
+func TestHandleCancelCallback(t *testing.T) {
+	myContractAddr := RandomAddress(t)
+	specs := map[string]struct {
+		setup     func(k *handlerPetriKeeperMock)
+		keeperErr error
+		expErr    *sdkerrors.Error
+	}{
+		"all good": {
+			setup: withPrivilegeRegistered(types.PrivilegeTypeScheduledCallback),
+		},
+		"unknown id": {
+			setup:     withPrivilegeRegistered(types.PrivilegeTypeScheduledCallback),
+			keeperErr: wasmtypes.ErrNotFound,
+			expErr:    wasmtypes.ErrNotFound,
+		},
+		"unauthorized contract": {
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeEndBlock),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotID string
+			keeperMock := handlerPetriKeeperMock{
+				CancelScheduledCallbackFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, id string) error {
+					require.Equal(t, myContractAddr, contractAddr)
+					gotID = id
+					return spec.keeperErr
+				},
+			}
+			spec.setup(&keeperMock)
+			h := NewPetriHandler(nil, keeperMock, nil, nil, nil, nil)
+			var ctx sdk.Context
+			gotEvts, gotErr := h.handleCancelCallback(ctx, myContractAddr, &contract.CancelCallback{ID: "my-id"})
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				assert.Len(t, gotEvts, 0)
+				return
+			}
+			assert.Equal(t, "my-id", gotID)
+			require.Len(t, gotEvts, 1)
+			assert.Equal(t, types.EventTypeCancelCallback, gotEvts[0].Type)
+		})
+	}
+}
+
 func withPrivilegeRegistered(p types.PrivilegeType) func(k *handlerPetriKeeperMock) {
 	return func(k *handlerPetriKeeperMock) {
 		k.GetContractInfoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
@@ -961,6 +1573,23 @@ func withPrivilegeRegistered(p types.PrivilegeType) func(k *handlerPetriKeeperMo
 			})
 			return &c
 		}
+		k.ConsumeMintAllowanceFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error {
+			return nil
+		}
+	}
+}
+
+func withTimelock(proposalTypes ...string) func(k *handlerPetriKeeperMock) {
+	return func(k *handlerPetriKeeperMock) {
+		k.GetTWasmParamsFn = func(ctx sdk.Context) types.TWasmParams {
+			p := types.DefaultTWasmParams()
+			p.GovProposalTimelock = time.Hour
+			p.TimelockedProposalTypes = proposalTypes
+			return p
+		}
+		k.QueueGovProposalFn = func(ctx sdk.Context, sender sdk.AccAddress, content govtypes.Content) (types.TimelockedGovProposal, error) {
+			return types.TimelockedGovProposal{}, nil
+		}
 	}
 }
 
@@ -981,6 +1610,9 @@ func setupHandlerKeeperMock(m *handlerPetriKeeperMock, mutators ...func(*wasmtyp
 	m.setContractDetailsFn = func(ctx sdk.Context, contract sdk.AccAddress, details *types.PetriContractDetails) error {
 		return nil
 	}
+	m.ConsumeMintAllowanceFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error {
+		return nil
+	}
 }
 
 var _ PetriWasmHandlerKeeper = handlerPetriKeeperMock{}
@@ -991,6 +1623,14 @@ type handlerPetriKeeperMock struct {
 	removePrivilegeRegistrationFn func(ctx sdk.Context, privilegeType types.PrivilegeType, pos uint8, contractAddr sdk.AccAddress) bool
 	setContractDetailsFn          func(ctx sdk.Context, contract sdk.AccAddress, details *types.PetriContractDetails) error
 	GetContractInfoFn             func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	ConsumeMintAllowanceFn        func(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error
+	ScheduleCallbackFn            func(ctx sdk.Context, callback types.ScheduledCallback) error
+	CancelScheduledCallbackFn     func(ctx sdk.Context, contractAddr sdk.AccAddress, id string) error
+	GetTWasmParamsFn              func(ctx sdk.Context) types.TWasmParams
+	QueueGovProposalFn            func(ctx sdk.Context, sender sdk.AccAddress, content govtypes.Content) (types.TimelockedGovProposal, error)
+	QueueConsensusParamsUpdateFn  func(ctx sdk.Context, sender sdk.AccAddress, update *contract.ConsensusParamsUpdate) (types.TimelockedGovProposal, error)
+	VetoGovProposalFn             func(ctx sdk.Context, vetoContract sdk.AccAddress, id uint64) error
+	HasDelegatorGrantFn           func(ctx sdk.Context, staker, contractAddr sdk.AccAddress) bool
 }
 
 func (m handlerPetriKeeperMock) IsPrivileged(ctx sdk.Context, contract sdk.AccAddress) bool {
@@ -1028,9 +1668,67 @@ func (m handlerPetriKeeperMock) GetContractInfo(ctx sdk.Context, contractAddress
 	return m.GetContractInfoFn(ctx, contractAddress)
 }
 
+func (m handlerPetriKeeperMock) ConsumeMintAllowance(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error {
+	if m.ConsumeMintAllowanceFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ConsumeMintAllowanceFn(ctx, contractAddr, amount)
+}
+
+func (m handlerPetriKeeperMock) ScheduleCallback(ctx sdk.Context, callback types.ScheduledCallback) error {
+	if m.ScheduleCallbackFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ScheduleCallbackFn(ctx, callback)
+}
+
+func (m handlerPetriKeeperMock) CancelScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, id string) error {
+	if m.CancelScheduledCallbackFn == nil {
+		panic("not expected to be called")
+	}
+	return m.CancelScheduledCallbackFn(ctx, contractAddr, id)
+}
+
+// GetTWasmParams returns the default params when no function is set
+func (m handlerPetriKeeperMock) GetTWasmParams(ctx sdk.Context) types.TWasmParams {
+	if m.GetTWasmParamsFn == nil {
+		return types.DefaultTWasmParams()
+	}
+	return m.GetTWasmParamsFn(ctx)
+}
+
+func (m handlerPetriKeeperMock) QueueGovProposal(ctx sdk.Context, sender sdk.AccAddress, content govtypes.Content) (types.TimelockedGovProposal, error) {
+	if m.QueueGovProposalFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueueGovProposalFn(ctx, sender, content)
+}
+
+func (m handlerPetriKeeperMock) QueueConsensusParamsUpdate(ctx sdk.Context, sender sdk.AccAddress, update *contract.ConsensusParamsUpdate) (types.TimelockedGovProposal, error) {
+	if m.QueueConsensusParamsUpdateFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueueConsensusParamsUpdateFn(ctx, sender, update)
+}
+
+func (m handlerPetriKeeperMock) VetoGovProposal(ctx sdk.Context, vetoContract sdk.AccAddress, id uint64) error {
+	if m.VetoGovProposalFn == nil {
+		panic("not expected to be called")
+	}
+	return m.VetoGovProposalFn(ctx, vetoContract, id)
+}
+
+func (m handlerPetriKeeperMock) HasDelegatorGrant(ctx sdk.Context, staker, contractAddr sdk.AccAddress) bool {
+	if m.HasDelegatorGrantFn == nil {
+		panic("not expected to be called")
+	}
+	return m.HasDelegatorGrantFn(ctx, staker, contractAddr)
+}
+
 // BankMock test helper that satisfies the `bankKeeper` interface
 type BankMock struct {
 	MintCoinsFn                          func(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoinsFn                          func(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccountFn       func(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModuleFn       func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModuleFn   func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -1044,6 +1742,13 @@ func (m BankMock) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) e
 	return m.MintCoinsFn(ctx, moduleName, amt)
 }
 
+func (m BankMock) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	if m.BurnCoinsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.BurnCoinsFn(ctx, moduleName, amt)
+}
+
 func (m BankMock) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
 	if m.SendCoinsFromModuleToAccountFn == nil {
 		panic("not expected to be called")
@@ -1077,6 +1782,9 @@ func NoopBankMock() *BankMock {
 		MintCoinsFn: func(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
 			return nil
 		},
+		BurnCoinsFn: func(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+			return nil
+		},
 		SendCoinsFromModuleToAccountFn: func(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
 			return nil
 		},
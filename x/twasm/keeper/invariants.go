@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+// RegisterInvariants registers all twasm invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "privileged-flags", PrivilegedFlagsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "privilege-registrations", PrivilegeRegistrationsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "privileged-code-pinned", PrivilegedCodePinnedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "singleton-privileges", SingletonPrivilegesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the twasm module
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			PrivilegedFlagsInvariant(k),
+			PrivilegeRegistrationsInvariant(k),
+			PrivilegedCodePinnedInvariant(k),
+			SingletonPrivilegesInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// AssertInvariants returns an error when any of the twasm invariants is broken
+func (k Keeper) AssertInvariants(ctx sdk.Context) error {
+	if msg, broken := AllInvariants(&k)(ctx); broken {
+		return fmt.Errorf("%s", msg)
+	}
+	return nil
+}
+
+// PrivilegedFlagsInvariant checks that every privileged flag points to an existing contract and that all contracts
+// with privilege registrations have the privileged flag set.
+func PrivilegedFlagsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+		k.IteratePrivileged(ctx, func(contractAddr sdk.AccAddress) bool {
+			if k.GetContractInfo(ctx, contractAddr) == nil {
+				broken++
+				msg += fmt.Sprintf("\tprivileged flag set for unknown contract %s\n", contractAddr)
+			}
+			return false
+		})
+		k.iteratePrivilegeRegistrations(ctx, func(privilegeType types.PrivilegeType, pos uint8, contractAddr sdk.AccAddress) bool {
+			if !k.IsPrivileged(ctx, contractAddr) {
+				broken++
+				msg += fmt.Sprintf("\tcontract %s registered for %q at position %d without privileged flag\n", contractAddr, privilegeType, pos)
+			}
+			return false
+		})
+		k.iterateContractDetails(ctx, func(contractAddr sdk.AccAddress, details types.PetriContractDetails) bool {
+			if len(details.RegisteredPrivileges) != 0 && !k.IsPrivileged(ctx, contractAddr) {
+				broken++
+				msg += fmt.Sprintf("\tcontract %s has registered privileges without privileged flag\n", contractAddr)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "privileged-flags",
+			fmt.Sprintf("%d privileged flags out of sync\n%s", broken, msg)), broken != 0
+	}
+}
+
+// PrivilegeRegistrationsInvariant checks that the privilege registrations in the secondary index match the
+// registered privileges in the contract details
+func PrivilegeRegistrationsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+		k.iteratePrivilegeRegistrations(ctx, func(privilegeType types.PrivilegeType, pos uint8, contractAddr sdk.AccAddress) bool {
+			if privilegeType.ValidateBasic() != nil {
+				broken++
+				msg += fmt.Sprintf("\tunknown privilege type %d registered for contract %s\n", privilegeType, contractAddr)
+				return false
+			}
+			details, err := k.getContractDetails(ctx, contractAddr)
+			if err != nil {
+				broken++
+				msg += fmt.Sprintf("\tcontract details for %s: %s\n", contractAddr, err)
+				return false
+			}
+			if !hasRegisteredPrivilegeAt(*details, privilegeType, pos) {
+				broken++
+				msg += fmt.Sprintf("\tcontract %s registered for %q at position %d but not in contract details\n", contractAddr, privilegeType, pos)
+			}
+			return false
+		})
+		k.iterateContractDetails(ctx, func(contractAddr sdk.AccAddress, details types.PetriContractDetails) bool {
+			for _, p := range details.RegisteredPrivileges {
+				privilegeType := types.PrivilegeTypeFrom(p.PrivilegeType)
+				if privilegeType == nil {
+					broken++
+					msg += fmt.Sprintf("\tunknown privilege type %q in contract details of %s\n", p.PrivilegeType, contractAddr)
+					continue
+				}
+				registered := k.getPrivilegedContract(ctx, *privilegeType, uint8(p.Position))
+				if !contractAddr.Equals(registered) {
+					broken++
+					msg += fmt.Sprintf("\tcontract details of %s contain %q at position %d but registered is %q\n", contractAddr, p.PrivilegeType, p.Position, registered)
+				}
+			}
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "privilege-registrations",
+			fmt.Sprintf("%d privilege registrations out of sync\n%s", broken, msg)), broken != 0
+	}
+}
+
+// PrivilegedCodePinnedInvariant checks that the code of every privileged contract is pinned
+func PrivilegedCodePinnedInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+		k.IteratePrivileged(ctx, func(contractAddr sdk.AccAddress) bool {
+			contractInfo := k.GetContractInfo(ctx, contractAddr)
+			if contractInfo == nil { // covered by the privileged flags invariant
+				return false
+			}
+			if !k.IsPinnedCode(ctx, contractInfo.CodeID) {
+				broken++
+				msg += fmt.Sprintf("\tcode %d of privileged contract %s not pinned\n", contractInfo.CodeID, contractAddr)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "privileged-code-pinned",
+			fmt.Sprintf("%d privileged contracts with unpinned code\n%s", broken, msg)), broken != 0
+	}
+}
+
+// SingletonPrivilegesInvariant checks that singleton privilege types have at most one registered contract
+func SingletonPrivilegesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		counts := make(map[types.PrivilegeType]int)
+		k.iteratePrivilegeRegistrations(ctx, func(privilegeType types.PrivilegeType, _ uint8, _ sdk.AccAddress) bool {
+			counts[privilegeType]++
+			return false
+		})
+		var broken int
+		for _, name := range types.AllPrivilegeTypeNames() {
+			privilegeType := *types.PrivilegeTypeFrom(name)
+			if privilegeType.IsSingleton() && counts[privilegeType] > 1 {
+				broken++
+				msg += fmt.Sprintf("\t%d contracts registered for singleton %q\n", counts[privilegeType], name)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "singleton-privileges",
+			fmt.Sprintf("%d singleton privileges with multiple contracts\n%s", broken, msg)), broken != 0
+	}
+}
+
+// iteratePrivilegeRegistrations iterates through the secondary index of all privilege types including unknown ones
+func (k Keeper) iteratePrivilegeRegistrations(ctx sdk.Context, cb func(privilegeType types.PrivilegeType, pos uint8, contractAddr sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), contractCallbacksSecondaryIndexPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// key is `<privilegeType><position>`
+		key := iter.Key()
+		if cb(types.PrivilegeType(key[0]), parseContractPosition(key[1:]), iter.Value()) {
+			return
+		}
+	}
+}
+
+// iterateContractDetails iterates through all contracts with furya contract details
+func (k Keeper) iterateContractDetails(ctx sdk.Context, cb func(contractAddr sdk.AccAddress, details types.PetriContractDetails) bool) {
+	k.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, info wasmtypes.ContractInfo) bool {
+		var details types.PetriContractDetails
+		if err := info.ReadExtension(&details); err != nil {
+			return false
+		}
+		return cb(contractAddr, details)
+	})
+}
+
+func hasRegisteredPrivilegeAt(details types.PetriContractDetails, privilegeType types.PrivilegeType, pos uint8) bool {
+	for _, p := range details.RegisteredPrivileges {
+		if p.PrivilegeType == privilegeType.String() && p.Position == uint32(pos) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+func TestInvariants(t *testing.T) {
+	specs := map[string]struct {
+		alterState func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress)
+		expBroken  string
+	}{
+		"all good": {
+			alterState: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {},
+		},
+		"flag without contract": {
+			alterState: func(t *testing.T, ctx sdk.Context, k *Keeper, _ sdk.AccAddress) {
+				k.setPrivilegedFlag(ctx, RandomAddress(t))
+			},
+			expBroken: "privileged-flags",
+		},
+		"registration without flag": {
+			alterState: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				k.clearPrivilegedFlag(ctx, contractAddr)
+			},
+			expBroken: "privileged-flags",
+		},
+		"registration without contract details": {
+			alterState: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				k.storeContractPrivilegeRegistration(ctx, types.PrivilegeTypeEndBlock, 1, contractAddr)
+			},
+			expBroken: "privilege-registrations",
+		},
+		"contract details without registration": {
+			alterState: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				ctx.KVStore(k.storeKey).Delete(contractPrivilegesSecondaryIndexKey(types.PrivilegeTypeBeginBlock, 1))
+			},
+			expBroken: "privilege-registrations",
+		},
+		"code not pinned": {
+			alterState: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				require.NoError(t, k.contractKeeper.UnpinCode(ctx, k.GetContractInfo(ctx, contractAddr).CodeID))
+			},
+			expBroken: "privileged-code-pinned",
+		},
+		"multiple contracts for singleton": {
+			alterState: func(t *testing.T, ctx sdk.Context, k *Keeper, _ sdk.AccAddress) {
+				codeID, otherContractAddr := seedTestContract(t, ctx, k)
+				details := types.PetriContractDetails{
+					RegisteredPrivileges: []types.RegisteredPrivilege{{PrivilegeType: types.PrivilegeTypeValidatorSetUpdate.String(), Position: 2}},
+				}
+				require.NoError(t, k.setContractDetails(ctx, otherContractAddr, &details))
+				require.NoError(t, k.importPrivileged(ctx, otherContractAddr, codeID, details))
+			},
+			expBroken: "singleton-privileges",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+				m.PinFn = func(checksum cosmwasm.Checksum) error { return nil }
+				m.UnpinFn = func(checksum cosmwasm.Checksum) error { return nil }
+			})
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+			k := keepers.TWasmKeeper
+
+			codeID, contractAddr := seedTestContract(t, ctx, k)
+			details := types.PetriContractDetails{
+				RegisteredPrivileges: []types.RegisteredPrivilege{
+					{PrivilegeType: types.PrivilegeTypeBeginBlock.String(), Position: 1},
+					{PrivilegeType: types.PrivilegeTypeValidatorSetUpdate.String(), Position: 1},
+				},
+			}
+			require.NoError(t, k.setContractDetails(ctx, contractAddr, &details))
+			require.NoError(t, k.importPrivileged(ctx, contractAddr, codeID, details))
+			require.NoError(t, k.AssertInvariants(ctx))
+
+			spec.alterState(t, ctx, k, contractAddr)
+
+			// when
+			gotErr := k.AssertInvariants(ctx)
+
+			// then
+			if spec.expBroken == "" {
+				require.NoError(t, gotErr)
+				return
+			}
+			require.Error(t, gotErr)
+			assert.Contains(t, gotErr.Error(), spec.expBroken)
+		})
+	}
+}
@@ -22,6 +22,8 @@ type Keeper struct {
 	contractKeeper wasmtypes.ContractOpsKeeper
 	paramSpace     paramtypes.Subspace
 	govRouter      govtypes.Router
+	// consensusParamsUpdater applies the timelocked consensus params updates
+	consensusParamsUpdater ConsensusParamsUpdater
 }
 
 func NewKeeper(
@@ -44,6 +46,10 @@ func NewKeeper(
 	availableCapabilities string,
 	opts ...wasmkeeper.Option,
 ) Keeper {
+	// set KeyTable with wasmd and twasm params if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
 	result := Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
@@ -74,10 +80,28 @@ func NewKeeper(
 	return result
 }
 
+// SetConsensusParamsUpdater sets the store for the consensus params updates that were queued with a timelock.
+// Must be called before the keeper is copied.
+func (k *Keeper) SetConsensusParamsUpdater(u ConsensusParamsUpdater) {
+	k.consensusParamsUpdater = u
+}
+
 func (k Keeper) setParams(ctx sdk.Context, ps wasmtypes.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
+// GetTWasmParams returns the twasm specific parameters. Defaults are returned for values not persisted.
+func (k Keeper) GetTWasmParams(ctx sdk.Context) types.TWasmParams {
+	params := types.DefaultTWasmParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetTWasmParams stores the twasm specific parameters
+func (k Keeper) SetTWasmParams(ctx sdk.Context, ps types.TWasmParams) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
 func WasmQuerier(k *Keeper) wasmtypes.QueryServer {
 	return wasmkeeper.NewGrpcQuerier(k.cdc, k.storeKey, k, k.QueryGasLimit())
 }
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// privilegedMigrationKeeper is a subset of Keeper that is needed to update privileged contracts on migrations
+type privilegedMigrationKeeper interface {
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	IsPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) bool
+	MigratePrivileged(ctx sdk.Context, contractAddr sdk.AccAddress, oldCodeID uint64) error
+}
+
+// migrateWithPrivileges executes the migrate function and updates the privileges when the contract is privileged.
+// Invalid addresses and unknown contracts are left to the migrate function to be rejected.
+func migrateWithPrivileges(ctx sdk.Context, k privilegedMigrationKeeper, contract string, migrate func() error) error {
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return migrate()
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil || !k.IsPrivileged(ctx, contractAddr) {
+		return migrate()
+	}
+	oldCodeID := contractInfo.CodeID
+	if err := migrate(); err != nil {
+		return err
+	}
+	return sdkerrors.Wrap(k.MigratePrivileged(ctx, contractAddr, oldCodeID), "privileged contract")
+}
+
+var _ wasmtypes.MsgServer = privilegedMigrationMsgServer{}
+
+// privilegedMigrationMsgServer decorates the wasm msg server to update privileged contracts after a migration
+type privilegedMigrationMsgServer struct {
+	wasmtypes.MsgServer
+	keeper privilegedMigrationKeeper
+}
+
+// NewPrivilegedMigrationMsgServer constructor
+func NewPrivilegedMigrationMsgServer(k privilegedMigrationKeeper, nested wasmtypes.MsgServer) wasmtypes.MsgServer {
+	return &privilegedMigrationMsgServer{MsgServer: nested, keeper: k}
+}
+
+// MigrateContract executes the migration in the nested msg server and notifies privileged contracts afterwards
+func (m privilegedMigrationMsgServer) MigrateContract(c context.Context, msg *wasmtypes.MsgMigrateContract) (*wasmtypes.MsgMigrateContractResponse, error) {
+	var rsp *wasmtypes.MsgMigrateContractResponse
+	err := migrateWithPrivileges(sdk.UnwrapSDKContext(c), m.keeper, msg.Contract, func() error {
+		var err error
+		rsp, err = m.MsgServer.MigrateContract(c, msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivilegedMigrationMsgServer(t *testing.T) {
+	myContractAddr := RandomAddress(t)
+	specs := map[string]struct {
+		src             string
+		mock            MockGovKeeper
+		nestedErr       error
+		expOldCodeID    uint64
+		expMigratedPriv bool
+		expErr          bool
+	}{
+		"privileged contract": {
+			src: myContractAddr.String(),
+			mock: MockGovKeeper{
+				GetContractInfoFn: contractInfoWithCodeID(1),
+				IsPrivilegedFn:    func(ctx sdk.Context, contractAddr sdk.AccAddress) bool { return true },
+			},
+			expOldCodeID:    1,
+			expMigratedPriv: true,
+		},
+		"non privileged contract": {
+			src: myContractAddr.String(),
+			mock: MockGovKeeper{
+				GetContractInfoFn: contractInfoWithCodeID(1),
+				IsPrivilegedFn:    func(ctx sdk.Context, contractAddr sdk.AccAddress) bool { return false },
+			},
+		},
+		"unknown contract": {
+			src: myContractAddr.String(),
+			mock: MockGovKeeper{
+				GetContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo { return nil },
+			},
+			nestedErr: wasmtypes.ErrNotFound,
+			expErr:    true,
+		},
+		"invalid address": {
+			src:       "invalid",
+			nestedErr: sdkerrors.ErrInvalidAddress,
+			expErr:    true,
+		},
+		"migration failed": {
+			src: myContractAddr.String(),
+			mock: MockGovKeeper{
+				GetContractInfoFn: contractInfoWithCodeID(1),
+				IsPrivilegedFn:    func(ctx sdk.Context, contractAddr sdk.AccAddress) bool { return true },
+			},
+			nestedErr: wasmtypes.ErrMigrationFailed,
+			expErr:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var migratedPriv bool
+			mock := spec.mock
+			mock.MigratePrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, oldCodeID uint64) error {
+				migratedPriv = true
+				assert.Equal(t, myContractAddr, contractAddr)
+				assert.Equal(t, spec.expOldCodeID, oldCodeID)
+				return nil
+			}
+			var nestedCalled bool
+			nested := &mockWasmMsgServer{
+				MigrateContractFn: func(c context.Context, msg *wasmtypes.MsgMigrateContract) (*wasmtypes.MsgMigrateContractResponse, error) {
+					nestedCalled = true
+					if spec.nestedErr != nil {
+						return nil, spec.nestedErr
+					}
+					return &wasmtypes.MsgMigrateContractResponse{Data: []byte("my-data")}, nil
+				},
+			}
+			srv := NewPrivilegedMigrationMsgServer(mock, nested)
+			ctx := sdk.Context{}.WithContext(context.Background())
+			// when
+			gotRsp, gotErr := srv.MigrateContract(sdk.WrapSDKContext(ctx), &wasmtypes.MsgMigrateContract{Contract: spec.src})
+			// then
+			assert.True(t, nestedCalled)
+			assert.Equal(t, spec.expMigratedPriv, migratedPriv)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, []byte("my-data"), gotRsp.Data)
+		})
+	}
+}
+
+type mockWasmMsgServer struct {
+	wasmtypes.MsgServer
+	MigrateContractFn func(c context.Context, msg *wasmtypes.MsgMigrateContract) (*wasmtypes.MsgMigrateContractResponse, error)
+}
+
+func (m mockWasmMsgServer) MigrateContract(c context.Context, msg *wasmtypes.MsgMigrateContract) (*wasmtypes.MsgMigrateContractResponse, error) {
+	if m.MigrateContractFn == nil {
+		panic("not expected to be called")
+	}
+	return m.MigrateContractFn(c, msg)
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+// SetMintAllowance stores the limits for a contract with the token minter privilege. Existing limits are replaced.
+// Tokens already minted by the contract are still accounted for.
+func (k Keeper) SetMintAllowance(ctx sdk.Context, contractAddr sdk.AccAddress, limits []types.MintLimit) error {
+	if k.GetContractInfo(ctx, contractAddr) == nil {
+		return sdkerrors.Wrap(wasmtypes.ErrNotFound, "contract info")
+	}
+	allowance := types.MintAllowance{ContractAddress: contractAddr.String(), Limits: limits}
+	if err := allowance.ValidateBasic(); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(mintAllowanceKey(contractAddr), k.cdc.MustMarshal(&allowance))
+
+	k.Logger(ctx).Info("Set mint allowance", "contractAddr", contractAddr.String())
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetMintAllowance,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+	))
+	return nil
+}
+
+// GetMintAllowance returns the allowance for the contract. Returns nil when none is set and the contract is not restricted.
+func (k Keeper) GetMintAllowance(ctx sdk.Context, contractAddr sdk.AccAddress) *types.MintAllowance {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(mintAllowanceKey(contractAddr))
+	if bz == nil {
+		return nil
+	}
+	var allowance types.MintAllowance
+	k.cdc.MustUnmarshal(bz, &allowance)
+	return &allowance
+}
+
+// IterateMintAllowances iterates through all mint allowances by contract address ASC
+func (k Keeper) IterateMintAllowances(ctx sdk.Context, cb func(allowance types.MintAllowance) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), mintAllowancePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var allowance types.MintAllowance
+		k.cdc.MustUnmarshal(iter.Value(), &allowance)
+		// cb returns true to stop early
+		if cb(allowance) {
+			return
+		}
+	}
+}
+
+// ConsumeMintAllowance tracks the minted tokens for the contract and fails when they exceed the allowance.
+// Contracts without an allowance are not restricted.
+func (k Keeper) ConsumeMintAllowance(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error {
+	allowance := k.GetMintAllowance(ctx, contractAddr)
+	if allowance == nil {
+		return nil
+	}
+	limit, ok := allowance.LimitFor(amount.Denom)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "denom %s not allowed", amount.Denom)
+	}
+	usage := k.GetMintUsage(ctx, contractAddr, amount.Denom)
+	usage.TotalMinted = usage.TotalMinted.Add(amount.Amount)
+	if limit.HasTotalCap() && usage.TotalMinted.GT(limit.TotalCap) {
+		return sdkerrors.Wrapf(wasmtypes.ErrLimit, "total cap of %s%s exceeded", limit.TotalCap, limit.Denom)
+	}
+	// start a new rate period when the current has ended
+	if limit.RatePeriod == 0 || ctx.BlockHeight() >= usage.PeriodStartHeight+int64(limit.RatePeriod) {
+		usage.PeriodStartHeight = ctx.BlockHeight()
+		usage.PeriodMinted = sdk.ZeroInt()
+	}
+	usage.PeriodMinted = usage.PeriodMinted.Add(amount.Amount)
+	if limit.HasRateLimit() && usage.PeriodMinted.GT(limit.RateLimit) {
+		return sdkerrors.Wrapf(wasmtypes.ErrLimit, "rate limit of %s%s per %d blocks exceeded", limit.RateLimit, limit.Denom, limit.RatePeriod)
+	}
+	k.setMintUsage(ctx, contractAddr, usage)
+	return nil
+}
+
+// GetMintUsage returns the tokens minted by the contract for the denom
+func (k Keeper) GetMintUsage(ctx sdk.Context, contractAddr sdk.AccAddress, denom string) types.MintUsage {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(mintUsageKey(contractAddr, denom))
+	if bz == nil {
+		return types.MintUsage{
+			ContractAddress: contractAddr.String(),
+			Denom:           denom,
+			TotalMinted:     sdk.ZeroInt(),
+			PeriodMinted:    sdk.ZeroInt(),
+		}
+	}
+	var usage types.MintUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// IterateMintUsages iterates through the minted tokens by denom ASC for the contract. All contracts are included when
+// the contract address is empty.
+func (k Keeper) IterateMintUsages(ctx sdk.Context, contractAddr sdk.AccAddress, cb func(usage types.MintUsage) bool) {
+	storePrefix := mintUsagePrefix
+	if len(contractAddr) != 0 {
+		storePrefix = append(append([]byte{}, mintUsagePrefix...), address.MustLengthPrefix(contractAddr)...)
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var usage types.MintUsage
+		k.cdc.MustUnmarshal(iter.Value(), &usage)
+		// cb returns true to stop early
+		if cb(usage) {
+			return
+		}
+	}
+}
+
+func (k Keeper) setMintUsage(ctx sdk.Context, contractAddr sdk.AccAddress, usage types.MintUsage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(mintUsageKey(contractAddr, usage.Denom), k.cdc.MustMarshal(&usage))
+}
+
+// importMintState stores the allowances and usages from genesis
+func (k Keeper) importMintState(ctx sdk.Context, allowances []types.MintAllowance, usages []types.MintUsage) error {
+	for _, a := range allowances {
+		contractAddr, err := sdk.AccAddressFromBech32(a.ContractAddress)
+		if err != nil {
+			return sdkerrors.Wrap(err, "contract address")
+		}
+		if err := k.SetMintAllowance(ctx, contractAddr, a.Limits); err != nil {
+			return sdkerrors.Wrapf(err, "mint allowance for %s", a.ContractAddress)
+		}
+	}
+	for _, u := range usages {
+		contractAddr, err := sdk.AccAddressFromBech32(u.ContractAddress)
+		if err != nil {
+			return sdkerrors.Wrap(err, "contract address")
+		}
+		k.setMintUsage(ctx, contractAddr, u)
+	}
+	return nil
+}
+
+// mintAllowanceKey returns the key for the mint allowance
+// `<prefix><contractAddr>`
+func mintAllowanceKey(contractAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, mintAllowancePrefix...), contractAddr...)
+}
+
+// mintUsageKey returns the key for the minted tokens
+// `<prefix><len><contractAddr><denom>`
+func mintUsageKey(contractAddr sdk.AccAddress, denom string) []byte {
+	r := append(append([]byte{}, mintUsagePrefix...), address.MustLengthPrefix(contractAddr)...)
+	return append(r, []byte(denom)...)
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+func TestSetMintAllowance(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	_, myContractAddr := seedTestContract(t, ctx, k)
+
+	specs := map[string]struct {
+		contractAddr sdk.AccAddress
+		limits       []types.MintLimit
+		expErr       *sdkerrors.Error
+	}{
+		"all good": {
+			contractAddr: myContractAddr,
+			limits:       []types.MintLimit{{Denom: "ufury", TotalCap: sdk.NewInt(100), RateLimit: sdk.NewInt(10), RatePeriod: 5}},
+		},
+		"empty limits": {
+			contractAddr: myContractAddr,
+		},
+		"unknown contract": {
+			contractAddr: RandomAddress(t),
+			limits:       []types.MintLimit{{Denom: "ufury"}},
+			expErr:       wasmtypes.ErrNotFound,
+		},
+		"invalid limits": {
+			contractAddr: myContractAddr,
+			limits:       []types.MintLimit{{Denom: "ufury"}, {Denom: "ufury"}},
+			expErr:       wasmtypes.ErrDuplicate,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			// when
+			gotErr := k.SetMintAllowance(ctx, spec.contractAddr, spec.limits)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				assert.Nil(t, k.GetMintAllowance(ctx, spec.contractAddr))
+				return
+			}
+			require.NoError(t, gotErr)
+			exp := &types.MintAllowance{ContractAddress: spec.contractAddr.String(), Limits: spec.limits}
+			assert.Equal(t, exp, k.GetMintAllowance(ctx, spec.contractAddr))
+			expEvt := sdk.NewEvent(types.EventTypeSetMintAllowance, sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, spec.contractAddr.String()))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+		})
+	}
+}
+
+func TestConsumeMintAllowance(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	_, myContractAddr := seedTestContract(t, ctx, k)
+	ctx = ctx.WithBlockHeight(100)
+
+	specs := map[string]struct {
+		limits         []types.MintLimit
+		setup          func(ctx sdk.Context)
+		height         int64
+		amount         sdk.Coin
+		expErr         *sdkerrors.Error
+		expTotalMinted sdk.Int
+		expPeriodStart int64
+		expPeriodMint  sdk.Int
+	}{
+		"no allowance set": {
+			amount:         sdk.NewInt64Coin("ufury", 1_000_000),
+			expTotalMinted: sdk.ZeroInt(),
+			expPeriodMint:  sdk.ZeroInt(),
+		},
+		"unlimited denom": {
+			limits:         []types.MintLimit{{Denom: "ufury"}},
+			amount:         sdk.NewInt64Coin("ufury", 1_000_000),
+			expTotalMinted: sdk.NewInt(1_000_000),
+			expPeriodStart: 100,
+			expPeriodMint:  sdk.NewInt(1_000_000),
+		},
+		"denom not allowed": {
+			limits:         []types.MintLimit{{Denom: "ufury"}},
+			amount:         sdk.NewInt64Coin("other", 1),
+			expErr:         sdkerrors.ErrUnauthorized,
+			expTotalMinted: sdk.ZeroInt(),
+			expPeriodMint:  sdk.ZeroInt(),
+		},
+		"empty limits": {
+			limits:         []types.MintLimit{},
+			amount:         sdk.NewInt64Coin("ufury", 1),
+			expErr:         sdkerrors.ErrUnauthorized,
+			expTotalMinted: sdk.ZeroInt(),
+			expPeriodMint:  sdk.ZeroInt(),
+		},
+		"total cap reached": {
+			limits:         []types.MintLimit{{Denom: "ufury", TotalCap: sdk.NewInt(10)}},
+			amount:         sdk.NewInt64Coin("ufury", 10),
+			expTotalMinted: sdk.NewInt(10),
+			expPeriodStart: 100,
+			expPeriodMint:  sdk.NewInt(10),
+		},
+		"total cap exceeded": {
+			limits:         []types.MintLimit{{Denom: "ufury", TotalCap: sdk.NewInt(10)}},
+			amount:         sdk.NewInt64Coin("ufury", 11),
+			expErr:         wasmtypes.ErrLimit,
+			expTotalMinted: sdk.ZeroInt(),
+			expPeriodMint:  sdk.ZeroInt(),
+		},
+		"total cap exceeded with previous mints": {
+			limits: []types.MintLimit{{Denom: "ufury", TotalCap: sdk.NewInt(10)}},
+			setup: func(ctx sdk.Context) {
+				require.NoError(t, k.ConsumeMintAllowance(ctx.WithBlockHeight(1), myContractAddr, sdk.NewInt64Coin("ufury", 9)))
+			},
+			amount:         sdk.NewInt64Coin("ufury", 2),
+			expErr:         wasmtypes.ErrLimit,
+			expTotalMinted: sdk.NewInt(9),
+			expPeriodStart: 1,
+			expPeriodMint:  sdk.NewInt(9),
+		},
+		"rate limit within period": {
+			limits: []types.MintLimit{{Denom: "ufury", RateLimit: sdk.NewInt(10), RatePeriod: 5}},
+			setup: func(ctx sdk.Context) {
+				require.NoError(t, k.ConsumeMintAllowance(ctx.WithBlockHeight(96), myContractAddr, sdk.NewInt64Coin("ufury", 4)))
+			},
+			amount:         sdk.NewInt64Coin("ufury", 6),
+			expTotalMinted: sdk.NewInt(10),
+			expPeriodStart: 96,
+			expPeriodMint:  sdk.NewInt(10),
+		},
+		"rate limit exceeded within period": {
+			limits: []types.MintLimit{{Denom: "ufury", RateLimit: sdk.NewInt(10), RatePeriod: 5}},
+			setup: func(ctx sdk.Context) {
+				require.NoError(t, k.ConsumeMintAllowance(ctx.WithBlockHeight(96), myContractAddr, sdk.NewInt64Coin("ufury", 4)))
+			},
+			amount:         sdk.NewInt64Coin("ufury", 7),
+			expErr:         wasmtypes.ErrLimit,
+			expTotalMinted: sdk.NewInt(4),
+			expPeriodStart: 96,
+			expPeriodMint:  sdk.NewInt(4),
+		},
+		"rate limit with new period": {
+			limits: []types.MintLimit{{Denom: "ufury", RateLimit: sdk.NewInt(10), RatePeriod: 5}},
+			setup: func(ctx sdk.Context) {
+				require.NoError(t, k.ConsumeMintAllowance(ctx.WithBlockHeight(95), myContractAddr, sdk.NewInt64Coin("ufury", 10)))
+			},
+			amount:         sdk.NewInt64Coin("ufury", 10),
+			expTotalMinted: sdk.NewInt(20),
+			expPeriodStart: 100,
+			expPeriodMint:  sdk.NewInt(10),
+		},
+		"rate limit exceeded in single mint": {
+			limits:         []types.MintLimit{{Denom: "ufury", RateLimit: sdk.NewInt(10), RatePeriod: 5}},
+			amount:         sdk.NewInt64Coin("ufury", 11),
+			expErr:         wasmtypes.ErrLimit,
+			expTotalMinted: sdk.ZeroInt(),
+			expPeriodMint:  sdk.ZeroInt(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			if spec.limits != nil {
+				require.NoError(t, k.SetMintAllowance(ctx, myContractAddr, spec.limits))
+			}
+			if spec.setup != nil {
+				spec.setup(ctx)
+			}
+			// when
+			gotErr := k.ConsumeMintAllowance(ctx, myContractAddr, spec.amount)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+			} else {
+				require.NoError(t, gotErr)
+			}
+			gotUsage := k.GetMintUsage(ctx, myContractAddr, spec.amount.Denom)
+			assert.Equal(t, spec.expTotalMinted.String(), gotUsage.TotalMinted.String())
+			assert.Equal(t, spec.expPeriodStart, gotUsage.PeriodStartHeight)
+			assert.Equal(t, spec.expPeriodMint.String(), gotUsage.PeriodMinted.String())
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+// DelegatorGrantKeeper is a subset of the keeper
+type DelegatorGrantKeeper interface {
+	GrantDelegator(ctx sdk.Context, grant types.DelegatorGrant) error
+	RevokeDelegator(ctx sdk.Context, staker, contractAddr sdk.AccAddress) error
+}
+
+type msgServer struct {
+	keeper DelegatorGrantKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the twasm MsgServer interface
+func NewMsgServerImpl(k DelegatorGrantKeeper) types.MsgServer {
+	return &msgServer{keeper: k}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (m msgServer) GrantDelegator(c context.Context, msg *types.MsgGrantDelegator) (*types.MsgGrantDelegatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	grant := types.DelegatorGrant{
+		Staker:          msg.Staker,
+		ContractAddress: msg.ContractAddress,
+		Expiration:      msg.Expiration,
+	}
+	if err := m.keeper.GrantDelegator(ctx, grant); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Staker),
+	))
+	return &types.MsgGrantDelegatorResponse{}, nil
+}
+
+func (m msgServer) RevokeDelegator(c context.Context, msg *types.MsgRevokeDelegator) (*types.MsgRevokeDelegatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	staker, err := sdk.AccAddressFromBech32(msg.Staker)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "staker")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract address")
+	}
+	if err := m.keeper.RevokeDelegator(ctx, staker, contractAddr); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Staker),
+	))
+	return &types.MsgRevokeDelegatorResponse{}, nil
+}
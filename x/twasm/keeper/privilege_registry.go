@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oldfurya/furya/x/twasm/contract"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+// PrivilegedMsgHandler handles the custom message of a contract that has the privilege registered. The message is
+// the json object below the privilege name.
+type PrivilegedMsgHandler func(ctx sdk.Context, contractAddr sdk.AccAddress, msg json.RawMessage) ([]sdk.Event, [][]byte, error)
+
+// PrivilegedSudoMsgBuilder returns the sudo message that is sent to all contracts registered for the privilege type
+// in the begin or end blocker. No callbacks are made when nil is returned.
+type PrivilegedSudoMsgBuilder func(ctx sdk.Context) ([]byte, error)
+
+// PrivilegeExtension defines a privilege type that is provided by the app or other modules. All handlers are
+// optional.
+type PrivilegeExtension struct {
+	// ID is the unique number of the privilege type. It must not be lower than types.CustomPrivilegeTypeOffset
+	ID uint8
+	// Name of the privilege type. It is used by contracts to request or release the privilege and as the
+	// key for the custom message and query.
+	Name string
+	// Singleton restricts the privilege type to a single contract
+	Singleton bool
+	// MsgHandler handles custom messages `{"<name>": {...}}` of contracts that have the privilege registered
+	MsgHandler PrivilegedMsgHandler
+	// QueryHandler handles custom queries `{"<name>": {...}}`. Queries are not restricted to privileged contracts.
+	QueryHandler wasmkeeper.CustomQuerier
+	// BeginBlockSudoMsg builds the sudo message for the contracts registered for the privilege type in the begin blocker
+	BeginBlockSudoMsg PrivilegedSudoMsgBuilder
+	// EndBlockSudoMsg builds the sudo message for the contracts registered for the privilege type in the end blocker
+	EndBlockSudoMsg PrivilegedSudoMsgBuilder
+}
+
+// PrivilegeRegistry contains the privilege extensions of the app
+type PrivilegeRegistry struct {
+	extensions map[string]PrivilegeExtension
+	// keep registration order for deterministic callbacks
+	names []string
+	// keys of the built-in custom messages and queries
+	reserved map[string]struct{}
+}
+
+// NewPrivilegeRegistry constructor. The keys of the built-in custom queries are taken from the json field names of the
+// given query types. They are reserved together with the keys of contract.PetriMsg.
+func NewPrivilegeRegistry(builtinQueries ...interface{}) *PrivilegeRegistry {
+	reserved := make(map[string]struct{})
+	for _, v := range append([]interface{}{contract.PetriMsg{}}, builtinQueries...) {
+		for _, name := range jsonFieldNames(v) {
+			reserved[name] = struct{}{}
+		}
+	}
+	return &PrivilegeRegistry{extensions: make(map[string]PrivilegeExtension), reserved: reserved}
+}
+
+// Register adds the privilege extension and registers the privilege type. Must be called on app construction.
+// Panics on duplicates, built-in message or query keys or conflicts with other privilege types.
+func (r *PrivilegeRegistry) Register(ext PrivilegeExtension) types.PrivilegeType {
+	if ext.Name == "" {
+		panic("privilege name must not be empty")
+	}
+	if _, exists := r.reserved[ext.Name]; exists {
+		panic(fmt.Sprintf("privilege name is a built-in message or query key: %q", ext.Name))
+	}
+	if _, exists := r.extensions[ext.Name]; exists {
+		panic(fmt.Sprintf("privilege extension exists already: %q", ext.Name))
+	}
+	privilegeType := types.RegisterCustomPrivilegeType(ext.ID, ext.Name, ext.Singleton)
+	r.extensions[ext.Name] = ext
+	r.names = append(r.names, ext.Name)
+	return privilegeType
+}
+
+// msgHandler returns the message handler and privilege type for the name. Returns false when none exists.
+func (r *PrivilegeRegistry) msgHandler(name string) (types.PrivilegeType, PrivilegedMsgHandler, bool) {
+	if r == nil {
+		return types.PrivilegeTypeEmpty, nil, false
+	}
+	ext, ok := r.extensions[name]
+	if !ok || ext.MsgHandler == nil {
+		return types.PrivilegeTypeEmpty, nil, false
+	}
+	return types.PrivilegeType(ext.ID), ext.MsgHandler, true
+}
+
+// IterateBeginBlockSudoMsgBuilders iterates through all extensions with a begin block sudo message in registration order
+func (r *PrivilegeRegistry) IterateBeginBlockSudoMsgBuilders(cb func(privilegeType types.PrivilegeType, builder PrivilegedSudoMsgBuilder)) {
+	r.iterateSudoMsgBuilders(func(ext PrivilegeExtension) PrivilegedSudoMsgBuilder { return ext.BeginBlockSudoMsg }, cb)
+}
+
+// IterateEndBlockSudoMsgBuilders iterates through all extensions with an end block sudo message in registration order
+func (r *PrivilegeRegistry) IterateEndBlockSudoMsgBuilders(cb func(privilegeType types.PrivilegeType, builder PrivilegedSudoMsgBuilder)) {
+	r.iterateSudoMsgBuilders(func(ext PrivilegeExtension) PrivilegedSudoMsgBuilder { return ext.EndBlockSudoMsg }, cb)
+}
+
+func (r *PrivilegeRegistry) iterateSudoMsgBuilders(
+	selector func(ext PrivilegeExtension) PrivilegedSudoMsgBuilder,
+	cb func(privilegeType types.PrivilegeType, builder PrivilegedSudoMsgBuilder),
+) {
+	if r == nil {
+		return
+	}
+	for _, name := range r.names {
+		ext := r.extensions[name]
+		if builder := selector(ext); builder != nil {
+			cb(types.PrivilegeType(ext.ID), builder)
+		}
+	}
+}
+
+// CustomQuerier returns a wasm custom querier that routes queries of the privilege extensions to their handlers.
+// All other queries are passed to the given querier.
+func (r *PrivilegeRegistry) CustomQuerier(other wasmkeeper.CustomQuerier) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		if name, payload, ok := singleKeyObject(request); ok && r != nil {
+			if ext, exists := r.extensions[name]; exists && ext.QueryHandler != nil {
+				return ext.QueryHandler(ctx, payload)
+			}
+		}
+		return other(ctx, request)
+	}
+}
+
+// singleKeyObject returns the key and value of a json object with a single element
+func singleKeyObject(bz []byte) (string, json.RawMessage, bool) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &obj); err != nil || len(obj) != 1 {
+		return "", nil, false
+	}
+	for k, v := range obj {
+		return k, v, true
+	}
+	return "", nil, false
+}
+
+// jsonFieldNames returns the json names of the struct fields. Fields without json tag or with "-" are skipped.
+func jsonFieldNames(v interface{}) []string {
+	t := reflect.Indirect(reflect.ValueOf(v)).Type()
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+func TestPrivilegeRegistryRegister(t *testing.T) {
+	NewPrivilegeRegistry().Register(PrivilegeExtension{ID: 0xe0, Name: "testing_register"})
+
+	specs := map[string]struct {
+		src      PrivilegeExtension
+		expPanic bool
+	}{
+		"all good": {
+			src: PrivilegeExtension{ID: 0xe3, Name: "testing_register_new"},
+		},
+		"same type again in other registry": {
+			src: PrivilegeExtension{ID: 0xe0, Name: "testing_register"},
+		},
+		"empty name": {
+			src:      PrivilegeExtension{ID: 0xe1},
+			expPanic: true,
+		},
+		"reserved id": {
+			src:      PrivilegeExtension{ID: types.CustomPrivilegeTypeOffset - 1, Name: "testing_reserved"},
+			expPanic: true,
+		},
+		"built-in message key": {
+			src:      PrivilegeExtension{ID: 0xe2, Name: "mint_tokens"},
+			expPanic: true,
+		},
+		"built-in query key": {
+			src:      PrivilegeExtension{ID: 0xe2, Name: "testing_builtin_query"},
+			expPanic: true,
+		},
+		"name of twasm privilege": {
+			src:      PrivilegeExtension{ID: 0xe2, Name: types.PrivilegeTypeEndBlock.String()},
+			expPanic: true,
+		},
+		"id registered with other name": {
+			src:      PrivilegeExtension{ID: 0xe0, Name: "testing_other"},
+			expPanic: true,
+		},
+		"id registered with other singleton flag": {
+			src:      PrivilegeExtension{ID: 0xe0, Name: "testing_register", Singleton: true},
+			expPanic: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			r := NewPrivilegeRegistry(struct {
+				Query *struct{} `json:"testing_builtin_query,omitempty"`
+			}{})
+			if spec.expPanic {
+				assert.Panics(t, func() {
+					r.Register(spec.src)
+				})
+				return
+			}
+			got := r.Register(spec.src)
+			assert.Equal(t, types.PrivilegeType(spec.src.ID), got)
+			assert.Equal(t, spec.src.Name, got.String())
+			// duplicates in the same registry are rejected
+			assert.Panics(t, func() {
+				r.Register(spec.src)
+			})
+		})
+	}
+}
+
+func TestPrivilegeRegistryCustomQuerier(t *testing.T) {
+	r := NewPrivilegeRegistry()
+	r.Register(PrivilegeExtension{
+		ID:   0xe8,
+		Name: "testing_query",
+		QueryHandler: func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+			return append([]byte("extension:"), request...), nil
+		},
+	})
+	r.Register(PrivilegeExtension{ID: 0xe9, Name: "testing_query_without_handler"})
+	otherQuerier := func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		return append([]byte("other:"), request...), nil
+	}
+
+	specs := map[string]struct {
+		src string
+		exp string
+	}{
+		"extension query": {
+			src: `{"testing_query":{}}`,
+			exp: `extension:{}`,
+		},
+		"no query handler": {
+			src: `{"testing_query_without_handler":{}}`,
+			exp: `other:{"testing_query_without_handler":{}}`,
+		},
+		"other query": {
+			src: `{"other":{}}`,
+			exp: `other:{"other":{}}`,
+		},
+		"multiple keys": {
+			src: `{"testing_query":{},"other":{}}`,
+			exp: `other:{"testing_query":{},"other":{}}`,
+		},
+		"invalid json": {
+			src: `not json`,
+			exp: `other:not json`,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := r.CustomQuerier(otherQuerier)(sdk.Context{}, json.RawMessage(spec.src))
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, string(got))
+		})
+	}
+}
+
+func TestNilPrivilegeRegistry(t *testing.T) {
+	var r *PrivilegeRegistry
+	_, _, found := r.msgHandler("testing")
+	assert.False(t, found)
+	r.IterateBeginBlockSudoMsgBuilders(func(types.PrivilegeType, PrivilegedSudoMsgBuilder) {
+		t.Fatal("not expected to be called")
+	})
+	r.IterateEndBlockSudoMsgBuilders(func(types.PrivilegeType, PrivilegedSudoMsgBuilder) {
+		t.Fatal("not expected to be called")
+	})
+	myErr := errors.New("testing")
+	_, gotErr := r.CustomQuerier(func(sdk.Context, json.RawMessage) ([]byte, error) {
+		return nil, myErr
+	})(sdk.Context{}, json.RawMessage(`{"testing":{}}`))
+	assert.Equal(t, myErr, gotErr)
+}
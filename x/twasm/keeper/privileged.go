@@ -4,11 +4,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"strconv"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/oldfurya/furya/x/twasm/contract"
 	"github.com/oldfurya/furya/x/twasm/types"
@@ -74,26 +76,105 @@ func (k Keeper) UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) er
 		return sdkerrors.Wrap(wasmtypes.ErrNotFound, "contractAddr")
 	}
 
+	// remove from cache
+	if err := k.unpinCodeWhenUnused(ctx, contractInfo.CodeID, contractAddr); err != nil {
+		return err
+	}
+
+	// remove privileged flag
+	k.clearPrivilegedFlag(ctx, contractAddr)
+
+	// remove remaining privileges
+	if err := k.removeAllPrivilegeRegistrations(ctx, contractAddr, contractInfo); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("Unset privileged", "contractAddr", contractAddr.String())
+	event := sdk.NewEvent(
+		types.EventTypeUnsetPrivileged,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+	)
+	ctx.EventManager().EmitEvent(event)
+	return nil
+}
+
+// MigratePrivileged must be called after a privileged contract was migrated to new code. It does:
+// - pin new code to cache
+// - remove old code from cache when no other contract instance uses it
+// - remove all privileges for the contract
+// - call Sudo with PrivilegeChangeMsg{Migrated{}} so that the contract can register the privileges again
+//
+// A failing sudo call does not revert the migration. The contract stays privileged without registrations and the
+// failure is logged and reported in the event.
+func (k Keeper) MigratePrivileged(ctx sdk.Context, contractAddr sdk.AccAddress, oldCodeID uint64) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(wasmtypes.ErrNotFound, "contractAddr")
+	}
+	if !k.IsPrivileged(ctx, contractAddr) {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "not privileged")
+	}
+	if contractInfo.CodeID != oldCodeID {
+		// add new code to cache
+		if err := k.contractKeeper.PinCode(ctx, contractInfo.CodeID); err != nil {
+			return sdkerrors.Wrapf(err, "pin")
+		}
+		// remove old code from cache
+		if err := k.unpinCodeWhenUnused(ctx, oldCodeID, contractAddr); err != nil {
+			return err
+		}
+	}
+
+	// drop the registrations of the old code
+	if err := k.removeAllPrivilegeRegistrations(ctx, contractAddr, contractInfo); err != nil {
+		return err
+	}
+
+	// call contract and let it register for privileges again
+	msg := contract.PetriSudoMsg{PrivilegeChange: &contract.PrivilegeChangeMsg{Migrated: &contract.Migrated{OldCodeID: oldCodeID}}}
+	msgBz, err := json.Marshal(&msg)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	cacheCtx, commit := ctx.CacheContext()
+	if _, err = k.Sudo(cacheCtx, contractAddr, msgBz); err != nil {
+		k.Logger(ctx).Error("Migrate privileged sudo call failed", "cause", err, "contractAddr", contractAddr.String(), "oldCodeID", oldCodeID)
+	} else {
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	k.Logger(ctx).Info("Migrate privileged", "contractAddr", contractAddr.String(), "oldCodeID", oldCodeID, "codeID", contractInfo.CodeID)
+	event := sdk.NewEvent(
+		types.EventTypeMigratePrivileged,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyOldCodeID, strconv.FormatUint(oldCodeID, 10)),
+		sdk.NewAttribute(wasmtypes.AttributeKeyCodeID, strconv.FormatUint(contractInfo.CodeID, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	)
+	ctx.EventManager().EmitEvent(event)
+	return nil
+}
+
+// unpinCodeWhenUnused removes the code from cache when no other contract instance uses it
+func (k Keeper) unpinCodeWhenUnused(ctx sdk.Context, codeID uint64, contractAddr sdk.AccAddress) error {
 	isUniqueContractInstance := true
-	k.Keeper.IterateContractsByCode(ctx, contractInfo.CodeID, func(address sdk.AccAddress) bool {
+	k.Keeper.IterateContractsByCode(ctx, codeID, func(address sdk.AccAddress) bool {
 		if !contractAddr.Equals(address) {
 			isUniqueContractInstance = false
 			return true
 		}
 		return false
 	})
-
-	if isUniqueContractInstance {
-		// remove from cache
-		if err := k.contractKeeper.UnpinCode(ctx, contractInfo.CodeID); err != nil {
-			return sdkerrors.Wrap(err, "unpin")
-		}
+	if !isUniqueContractInstance {
+		return nil
 	}
+	return sdkerrors.Wrap(k.contractKeeper.UnpinCode(ctx, codeID), "unpin")
+}
 
-	// remove privileged flag
-	k.clearPrivilegedFlag(ctx, contractAddr)
-
-	// remove remaining privileges
+// removeAllPrivilegeRegistrations removes all privilege registrations of the contract and stores the updated
+// contract details
+func (k Keeper) removeAllPrivilegeRegistrations(ctx sdk.Context, contractAddr sdk.AccAddress, contractInfo *wasmtypes.ContractInfo) error {
 	var details types.PetriContractDetails
 	if err := contractInfo.ReadExtension(&details); err != nil {
 		return err
@@ -103,17 +184,7 @@ func (k Keeper) UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) er
 		details.RemoveRegisteredPrivilege(privilegeType, pos)
 		return false
 	})
-	if err := k.setContractDetails(ctx, contractAddr, &details); err != nil {
-		return sdkerrors.Wrap(err, "store contract info extension")
-	}
-
-	k.Logger(ctx).Info("Unset privileged", "contractAddr", contractAddr.String())
-	event := sdk.NewEvent(
-		types.EventTypeUnsetPrivileged,
-		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
-	)
-	ctx.EventManager().EmitEvent(event)
-	return nil
+	return sdkerrors.Wrap(k.setContractDetails(ctx, contractAddr, &details), "store contract info extension")
 }
 
 // importPrivileged import from genesis
@@ -176,25 +247,33 @@ func (k Keeper) IteratePrivileged(ctx sdk.Context, cb func(sdk.AccAddress) bool)
 	}
 }
 
+// PaginatePrivileged iterates through a page of privileged contacts by address ASC
+func (k Keeper) PaginatePrivileged(ctx sdk.Context, pageReq *query.PageRequest, cb func(sdk.AccAddress)) (*query.PageResponse, error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), privilegedContractsSecondaryIndexPrefix)
+	return query.Paginate(prefixStore, pageReq, func(key []byte, _ []byte) error {
+		cb(key)
+		return nil
+	})
+}
+
 // appendToPrivilegedContracts registers given contract for a privilege type.
 func (k Keeper) appendToPrivilegedContracts(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) (uint8, error) {
-	store := ctx.KVStore(k.storeKey)
-
-	// find last position value for privilege type
-	var pos uint8
-	iter := prefix.NewStore(store, getContractPrivilegesSecondaryIndexPrefix(privilegeType)).ReverseIterator(nil, nil)
-	defer iter.Close()
-	if iter.Valid() {
-		key := iter.Key()
-		pos = key[0]
-		if privilegeType.IsSingleton() {
-			return 0, wasmtypes.ErrDuplicate
+	pos, exists := k.lastPrivilegePosition(ctx, privilegeType)
+	if exists && privilegeType.IsSingleton() {
+		return 0, wasmtypes.ErrDuplicate
+	}
+	if pos == math.MaxUint8 {
+		// reuse the gaps left by removed registrations
+		n, err := k.compactPrivilegePositions(ctx, privilegeType)
+		if err != nil {
+			return 0, sdkerrors.Wrap(err, "compact positions")
+		}
+		if n == math.MaxUint8 {
+			return 0, sdkerrors.Wrapf(wasmtypes.ErrLimit, "max %d contracts for privilege type", math.MaxUint8)
 		}
+		pos = n
 	}
 	newPos := pos + 1
-	if newPos <= pos {
-		panic("Overflow in privilege positions")
-	}
 	k.storeContractPrivilegeRegistration(ctx, privilegeType, newPos, contractAddr)
 
 	k.Logger(ctx).Info("Add privilege", "contractAddr", contractAddr.String(), "type", privilegeType.String())
@@ -207,6 +286,86 @@ func (k Keeper) appendToPrivilegedContracts(ctx sdk.Context, privilegeType types
 	return newPos, nil
 }
 
+// lastPrivilegePosition returns the highest position value for the privilege type. Returns false when none exists.
+func (k Keeper) lastPrivilegePosition(ctx sdk.Context, privilegeType types.PrivilegeType) (uint8, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := prefix.NewStore(store, getContractPrivilegesSecondaryIndexPrefix(privilegeType)).ReverseIterator(nil, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false
+	}
+	return parseContractPosition(iter.Key()), true
+}
+
+// SetPrivilegeCallbackOrder sets new positions for all contracts registered for the privilege type. The contracts are called
+// in the given order. The list must contain all registered contracts and none else.
+func (k Keeper) SetPrivilegeCallbackOrder(ctx sdk.Context, privilegeType types.PrivilegeType, contracts []sdk.AccAddress) error {
+	if err := k.setPrivilegePositions(ctx, privilegeType, contracts); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("Set privilege callback order", "type", privilegeType.String())
+	event := sdk.NewEvent(
+		types.EventTypeSetCallbackOrder,
+		sdk.NewAttribute(types.AttributeKeyCallbackType, privilegeType.String()),
+	)
+	ctx.EventManager().EmitEvent(event)
+	return nil
+}
+
+// compactPrivilegePositions removes gaps in the positions for the privilege type while keeping the callback order.
+// Returns the number of registrations.
+func (k Keeper) compactPrivilegePositions(ctx sdk.Context, privilegeType types.PrivilegeType) (uint8, error) {
+	var contracts []sdk.AccAddress
+	k.IteratePrivilegedContractsByType(ctx, privilegeType, func(_ uint8, contractAddr sdk.AccAddress) bool {
+		contracts = append(contracts, contractAddr)
+		return false
+	})
+	return uint8(len(contracts)), k.setPrivilegePositions(ctx, privilegeType, contracts)
+}
+
+// setPrivilegePositions replaces the registrations for the privilege type with the given contracts at positions 1..n.
+// The contract details are updated with the new positions. All registered contracts must be in the list.
+func (k Keeper) setPrivilegePositions(ctx sdk.Context, privilegeType types.PrivilegeType, contracts []sdk.AccAddress) error {
+	if len(contracts) > math.MaxUint8 {
+		return sdkerrors.Wrapf(wasmtypes.ErrLimit, "max %d contracts", math.MaxUint8)
+	}
+	// collect first to not modify the store while iterating
+	oldPositions := make(map[string]uint8)
+	k.IteratePrivilegedContractsByType(ctx, privilegeType, func(pos uint8, contractAddr sdk.AccAddress) bool {
+		oldPositions[contractAddr.String()] = pos
+		return false
+	})
+	if len(oldPositions) != len(contracts) {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "expected %d contracts but got %d", len(oldPositions), len(contracts))
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, pos := range oldPositions {
+		store.Delete(contractPrivilegesSecondaryIndexKey(privilegeType, pos))
+	}
+	for i, contractAddr := range contracts {
+		oldPos, ok := oldPositions[contractAddr.String()]
+		if !ok {
+			return sdkerrors.Wrapf(wasmtypes.ErrNotFound, "no registration for contract %s", contractAddr)
+		}
+		delete(oldPositions, contractAddr.String()) // catch duplicates
+		newPos := uint8(i + 1)
+		k.storeContractPrivilegeRegistration(ctx, privilegeType, newPos, contractAddr)
+		if oldPos == newPos {
+			continue
+		}
+		details, err := k.getContractDetails(ctx, contractAddr)
+		if err != nil {
+			return err
+		}
+		details.RemoveRegisteredPrivilege(privilegeType, oldPos)
+		details.AddRegisteredPrivilege(privilegeType, newPos)
+		if err := k.setContractDetails(ctx, contractAddr, details); err != nil {
+			return sdkerrors.Wrap(err, "store contract info extension")
+		}
+	}
+	return nil
+}
+
 // storeContractPrivilegeRegistration persists the privilege registration the contract
 func (k Keeper) storeContractPrivilegeRegistration(ctx sdk.Context, privilegeType types.PrivilegeType, pos uint8, contractAddr sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
@@ -221,6 +380,10 @@ func (k Keeper) removePrivilegeRegistration(ctx sdk.Context, privilegeType types
 		return false
 	}
 	store.Delete(key)
+	k.ResetCallbackFailures(ctx, privilegeType, contractAddr)
+	if privilegeType == types.PrivilegeTypeScheduledCallback {
+		k.removeScheduledCallbacks(ctx, contractAddr)
+	}
 	k.Logger(ctx).Info("Remove privilege", "contractAddr", contractAddr.String(), "type", privilegeType.String())
 	event := sdk.NewEvent(
 		types.EventTypeReleasePrivilege,
@@ -232,7 +395,7 @@ func (k Keeper) removePrivilegeRegistration(ctx sdk.Context, privilegeType types
 }
 
 // getPrivilegedContract returns the key stored at the given type and position. Result can be nil when none exists
-func (k Keeper) getPrivilegedContract(ctx sdk.Context, privilegeType types.PrivilegeType, pos uint8) sdk.AccAddress { //nolint:unused
+func (k Keeper) getPrivilegedContract(ctx sdk.Context, privilegeType types.PrivilegeType, pos uint8) sdk.AccAddress {
 	store := ctx.KVStore(k.storeKey)
 	key := contractPrivilegesSecondaryIndexKey(privilegeType, pos)
 	return store.Get(key)
@@ -264,6 +427,15 @@ func (k Keeper) IteratePrivilegedContractsByType(ctx sdk.Context, privilegeType
 	}
 }
 
+// PaginatePrivilegedContractsByType iterates through a page of contracts for the given type by position ASC
+func (k Keeper) PaginatePrivilegedContractsByType(ctx sdk.Context, privilegeType types.PrivilegeType, pageReq *query.PageRequest, cb func(pos uint8, contractAddr sdk.AccAddress)) (*query.PageResponse, error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), getContractPrivilegesSecondaryIndexPrefix(privilegeType))
+	return query.Paginate(prefixStore, pageReq, func(key []byte, value []byte) error {
+		cb(parseContractPosition(key), value)
+		return nil
+	})
+}
+
 // HasPrivilegedContract returns if the contract has the given privilege type registered.
 // Returns error for unknown contract addresses.
 func (k Keeper) HasPrivilegedContract(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType types.PrivilegeType) (bool, error) {
@@ -274,6 +446,36 @@ func (k Keeper) HasPrivilegedContract(ctx sdk.Context, contractAddr sdk.AccAddre
 	return d.HasRegisteredPrivilege(privilegeType), nil
 }
 
+// CallbackGasLimit returns the max gas for a privileged callback of the given type to the contract.
+// A contract specific limit has precedence over the default from the params. Returns 0 when no limit is set.
+func (k Keeper) CallbackGasLimit(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) sdk.Gas {
+	if details, err := k.getContractDetails(ctx, contractAddr); err == nil {
+		if limit, ok := details.CallbackGasLimit(privilegeType); ok {
+			return limit
+		}
+	}
+	limit, _ := k.GetTWasmParams(ctx).CallbackGasLimit(privilegeType)
+	return limit
+}
+
+// ExecuteWithGasLimit runs the callback with a new gas meter limited to the given amount. An out of gas panic
+// is recovered and returned as ErrOutOfGas. A gas limit of 0 means that no limit is enforced.
+func ExecuteWithGasLimit(ctx sdk.Context, gasLimit sdk.Gas, cb func(ctx sdk.Context) error) (err error) {
+	if gasLimit == 0 {
+		return cb(ctx)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "gas limit %d exceeded in location: %s", gasLimit, oog.Descriptor)
+		}
+	}()
+	return cb(ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)))
+}
+
 func privilegedContractsSecondaryIndexKey(contractAddr sdk.AccAddress) []byte {
 	return append(privilegedContractsSecondaryIndexPrefix, contractAddr...)
 }
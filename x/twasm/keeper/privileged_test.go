@@ -3,6 +3,8 @@ package keeper
 import (
 	"bytes"
 	"errors"
+	"fmt"
+	"math"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -168,7 +170,7 @@ func TestUnsetPrivileged(t *testing.T) {
 			codeID, contractAddr := seedTestContract(t, ctx, k)
 			spec.setup(t, ctx, keepers, mock)
 
-			h := NewPetriHandler(nil, k, nil, nil, nil)
+			h := NewPetriHandler(nil, k, nil, nil, nil, nil)
 			// and privileged with a type
 			k.setPrivilegedFlag(ctx, contractAddr)
 			err := h.handlePrivilege(ctx, contractAddr, &contract.PrivilegeMsg{
@@ -213,6 +215,152 @@ func TestUnsetPrivileged(t *testing.T) {
 	}
 }
 
+func TestMigratePrivileged(t *testing.T) {
+	var (
+		capturedPinChecksums   []cosmwasm.Checksum
+		capturedUnpinChecksums []cosmwasm.Checksum
+		capturedSudoMsg        []byte
+	)
+	captureSudoFn := func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		capturedSudoMsg = sudoMsg
+		return &wasmvmtypes.Response{}, 0, nil
+	}
+	specs := map[string]struct {
+		setup        func(t *testing.T, ctx sdk.Context, k *Keeper, mock *wasmtesting.MockWasmer, oldCodeID uint64)
+		sameCode     bool
+		notPrivilege bool
+		expErr       bool
+		expPin       bool
+		expUnpin     bool
+		expSudoFail  bool
+	}{
+		"all good": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, mock *wasmtesting.MockWasmer, _ uint64) {
+				mock.SudoFn = captureSudoFn
+			},
+			expPin:   true,
+			expUnpin: true,
+		},
+		"old code used by other instance": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, mock *wasmtesting.MockWasmer, oldCodeID uint64) {
+				mock.SudoFn = captureSudoFn
+				creatorAddr := RandomAddress(t)
+				_, _, err := k.GetContractKeeper().Instantiate(ctx, oldCodeID, creatorAddr, creatorAddr, nil, "", nil)
+				require.NoError(t, err)
+			},
+			expPin: true,
+		},
+		"migrated to same code": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, mock *wasmtesting.MockWasmer, _ uint64) {
+				mock.SudoFn = captureSudoFn
+			},
+			sameCode: true,
+		},
+		"new code rejects migrated msg": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, mock *wasmtesting.MockWasmer, _ uint64) {
+				mock.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					capturedSudoMsg = sudoMsg
+					store.Set([]byte("my-key"), []byte("my-value"))
+					return nil, 0, errors.New("test, ignore")
+				}
+			},
+			expPin:      true,
+			expUnpin:    true,
+			expSudoFail: true,
+		},
+		"not privileged": {
+			notPrivilege: true,
+			expErr:       true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			capturedPinChecksums, capturedUnpinChecksums, capturedSudoMsg = nil, nil, nil
+			mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+				m.PinFn = func(checksum cosmwasm.Checksum) error {
+					capturedPinChecksums = append(capturedPinChecksums, checksum)
+					return nil
+				}
+				m.UnpinFn = func(checksum cosmwasm.Checksum) error {
+					capturedUnpinChecksums = append(capturedUnpinChecksums, checksum)
+					return nil
+				}
+				m.MigrateFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, migrateMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					return &wasmvmtypes.Response{}, 0, nil
+				}
+			})
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+			k := keepers.TWasmKeeper
+			creatorAddr := RandomAddress(t)
+			oldCodeID, _, err := k.contractKeeper.Create(ctx, creatorAddr, []byte{}, nil)
+			require.NoError(t, err)
+			contractAddr, _, err := k.contractKeeper.Instantiate(ctx, oldCodeID, creatorAddr, creatorAddr, nil, "", nil)
+			require.NoError(t, err)
+			newCodeID := oldCodeID
+			if !spec.sameCode {
+				newCodeID, _, err = k.contractKeeper.Create(ctx, creatorAddr, []byte("new code"), nil)
+				require.NoError(t, err)
+			}
+			// and privileged with a type
+			k.setPrivilegedFlag(ctx, contractAddr)
+			h := NewPetriHandler(nil, k, nil, nil, nil, nil)
+			require.NoError(t, h.handlePrivilege(ctx, contractAddr, &contract.PrivilegeMsg{Request: types.PrivilegeTypeBeginBlock}))
+			// and migrated
+			_, err = k.contractKeeper.Migrate(ctx, contractAddr, creatorAddr, newCodeID, []byte(`{}`))
+			require.NoError(t, err)
+			if spec.notPrivilege {
+				k.clearPrivilegedFlag(ctx, contractAddr)
+			}
+			if spec.setup != nil {
+				spec.setup(t, ctx, k, mock, oldCodeID)
+			}
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotErr := k.MigratePrivileged(ctx, contractAddr, oldCodeID)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			oldChecksum := cosmwasm.Checksum(k.GetCodeInfo(ctx, oldCodeID).CodeHash)
+			newChecksum := cosmwasm.Checksum(k.GetCodeInfo(ctx, newCodeID).CodeHash)
+			if spec.expPin {
+				assert.Equal(t, []cosmwasm.Checksum{newChecksum}, capturedPinChecksums)
+			} else {
+				assert.Empty(t, capturedPinChecksums)
+			}
+			if spec.expUnpin {
+				assert.Equal(t, []cosmwasm.Checksum{oldChecksum}, capturedUnpinChecksums)
+			} else {
+				assert.Empty(t, capturedUnpinChecksums)
+			}
+			// and privileges removed
+			assert.True(t, k.IsPrivileged(ctx, contractAddr))
+			assert.False(t, k.ExistsAnyPrivilegedContract(ctx, types.PrivilegeTypeBeginBlock))
+			details, err := k.getContractDetails(ctx, contractAddr)
+			require.NoError(t, err)
+			assert.Empty(t, details.RegisteredPrivileges)
+			// and sudo called
+			exp := fmt.Sprintf(`{"privilege_change":{"migrated":{"old_code_id":%d}}}`, oldCodeID)
+			assert.JSONEq(t, exp, string(capturedSudoMsg), "got %s", string(capturedSudoMsg))
+			// and state of a failed sudo call discarded
+			assert.Nil(t, k.QueryRaw(ctx, contractAddr, []byte("my-key")))
+			// and event emitted
+			expEvent := sdk.NewEvent(types.EventTypeMigratePrivileged,
+				sdk.NewAttribute("_contract_address", contractAddr.String()),
+				sdk.NewAttribute("old_code_id", fmt.Sprint(oldCodeID)),
+				sdk.NewAttribute("code_id", fmt.Sprint(newCodeID)),
+				sdk.NewAttribute("success", fmt.Sprint(!spec.expSudoFail)),
+			)
+			assert.Contains(t, em.Events(), expEvent)
+		})
+	}
+}
+
 func TestIteratePrivileged(t *testing.T) {
 	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
 	k := keepers.TWasmKeeper
@@ -336,6 +484,133 @@ func TestAppendToPrivilegedContracts(t *testing.T) {
 	}
 }
 
+func TestAppendToPrivilegedContractsCompaction(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	_, contractAddr := seedTestContract(t, ctx, k)
+	newAddr := RandomAddress(t)
+
+	// with last position taken
+	k.storeContractPrivilegeRegistration(ctx, types.PrivilegeTypeBeginBlock, math.MaxUint8, contractAddr)
+	details := types.PetriContractDetails{}
+	details.AddRegisteredPrivilege(types.PrivilegeTypeBeginBlock, math.MaxUint8)
+	require.NoError(t, k.setContractDetails(ctx, contractAddr, &details))
+
+	// when
+	gotPos, gotErr := k.appendToPrivilegedContracts(ctx, types.PrivilegeTypeBeginBlock, newAddr)
+	// then
+	require.NoError(t, gotErr)
+	assert.Equal(t, uint8(2), gotPos)
+	type tuple struct {
+		a sdk.AccAddress
+		p uint8
+	}
+	var captured []tuple
+	k.IteratePrivilegedContractsByType(ctx, types.PrivilegeTypeBeginBlock, func(prio uint8, contractAddr sdk.AccAddress) bool {
+		captured = append(captured, tuple{p: prio, a: contractAddr})
+		return false
+	})
+	assert.Equal(t, []tuple{{p: 1, a: contractAddr}, {p: 2, a: newAddr}}, captured)
+	gotDetails, err := k.getContractDetails(ctx, contractAddr)
+	require.NoError(t, err)
+	assert.Equal(t, []types.RegisteredPrivilege{{PrivilegeType: "begin_blocker", Position: 1}}, gotDetails.RegisteredPrivileges)
+
+	// and when all positions are used
+	for i := 1; i <= math.MaxUint8; i++ {
+		k.storeContractPrivilegeRegistration(ctx, types.PrivilegeTypeEndBlock, uint8(i), RandomAddress(t))
+	}
+	_, gotErr = k.appendToPrivilegedContracts(ctx, types.PrivilegeTypeEndBlock, contractAddr)
+	assert.True(t, wasmtypes.ErrLimit.Is(gotErr), "got %+v", gotErr)
+}
+
+func TestSetPrivilegeCallbackOrder(t *testing.T) {
+	type tuple struct {
+		a sdk.AccAddress
+		p uint8
+	}
+	specs := map[string]struct {
+		reorder func(addrs []sdk.AccAddress) []sdk.AccAddress
+		expErr  *sdkerrors.Error
+		expPos  func(addrs []sdk.AccAddress) []tuple
+	}{
+		"reversed": {
+			reorder: func(addrs []sdk.AccAddress) []sdk.AccAddress {
+				return []sdk.AccAddress{addrs[2], addrs[1], addrs[0]}
+			},
+			expPos: func(addrs []sdk.AccAddress) []tuple {
+				return []tuple{{a: addrs[2], p: 1}, {a: addrs[1], p: 2}, {a: addrs[0], p: 3}}
+			},
+		},
+		"same order compacted": {
+			reorder: func(addrs []sdk.AccAddress) []sdk.AccAddress {
+				return addrs
+			},
+			expPos: func(addrs []sdk.AccAddress) []tuple {
+				return []tuple{{a: addrs[0], p: 1}, {a: addrs[1], p: 2}, {a: addrs[2], p: 3}}
+			},
+		},
+		"contract missing": {
+			reorder: func(addrs []sdk.AccAddress) []sdk.AccAddress {
+				return addrs[0:2]
+			},
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"unregistered contract": {
+			reorder: func(addrs []sdk.AccAddress) []sdk.AccAddress {
+				return []sdk.AccAddress{addrs[0], addrs[1], RandomAddress(t)}
+			},
+			expErr: wasmtypes.ErrNotFound,
+		},
+		"duplicate contract": {
+			reorder: func(addrs []sdk.AccAddress) []sdk.AccAddress {
+				return []sdk.AccAddress{addrs[0], addrs[1], addrs[0]}
+			},
+			expErr: wasmtypes.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+			k := keepers.TWasmKeeper
+			// setup contracts with gaps in the positions
+			addrs := make([]sdk.AccAddress, 3)
+			for i := range addrs {
+				_, addrs[i] = seedTestContract(t, ctx, k)
+				pos := uint8(i*2 + 1)
+				k.storeContractPrivilegeRegistration(ctx, types.PrivilegeTypeBeginBlock, pos, addrs[i])
+				details := types.PetriContractDetails{}
+				details.AddRegisteredPrivilege(types.PrivilegeTypeBeginBlock, pos)
+				require.NoError(t, k.setContractDetails(ctx, addrs[i], &details))
+			}
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotErr := k.SetPrivilegeCallbackOrder(ctx, types.PrivilegeTypeBeginBlock, spec.reorder(addrs))
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %+v", spec.expErr, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var captured []tuple
+			k.IteratePrivilegedContractsByType(ctx, types.PrivilegeTypeBeginBlock, func(prio uint8, contractAddr sdk.AccAddress) bool {
+				captured = append(captured, tuple{p: prio, a: contractAddr})
+				return false
+			})
+			exp := spec.expPos(addrs)
+			assert.Equal(t, exp, captured)
+			for _, v := range exp {
+				details, err := k.getContractDetails(ctx, v.a)
+				require.NoError(t, err)
+				assert.Equal(t, []types.RegisteredPrivilege{{PrivilegeType: "begin_blocker", Position: uint32(v.p)}}, details.RegisteredPrivileges)
+			}
+			assert.Equal(t, types.EventTypeSetCallbackOrder, em.Events()[len(em.Events())-1].Type)
+		})
+	}
+}
+
 func TestRemovePrivilegedContractRegistration(t *testing.T) {
 	var (
 		myAddr      = sdk.AccAddress(bytes.Repeat([]byte{1}, address.Len))
@@ -415,6 +690,55 @@ func TestRemovePrivilegedContractRegistration(t *testing.T) {
 	}
 }
 
+func TestCallbackGasLimit(t *testing.T) {
+	specs := map[string]struct {
+		params          []types.CallbackGasLimit
+		contractDetails []types.CallbackGasLimit
+		exp             sdk.Gas
+	}{
+		"no limits set": {
+			exp: 0,
+		},
+		"from params": {
+			params: []types.CallbackGasLimit{{PrivilegeType: "begin_blocker", GasLimit: 100}},
+			exp:    100,
+		},
+		"other type in params": {
+			params: []types.CallbackGasLimit{{PrivilegeType: "end_blocker", GasLimit: 100}},
+			exp:    0,
+		},
+		"from contract details": {
+			contractDetails: []types.CallbackGasLimit{{PrivilegeType: "begin_blocker", GasLimit: 200}},
+			exp:             200,
+		},
+		"contract details overwrite params": {
+			params:          []types.CallbackGasLimit{{PrivilegeType: "begin_blocker", GasLimit: 100}},
+			contractDetails: []types.CallbackGasLimit{{PrivilegeType: "begin_blocker", GasLimit: 200}},
+			exp:             200,
+		},
+		"other type in contract details": {
+			params:          []types.CallbackGasLimit{{PrivilegeType: "begin_blocker", GasLimit: 100}},
+			contractDetails: []types.CallbackGasLimit{{PrivilegeType: "end_blocker", GasLimit: 200}},
+			exp:             100,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+			k := keepers.TWasmKeeper
+			_, contractAddr := seedTestContract(t, ctx, k)
+			k.SetTWasmParams(ctx, types.TWasmParams{CallbackGasLimits: spec.params})
+			require.NoError(t, k.setContractDetails(ctx, contractAddr, &types.PetriContractDetails{CallbackGasLimits: spec.contractDetails}))
+
+			// when
+			got := k.CallbackGasLimit(ctx, types.PrivilegeTypeBeginBlock, contractAddr)
+
+			// then
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
 func seedTestContract(t *testing.T, ctx sdk.Context, k *Keeper) (uint64, sdk.AccAddress) {
 	t.Helper()
 	creatorAddr := rand.Bytes(address.Len)
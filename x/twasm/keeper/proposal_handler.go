@@ -14,6 +14,9 @@ import (
 type govKeeper interface {
 	SetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error
 	UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error
+	SetPrivilegeCallbackOrder(ctx sdk.Context, privilegeType types.PrivilegeType, contracts []sdk.AccAddress) error
+	SetMintAllowance(ctx sdk.Context, contractAddr sdk.AccAddress, limits []types.MintLimit) error
+	privilegedMigrationKeeper
 }
 
 // NewProposalHandler creates a new governance Handler for wasm proposals
@@ -25,6 +28,9 @@ func NewProposalHandler(k Keeper) govtypes.Handler {
 // NewProposalHandlerX creates a new governance Handler for wasm proposals
 func NewProposalHandlerX(k govKeeper, wasmProposalHandler govtypes.Handler) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
+		if c, ok := content.(*wasmtypes.MigrateContractProposal); ok {
+			return handleMigrateContractProposal(ctx, k, wasmProposalHandler, c)
+		}
 		err := wasmProposalHandler(ctx, content)
 		switch {
 		case err == nil:
@@ -40,12 +46,24 @@ func NewProposalHandlerX(k govKeeper, wasmProposalHandler govtypes.Handler) govt
 			return handlePromoteContractProposal(ctx, k, *c)
 		case *types.DemotePrivilegedContractProposal:
 			return handleDemoteContractProposal(ctx, k, *c)
+		case *types.SetPrivilegeCallbackOrderProposal:
+			return handleSetPrivilegeCallbackOrderProposal(ctx, k, *c)
+		case *types.SetMintAllowanceProposal:
+			return handleSetMintAllowanceProposal(ctx, k, *c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized twasm srcProposal content type: %T", c)
 		}
 	}
 }
 
+// handleMigrateContractProposal executes the migration in the wasm proposal handler. Privileged contracts are
+// notified afterwards so that they can register their privileges again.
+func handleMigrateContractProposal(ctx sdk.Context, k govKeeper, wasmProposalHandler govtypes.Handler, p *wasmtypes.MigrateContractProposal) error {
+	return migrateWithPrivileges(ctx, k, p.Contract, func() error {
+		return wasmProposalHandler(ctx, p)
+	})
+}
+
 func handlePromoteContractProposal(ctx sdk.Context, k govKeeper, p types.PromoteToPrivilegedContractProposal) error {
 	if err := p.ValidateBasic(); err != nil {
 		return err
@@ -70,3 +88,29 @@ func handleDemoteContractProposal(ctx sdk.Context, k govKeeper, p types.DemotePr
 
 	return k.UnsetPrivileged(ctx, contractAddr)
 }
+
+func handleSetPrivilegeCallbackOrderProposal(ctx sdk.Context, k govKeeper, p types.SetPrivilegeCallbackOrderProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contracts := make([]sdk.AccAddress, len(p.Contracts))
+	for i, a := range p.Contracts {
+		contractAddr, err := sdk.AccAddressFromBech32(a)
+		if err != nil {
+			return sdkerrors.Wrap(err, "contract address")
+		}
+		contracts[i] = contractAddr
+	}
+	return k.SetPrivilegeCallbackOrder(ctx, *types.PrivilegeTypeFrom(p.PrivilegeType), contracts)
+}
+
+func handleSetMintAllowanceProposal(ctx sdk.Context, k govKeeper, p types.SetMintAllowanceProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	return k.SetMintAllowance(ctx, contractAddr, p.Limits)
+}
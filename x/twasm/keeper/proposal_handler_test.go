@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -17,6 +18,7 @@ import (
 func TestGovHandler(t *testing.T) {
 	var (
 		myAddr                sdk.AccAddress = rand.Bytes(address.Len)
+		otherAddr             sdk.AccAddress = rand.Bytes(address.Len)
 		capturedContractAddrs []sdk.AccAddress
 	)
 	notHandler := func(ctx sdk.Context, content govtypes.Content) error {
@@ -83,6 +85,97 @@ func TestGovHandler(t *testing.T) {
 			srcProposal: &types.DemotePrivilegedContractProposal{},
 			expErr:      govtypes.ErrInvalidProposalContent,
 		},
+		"set privilege callback order proposal": {
+			wasmHandler: notHandler,
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.SetPrivilegeCallbackOrderFn = func(ctx sdk.Context, privilegeType types.PrivilegeType, contracts []sdk.AccAddress) error {
+					require.Equal(t, types.PrivilegeTypeEndBlock, privilegeType)
+					capturedContractAddrs = append(capturedContractAddrs, contracts...)
+					return nil
+				}
+			},
+			srcProposal: types.SetPrivilegeCallbackOrderProposalFixture(func(proposal *types.SetPrivilegeCallbackOrderProposal) {
+				proposal.PrivilegeType = types.PrivilegeTypeEndBlock.String()
+				proposal.Contracts = []string{otherAddr.String(), myAddr.String()}
+			}),
+			expCapturedAddrs: []sdk.AccAddress{otherAddr, myAddr},
+		},
+		"invalid set privilege callback order proposal rejected": {
+			wasmHandler: notHandler,
+			srcProposal: &types.SetPrivilegeCallbackOrderProposal{},
+			expErr:      govtypes.ErrInvalidProposalContent,
+		},
+		"set mint allowance proposal": {
+			wasmHandler: notHandler,
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.SetMintAllowanceFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, limits []types.MintLimit) error {
+					require.Len(t, limits, 1)
+					assert.Equal(t, "ufury", limits[0].Denom)
+					capturedContractAddrs = append(capturedContractAddrs, contractAddr)
+					return nil
+				}
+			},
+			srcProposal: types.SetMintAllowanceProposalFixture(func(proposal *types.SetMintAllowanceProposal) {
+				proposal.Contract = myAddr.String()
+			}),
+			expCapturedAddrs: []sdk.AccAddress{myAddr},
+		},
+		"invalid set mint allowance proposal rejected": {
+			wasmHandler: notHandler,
+			srcProposal: &types.SetMintAllowanceProposal{},
+			expErr:      govtypes.ErrInvalidProposalContent,
+		},
+		"migrate privileged contract": {
+			wasmHandler: func(ctx sdk.Context, content govtypes.Content) error {
+				return nil
+			},
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.GetContractInfoFn = contractInfoWithCodeID(1)
+				m.IsPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) bool { return true }
+				m.MigratePrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, oldCodeID uint64) error {
+					assert.Equal(t, uint64(1), oldCodeID)
+					capturedContractAddrs = append(capturedContractAddrs, contractAddr)
+					return nil
+				}
+			},
+			srcProposal:      migrateProposalFixture(myAddr),
+			expCapturedAddrs: []sdk.AccAddress{myAddr},
+		},
+		"migrate non privileged contract": {
+			wasmHandler: func(ctx sdk.Context, content govtypes.Content) error {
+				return nil
+			},
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.GetContractInfoFn = contractInfoWithCodeID(1)
+				m.IsPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) bool { return false }
+			},
+			srcProposal: migrateProposalFixture(myAddr),
+		},
+		"migrate privileged contract fails in wasm": {
+			wasmHandler: func(ctx sdk.Context, content govtypes.Content) error {
+				return wasmtypes.ErrMigrationFailed
+			},
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.GetContractInfoFn = contractInfoWithCodeID(1)
+				m.IsPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) bool { return true }
+			},
+			srcProposal: migrateProposalFixture(myAddr),
+			expErr:      wasmtypes.ErrMigrationFailed,
+		},
+		"migrate privileged contract fails in twasm": {
+			wasmHandler: func(ctx sdk.Context, content govtypes.Content) error {
+				return nil
+			},
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.GetContractInfoFn = contractInfoWithCodeID(1)
+				m.IsPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) bool { return true }
+				m.MigratePrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, oldCodeID uint64) error {
+					return wasmtypes.ErrExecuteFailed
+				}
+			},
+			srcProposal: migrateProposalFixture(myAddr),
+			expErr:      wasmtypes.ErrExecuteFailed,
+		},
 		"nil content": {
 			wasmHandler: notHandler,
 			expErr:      sdkerrors.ErrUnknownRequest,
@@ -108,9 +201,29 @@ func TestGovHandler(t *testing.T) {
 	}
 }
 
+func migrateProposalFixture(contractAddr sdk.AccAddress) *wasmtypes.MigrateContractProposal {
+	return wasmtypes.MigrateContractProposalFixture(func(p *wasmtypes.MigrateContractProposal) {
+		p.Contract = contractAddr.String()
+	})
+}
+
+func contractInfoWithCodeID(codeID uint64) func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	return func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+		c := wasmtypes.ContractInfoFixture(func(info *wasmtypes.ContractInfo) {
+			info.CodeID = codeID
+		})
+		return &c
+	}
+}
+
 type MockGovKeeper struct {
-	SetPrivilegedFn   func(ctx sdk.Context, contractAddr sdk.AccAddress) error
-	UnsetPrivilegedFn func(ctx sdk.Context, contractAddr sdk.AccAddress) error
+	SetPrivilegedFn             func(ctx sdk.Context, contractAddr sdk.AccAddress) error
+	UnsetPrivilegedFn           func(ctx sdk.Context, contractAddr sdk.AccAddress) error
+	SetPrivilegeCallbackOrderFn func(ctx sdk.Context, privilegeType types.PrivilegeType, contracts []sdk.AccAddress) error
+	SetMintAllowanceFn          func(ctx sdk.Context, contractAddr sdk.AccAddress, limits []types.MintLimit) error
+	GetContractInfoFn           func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	IsPrivilegedFn              func(ctx sdk.Context, contractAddr sdk.AccAddress) bool
+	MigratePrivilegedFn         func(ctx sdk.Context, contractAddr sdk.AccAddress, oldCodeID uint64) error
 }
 
 func (m MockGovKeeper) SetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error {
@@ -127,6 +240,41 @@ func (m MockGovKeeper) UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddr
 	return m.UnsetPrivilegedFn(ctx, contractAddr)
 }
 
+func (m MockGovKeeper) SetPrivilegeCallbackOrder(ctx sdk.Context, privilegeType types.PrivilegeType, contracts []sdk.AccAddress) error {
+	if m.SetPrivilegeCallbackOrderFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetPrivilegeCallbackOrderFn(ctx, privilegeType, contracts)
+}
+
+func (m MockGovKeeper) SetMintAllowance(ctx sdk.Context, contractAddr sdk.AccAddress, limits []types.MintLimit) error {
+	if m.SetMintAllowanceFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetMintAllowanceFn(ctx, contractAddr, limits)
+}
+
+func (m MockGovKeeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	if m.GetContractInfoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetContractInfoFn(ctx, contractAddress)
+}
+
+func (m MockGovKeeper) IsPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
+	if m.IsPrivilegedFn == nil {
+		panic("not expected to be called")
+	}
+	return m.IsPrivilegedFn(ctx, contractAddr)
+}
+
+func (m MockGovKeeper) MigratePrivileged(ctx sdk.Context, contractAddr sdk.AccAddress, oldCodeID uint64) error {
+	if m.MigratePrivilegedFn == nil {
+		panic("not expected to be called")
+	}
+	return m.MigratePrivilegedFn(ctx, contractAddr, oldCodeID)
+}
+
 type CapturingGovRouter struct {
 	govtypes.Router
 	captured []govtypes.Content
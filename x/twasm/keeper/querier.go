@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -14,8 +16,19 @@ var _ types.QueryServer = &Querier{}
 
 // queryKeeper is a subset of the keeper's methods
 type queryKeeper interface {
-	IteratePrivileged(ctx sdk.Context, cb func(sdk.AccAddress) bool)
+	PaginatePrivileged(ctx sdk.Context, pageReq *query.PageRequest, cb func(sdk.AccAddress)) (*query.PageResponse, error)
+	PaginatePrivilegedContractsByType(ctx sdk.Context, privilegeType types.PrivilegeType, pageReq *query.PageRequest, cb func(pos uint8, contractAddr sdk.AccAddress)) (*query.PageResponse, error)
 	IteratePrivilegedContractsByType(ctx sdk.Context, privilegeType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	IterateCallbackFailures(ctx sdk.Context, cb func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint32) bool)
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	IsPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) bool
+	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
+	GetMintAllowance(ctx sdk.Context, contractAddr sdk.AccAddress) *types.MintAllowance
+	IterateMintUsages(ctx sdk.Context, contractAddr sdk.AccAddress, cb func(usage types.MintUsage) bool)
+	PaginateScheduledCallbacks(ctx sdk.Context, contractAddr sdk.AccAddress, pageReq *query.PageRequest, cb func(callback types.ScheduledCallback)) (*query.PageResponse, error)
+	PaginateTimelockedGovProposals(ctx sdk.Context, pageReq *query.PageRequest, cb func(proposal types.TimelockedGovProposal)) (*query.PageResponse, error)
+	GetTimelockedGovProposal(ctx sdk.Context, id uint64) *types.TimelockedGovProposal
+	PaginateDelegatorGrants(ctx sdk.Context, staker sdk.AccAddress, pageReq *query.PageRequest, cb func(grant types.DelegatorGrant)) (*query.PageResponse, error)
 }
 type Querier struct {
 	keeper queryKeeper
@@ -25,12 +38,15 @@ func NewQuerier(keeper queryKeeper) *Querier {
 	return &Querier{keeper: keeper}
 }
 
-func (q Querier) PrivilegedContracts(c context.Context, _ *types.QueryPrivilegedContractsRequest) (*types.QueryPrivilegedContractsResponse, error) {
+func (q Querier) PrivilegedContracts(c context.Context, req *types.QueryPrivilegedContractsRequest) (*types.QueryPrivilegedContractsResponse, error) {
 	var result types.QueryPrivilegedContractsResponse
-	q.keeper.IteratePrivileged(sdk.UnwrapSDKContext(c), func(address sdk.AccAddress) bool {
+	pageRes, err := q.keeper.PaginatePrivileged(sdk.UnwrapSDKContext(c), req.GetPagination(), func(address sdk.AccAddress) {
 		result.Contracts = append(result.Contracts, address.String())
-		return false
 	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result.Pagination = pageRes
 	return &result, nil
 }
 
@@ -40,9 +56,170 @@ func (q Querier) ContractsByPrivilegeType(c context.Context, req *types.QueryCon
 	if cType == nil {
 		return nil, status.Error(codes.NotFound, "privilege type")
 	}
-	q.keeper.IteratePrivilegedContractsByType(sdk.UnwrapSDKContext(c), *cType, func(_ uint8, contractAddr sdk.AccAddress) bool {
+	pageRes, err := q.keeper.PaginatePrivilegedContractsByType(sdk.UnwrapSDKContext(c), *cType, req.Pagination, func(pos uint8, contractAddr sdk.AccAddress) {
 		result.Contracts = append(result.Contracts, contractAddr.String())
+		result.Positions = append(result.Positions, types.PrivilegedContractPosition{
+			Address:  contractAddr.String(),
+			Position: uint32(pos),
+		})
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result.Pagination = pageRes
+	return &result, nil
+}
+
+func (q Querier) CallbackFailures(c context.Context, _ *types.QueryCallbackFailuresRequest) (*types.QueryCallbackFailuresResponse, error) {
+	var result types.QueryCallbackFailuresResponse
+	q.keeper.IterateCallbackFailures(sdk.UnwrapSDKContext(c), func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint32) bool {
+		result.Failures = append(result.Failures, types.CallbackFailure{
+			ContractAddress: contractAddr.String(),
+			PrivilegeType:   privilegeType.String(),
+			Failures:        failures,
+		})
 		return false
 	})
 	return &result, nil
 }
+
+func (q Querier) ContractPrivileges(c context.Context, req *types.QueryContractPrivilegesRequest) (*types.QueryContractPrivilegesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "address")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	contractInfo := q.keeper.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return nil, status.Error(codes.NotFound, "contract")
+	}
+	var details types.PetriContractDetails
+	if err := contractInfo.ReadExtension(&details); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result := types.QueryContractPrivilegesResponse{
+		Privileged: q.keeper.IsPrivileged(ctx, contractAddr),
+		Pinned:     q.keeper.IsPinnedCode(ctx, contractInfo.CodeID),
+	}
+	details.IterateRegisteredPrivileges(func(privilegeType types.PrivilegeType, pos uint8) bool {
+		var order uint32
+		q.keeper.IteratePrivilegedContractsByType(ctx, privilegeType, func(p uint8, _ sdk.AccAddress) bool {
+			order++
+			return p == pos
+		})
+		result.Privileges = append(result.Privileges, types.ContractPrivilege{
+			PrivilegeType: privilegeType.String(),
+			Position:      uint32(pos),
+			CallbackOrder: order,
+		})
+		return false
+	})
+	return &result, nil
+}
+
+func (q Querier) MintAllowance(c context.Context, req *types.QueryMintAllowanceRequest) (*types.QueryMintAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "address")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if q.keeper.GetContractInfo(ctx, contractAddr) == nil {
+		return nil, status.Error(codes.NotFound, "contract")
+	}
+	result := types.QueryMintAllowanceResponse{
+		Allowance: q.keeper.GetMintAllowance(ctx, contractAddr),
+	}
+	q.keeper.IterateMintUsages(ctx, contractAddr, func(usage types.MintUsage) bool {
+		result.Usages = append(result.Usages, usage)
+		return false
+	})
+	return &result, nil
+}
+
+func (q Querier) ScheduledCallbacks(c context.Context, req *types.QueryScheduledCallbacksRequest) (*types.QueryScheduledCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	var contractAddr sdk.AccAddress
+	if req.Address != "" {
+		var err error
+		if contractAddr, err = sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "address")
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	result := types.QueryScheduledCallbacksResponse{
+		Callbacks: make([]types.ScheduledCallback, 0),
+	}
+	pageRes, err := q.keeper.PaginateScheduledCallbacks(ctx, contractAddr, req.Pagination, func(callback types.ScheduledCallback) {
+		result.Callbacks = append(result.Callbacks, callback)
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result.Pagination = pageRes
+	return &result, nil
+}
+
+func (q Querier) TimelockedGovProposals(c context.Context, req *types.QueryTimelockedGovProposalsRequest) (*types.QueryTimelockedGovProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	result := types.QueryTimelockedGovProposalsResponse{
+		Proposals: make([]types.TimelockedGovProposal, 0),
+	}
+	pageRes, err := q.keeper.PaginateTimelockedGovProposals(ctx, req.Pagination, func(proposal types.TimelockedGovProposal) {
+		result.Proposals = append(result.Proposals, proposal)
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result.Pagination = pageRes
+	return &result, nil
+}
+
+func (q Querier) TimelockedGovProposal(c context.Context, req *types.QueryTimelockedGovProposalRequest) (*types.QueryTimelockedGovProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id")
+	}
+	proposal := q.keeper.GetTimelockedGovProposal(sdk.UnwrapSDKContext(c), req.Id)
+	if proposal == nil {
+		return nil, status.Error(codes.NotFound, "proposal")
+	}
+	return &types.QueryTimelockedGovProposalResponse{Proposal: *proposal}, nil
+}
+
+func (q Querier) DelegatorGrants(c context.Context, req *types.QueryDelegatorGrantsRequest) (*types.QueryDelegatorGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	var staker sdk.AccAddress
+	if req.Staker != "" {
+		var err error
+		if staker, err = sdk.AccAddressFromBech32(req.Staker); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "staker")
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	result := types.QueryDelegatorGrantsResponse{
+		Grants: make([]types.DelegatorGrant, 0),
+	}
+	pageRes, err := q.keeper.PaginateDelegatorGrants(ctx, staker, req.Pagination, func(grant types.DelegatorGrant) {
+		result.Grants = append(result.Grants, grant)
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result.Pagination = pageRes
+	return &result, nil
+}
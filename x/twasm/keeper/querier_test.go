@@ -1,57 +1,90 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"testing"
+	"time"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/oldfurya/furya/x/twasm/types"
 )
 
 func TestQueryPrivilegedContracts(t *testing.T) {
-	addr1 := RandomAddress(t)
-	addr2 := RandomAddress(t)
+	var (
+		addr1 = sdk.AccAddress(bytes.Repeat([]byte{1}, address.Len))
+		addr2 = sdk.AccAddress(bytes.Repeat([]byte{2}, address.Len))
+		addr3 = sdk.AccAddress(bytes.Repeat([]byte{3}, address.Len))
+	)
 
 	specs := map[string]struct {
 		state  []sdk.AccAddress
+		src    *types.QueryPrivilegedContractsRequest
 		expRsp *types.QueryPrivilegedContractsResponse
+		expErr bool
 	}{
 		"none found": {
-			expRsp: &types.QueryPrivilegedContractsResponse{},
+			expRsp: &types.QueryPrivilegedContractsResponse{
+				Pagination: &query.PageResponse{},
+			},
 		},
 		"single found": {
 			state: []sdk.AccAddress{addr1},
 			expRsp: &types.QueryPrivilegedContractsResponse{
-				Contracts: []string{addr1.String()},
+				Contracts:  []string{addr1.String()},
+				Pagination: &query.PageResponse{Total: 1},
 			},
 		},
 		"multiple found": {
-			state: []sdk.AccAddress{addr1, addr2},
+			state: []sdk.AccAddress{addr2, addr1},
+			expRsp: &types.QueryPrivilegedContractsResponse{
+				Contracts:  []string{addr1.String(), addr2.String()},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		"paginated": {
+			state: []sdk.AccAddress{addr1, addr2, addr3},
+			src: &types.QueryPrivilegedContractsRequest{
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+			},
 			expRsp: &types.QueryPrivilegedContractsResponse{
-				Contracts: []string{addr1.String(), addr2.String()},
+				Contracts:  []string{addr2.String()},
+				Pagination: &query.PageResponse{NextKey: addr3},
 			},
 		},
+		"invalid pagination": {
+			src: &types.QueryPrivilegedContractsRequest{
+				Pagination: &query.PageRequest{Key: []byte("foo"), Offset: 1},
+			},
+			expErr: true,
+		},
 	}
-	ctx := sdk.Context{}.WithContext(context.Background())
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			mock := MockQueryKeeper{
-				IteratePrivilegedFn: func(ctx sdk.Context, cb func(sdk.AccAddress) bool) {
-					for _, a := range spec.state {
-						if cb(a) {
-							return
-						}
-					}
-				},
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+			k := keepers.TWasmKeeper
+			for _, a := range spec.state {
+				k.setPrivilegedFlag(ctx, a)
 			}
 
-			q := NewQuerier(mock)
+			q := NewQuerier(k)
 			// when
-			gotRsp, gotErr := q.PrivilegedContracts(sdk.WrapSDKContext(ctx), nil)
+			gotRsp, gotErr := q.PrivilegedContracts(sdk.WrapSDKContext(ctx), spec.src)
 			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Nil(t, gotRsp)
+				return
+			}
 			require.NoError(t, gotErr)
 			assert.Equal(t, spec.expRsp, gotRsp)
 		})
@@ -59,8 +92,11 @@ func TestQueryPrivilegedContracts(t *testing.T) {
 }
 
 func TestQueryContractsByPrivilegeType(t *testing.T) {
-	addr1 := RandomAddress(t)
-	addr2 := RandomAddress(t)
+	var (
+		addr1 = sdk.AccAddress(bytes.Repeat([]byte{1}, address.Len))
+		addr2 = sdk.AccAddress(bytes.Repeat([]byte{2}, address.Len))
+		addr3 = sdk.AccAddress(bytes.Repeat([]byte{3}, address.Len))
+	)
 
 	specs := map[string]struct {
 		state  []sdk.AccAddress
@@ -72,7 +108,9 @@ func TestQueryContractsByPrivilegeType(t *testing.T) {
 			src: types.QueryContractsByPrivilegeTypeRequest{
 				PrivilegeType: types.PrivilegeTypeEndBlock.String(),
 			},
-			expRsp: &types.QueryContractsByPrivilegeTypeResponse{},
+			expRsp: &types.QueryContractsByPrivilegeTypeResponse{
+				Pagination: &query.PageResponse{},
+			},
 		},
 		"single found": {
 			src: types.QueryContractsByPrivilegeTypeRequest{
@@ -81,17 +119,47 @@ func TestQueryContractsByPrivilegeType(t *testing.T) {
 			state: []sdk.AccAddress{addr1},
 			expRsp: &types.QueryContractsByPrivilegeTypeResponse{
 				Contracts: []string{addr1.String()},
+				Positions: []types.PrivilegedContractPosition{
+					{Address: addr1.String(), Position: 1},
+				},
+				Pagination: &query.PageResponse{Total: 1},
 			},
 		},
 		"multiple found": {
 			src: types.QueryContractsByPrivilegeTypeRequest{
 				PrivilegeType: types.PrivilegeTypeEndBlock.String(),
 			},
-			state: []sdk.AccAddress{addr1, addr2},
+			state: []sdk.AccAddress{addr2, addr1},
 			expRsp: &types.QueryContractsByPrivilegeTypeResponse{
-				Contracts: []string{addr1.String(), addr2.String()},
+				Contracts: []string{addr2.String(), addr1.String()},
+				Positions: []types.PrivilegedContractPosition{
+					{Address: addr2.String(), Position: 1},
+					{Address: addr1.String(), Position: 2},
+				},
+				Pagination: &query.PageResponse{Total: 2},
 			},
 		},
+		"paginated": {
+			src: types.QueryContractsByPrivilegeTypeRequest{
+				PrivilegeType: types.PrivilegeTypeEndBlock.String(),
+				Pagination:    &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			state: []sdk.AccAddress{addr1, addr2, addr3},
+			expRsp: &types.QueryContractsByPrivilegeTypeResponse{
+				Contracts: []string{addr2.String()},
+				Positions: []types.PrivilegedContractPosition{
+					{Address: addr2.String(), Position: 2},
+				},
+				Pagination: &query.PageResponse{NextKey: []byte{3}},
+			},
+		},
+		"invalid pagination": {
+			src: types.QueryContractsByPrivilegeTypeRequest{
+				PrivilegeType: types.PrivilegeTypeEndBlock.String(),
+				Pagination:    &query.PageRequest{Key: []byte{1}, Offset: 1},
+			},
+			expErr: true,
+		},
 		"unknown privilege type": {
 			src: types.QueryContractsByPrivilegeTypeRequest{
 				PrivilegeType: "unknown",
@@ -103,13 +171,241 @@ func TestQueryContractsByPrivilegeType(t *testing.T) {
 			expErr: true,
 		},
 	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+			k := keepers.TWasmKeeper
+			for _, a := range spec.state {
+				_, err := k.appendToPrivilegedContracts(ctx, types.PrivilegeTypeEndBlock, a)
+				require.NoError(t, err)
+			}
+
+			q := NewQuerier(k)
+			// when
+			gotRsp, gotErr := q.ContractsByPrivilegeType(sdk.WrapSDKContext(ctx), &spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Nil(t, gotRsp)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryCallbackFailures(t *testing.T) {
+	addr1 := RandomAddress(t)
+	addr2 := RandomAddress(t)
+
+	type failure struct {
+		t types.PrivilegeType
+		a sdk.AccAddress
+		f uint32
+	}
+	specs := map[string]struct {
+		state  []failure
+		expRsp *types.QueryCallbackFailuresResponse
+	}{
+		"none found": {
+			expRsp: &types.QueryCallbackFailuresResponse{},
+		},
+		"multiple found": {
+			state: []failure{
+				{t: types.PrivilegeTypeBeginBlock, a: addr1, f: 1},
+				{t: types.PrivilegeTypeEndBlock, a: addr2, f: 2},
+			},
+			expRsp: &types.QueryCallbackFailuresResponse{
+				Failures: []types.CallbackFailure{
+					{ContractAddress: addr1.String(), PrivilegeType: "begin_blocker", Failures: 1},
+					{ContractAddress: addr2.String(), PrivilegeType: "end_blocker", Failures: 2},
+				},
+			},
+		},
+	}
+	ctx := sdk.Context{}.WithContext(context.Background())
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := MockQueryKeeper{
+				IterateCallbackFailuresFn: func(ctx sdk.Context, cb func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint32) bool) {
+					for _, v := range spec.state {
+						if cb(v.t, v.a, v.f) {
+							return
+						}
+					}
+				},
+			}
+
+			q := NewQuerier(mock)
+			// when
+			gotRsp, gotErr := q.CallbackFailures(sdk.WrapSDKContext(ctx), nil)
+			// then
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryContractPrivileges(t *testing.T) {
+	myAddr := RandomAddress(t)
+	otherAddr := RandomAddress(t)
+
+	contractInfo := func(mutators ...func(*wasmtypes.ContractInfo)) *wasmtypes.ContractInfo {
+		r := wasmtypes.ContractInfoFixture(func(info *wasmtypes.ContractInfo) {
+			info.CodeID = 1
+		})
+		for _, m := range mutators {
+			m(&r)
+		}
+		return &r
+	}
+	specs := map[string]struct {
+		src          types.QueryContractPrivilegesRequest
+		contractInfo *wasmtypes.ContractInfo
+		privileged   bool
+		pinned       bool
+		expRsp       *types.QueryContractPrivilegesResponse
+		expErr       bool
+	}{
+		"no privileges": {
+			src:          types.QueryContractPrivilegesRequest{Address: myAddr.String()},
+			contractInfo: contractInfo(),
+			expRsp:       &types.QueryContractPrivilegesResponse{},
+		},
+		"privileged and pinned": {
+			src: types.QueryContractPrivilegesRequest{Address: myAddr.String()},
+			contractInfo: contractInfo(func(info *wasmtypes.ContractInfo) {
+				details := types.PetriContractDetails{}
+				details.AddRegisteredPrivilege(types.PrivilegeTypeBeginBlock, 1)
+				details.AddRegisteredPrivilege(types.PrivilegeTypeEndBlock, 3)
+				require.NoError(t, info.SetExtension(&details))
+			}),
+			privileged: true,
+			pinned:     true,
+			expRsp: &types.QueryContractPrivilegesResponse{
+				Privileged: true,
+				Pinned:     true,
+				Privileges: []types.ContractPrivilege{
+					{PrivilegeType: "begin_blocker", Position: 1, CallbackOrder: 1},
+					{PrivilegeType: "end_blocker", Position: 3, CallbackOrder: 2},
+				},
+			},
+		},
+		"unknown contract": {
+			src:    types.QueryContractPrivilegesRequest{Address: myAddr.String()},
+			expErr: true,
+		},
+		"invalid address": {
+			src:    types.QueryContractPrivilegesRequest{Address: "invalid"},
+			expErr: true,
+		},
+		"empty address": {
+			src:    types.QueryContractPrivilegesRequest{},
+			expErr: true,
+		},
+	}
 	ctx := sdk.Context{}.WithContext(context.Background())
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			mock := MockQueryKeeper{
+				GetContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+					require.Equal(t, myAddr, contractAddress)
+					return spec.contractInfo
+				},
+				IsPrivilegedFn: func(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
+					return spec.privileged
+				},
+				IsPinnedCodeFn: func(ctx sdk.Context, codeID uint64) bool {
+					require.Equal(t, uint64(1), codeID)
+					return spec.pinned
+				},
+				// other contract registered in front for end blocker
 				IterateContractCallbacksByTypeFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool) {
-					for i, a := range spec.state {
-						if cb(uint8(i+1), a) {
+					switch privilegeType {
+					case types.PrivilegeTypeBeginBlock:
+						_ = cb(1, myAddr) || cb(2, otherAddr)
+					case types.PrivilegeTypeEndBlock:
+						_ = cb(2, otherAddr) || cb(3, myAddr)
+					}
+				},
+			}
+
+			q := NewQuerier(mock)
+			// when
+			gotRsp, gotErr := q.ContractPrivileges(sdk.WrapSDKContext(ctx), &spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Nil(t, gotRsp)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryMintAllowance(t *testing.T) {
+	myAddr := RandomAddress(t)
+	myAllowance := types.MintAllowance{
+		ContractAddress: myAddr.String(),
+		Limits:          []types.MintLimit{{Denom: "ufury", TotalCap: sdk.NewInt(100), RateLimit: sdk.ZeroInt()}},
+	}
+	myUsage := types.MintUsage{
+		ContractAddress:   myAddr.String(),
+		Denom:             "ufury",
+		TotalMinted:       sdk.NewInt(10),
+		PeriodStartHeight: 1,
+		PeriodMinted:      sdk.NewInt(10),
+	}
+	specs := map[string]struct {
+		src          types.QueryMintAllowanceRequest
+		contractInfo *wasmtypes.ContractInfo
+		allowance    *types.MintAllowance
+		usages       []types.MintUsage
+		expRsp       *types.QueryMintAllowanceResponse
+		expErr       bool
+	}{
+		"unrestricted": {
+			src:          types.QueryMintAllowanceRequest{Address: myAddr.String()},
+			contractInfo: &wasmtypes.ContractInfo{},
+			expRsp:       &types.QueryMintAllowanceResponse{},
+		},
+		"with allowance and usages": {
+			src:          types.QueryMintAllowanceRequest{Address: myAddr.String()},
+			contractInfo: &wasmtypes.ContractInfo{},
+			allowance:    &myAllowance,
+			usages:       []types.MintUsage{myUsage},
+			expRsp: &types.QueryMintAllowanceResponse{
+				Allowance: &myAllowance,
+				Usages:    []types.MintUsage{myUsage},
+			},
+		},
+		"unknown contract": {
+			src:    types.QueryMintAllowanceRequest{Address: myAddr.String()},
+			expErr: true,
+		},
+		"invalid address": {
+			src:    types.QueryMintAllowanceRequest{Address: "invalid"},
+			expErr: true,
+		},
+	}
+	ctx := sdk.Context{}.WithContext(context.Background())
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := MockQueryKeeper{
+				GetContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+					require.Equal(t, myAddr, contractAddress)
+					return spec.contractInfo
+				},
+				GetMintAllowanceFn: func(ctx sdk.Context, contractAddr sdk.AccAddress) *types.MintAllowance {
+					return spec.allowance
+				},
+				IterateMintUsagesFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, cb func(usage types.MintUsage) bool) {
+					require.Equal(t, myAddr, contractAddr)
+					for _, u := range spec.usages {
+						if cb(u) {
 							return
 						}
 					}
@@ -118,7 +414,128 @@ func TestQueryContractsByPrivilegeType(t *testing.T) {
 
 			q := NewQuerier(mock)
 			// when
-			gotRsp, gotErr := q.ContractsByPrivilegeType(sdk.WrapSDKContext(ctx), &spec.src)
+			gotRsp, gotErr := q.MintAllowance(sdk.WrapSDKContext(ctx), &spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Nil(t, gotRsp)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryScheduledCallbacks(t *testing.T) {
+	var (
+		addr1 = sdk.AccAddress(bytes.Repeat([]byte{1}, address.Len))
+		addr2 = sdk.AccAddress(bytes.Repeat([]byte{2}, address.Len))
+	)
+	callback1 := types.ScheduledCallback{ContractAddress: addr1.String(), ID: "b", Height: 30}
+	callback2 := types.ScheduledCallback{ContractAddress: addr1.String(), ID: "a", Height: 20}
+	callback3 := types.ScheduledCallback{ContractAddress: addr2.String(), ID: "a", Height: 10}
+
+	specs := map[string]struct {
+		src    *types.QueryScheduledCallbacksRequest
+		expRsp *types.QueryScheduledCallbacksResponse
+		expErr bool
+	}{
+		"all contracts in execution order": {
+			src: &types.QueryScheduledCallbacksRequest{},
+			expRsp: &types.QueryScheduledCallbacksResponse{
+				Callbacks:  []types.ScheduledCallback{callback3, callback2, callback1},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		"by contract": {
+			src: &types.QueryScheduledCallbacksRequest{Address: addr1.String()},
+			expRsp: &types.QueryScheduledCallbacksResponse{
+				Callbacks:  []types.ScheduledCallback{callback2, callback1},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		"none found": {
+			src: &types.QueryScheduledCallbacksRequest{Address: RandomAddress(t).String()},
+			expRsp: &types.QueryScheduledCallbacksResponse{
+				Callbacks:  []types.ScheduledCallback{},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"paginated": {
+			src: &types.QueryScheduledCallbacksRequest{Pagination: &query.PageRequest{Limit: 1}},
+			expRsp: &types.QueryScheduledCallbacksResponse{
+				Callbacks:  []types.ScheduledCallback{callback3},
+				Pagination: &query.PageResponse{NextKey: scheduledCallbackQueueKey(scheduledByHeight, 20, addr1, "a")[1:]},
+			},
+		},
+		"invalid address": {
+			src:    &types.QueryScheduledCallbacksRequest{Address: "invalid"},
+			expErr: true,
+		},
+		"empty request": {
+			expErr: true,
+		},
+	}
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	require.NoError(t, k.importScheduledCallbacks(ctx, []types.ScheduledCallback{callback1, callback2, callback3}))
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := NewQuerier(k)
+			// when
+			gotRsp, gotErr := q.ScheduledCallbacks(sdk.WrapSDKContext(ctx), spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Nil(t, gotRsp)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryTimelockedGovProposals(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	proposal1 := types.TimelockedGovProposalFixture(t, func(p *types.TimelockedGovProposal) {
+		p.ID, p.ExecuteTime = 1, time.Unix(2, 0).UTC()
+	})
+	proposal2 := types.TimelockedGovProposalFixture(t, func(p *types.TimelockedGovProposal) {
+		p.ID, p.ExecuteTime = 2, time.Unix(1, 0).UTC()
+	})
+	require.NoError(t, k.importTimelockedGovProposals(ctx, []types.TimelockedGovProposal{proposal1, proposal2}, 3))
+
+	specs := map[string]struct {
+		src    *types.QueryTimelockedGovProposalsRequest
+		expRsp *types.QueryTimelockedGovProposalsResponse
+		expErr bool
+	}{
+		"all in execution order": {
+			src: &types.QueryTimelockedGovProposalsRequest{},
+			expRsp: &types.QueryTimelockedGovProposalsResponse{
+				Proposals:  []types.TimelockedGovProposal{proposal2, proposal1},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		"paginated": {
+			src: &types.QueryTimelockedGovProposalsRequest{Pagination: &query.PageRequest{Limit: 1}},
+			expRsp: &types.QueryTimelockedGovProposalsResponse{
+				Proposals:  []types.TimelockedGovProposal{proposal2},
+				Pagination: &query.PageResponse{NextKey: timelockedGovProposalQueueKey(uint64(time.Unix(2, 0).UnixNano()), 1)[1:]},
+			},
+		},
+		"empty request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := NewQuerier(k)
+			// when
+			gotRsp, gotErr := q.TimelockedGovProposals(sdk.WrapSDKContext(ctx), spec.src)
 			// then
 			if spec.expErr {
 				require.Error(t, gotErr)
@@ -131,16 +548,83 @@ func TestQueryContractsByPrivilegeType(t *testing.T) {
 	}
 }
 
+func TestQueryTimelockedGovProposal(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	myProposal := types.TimelockedGovProposalFixture(t)
+	require.NoError(t, k.importTimelockedGovProposals(ctx, []types.TimelockedGovProposal{myProposal}, 2))
+
+	specs := map[string]struct {
+		src     *types.QueryTimelockedGovProposalRequest
+		expRsp  *types.QueryTimelockedGovProposalResponse
+		expCode codes.Code
+	}{
+		"found": {
+			src:    &types.QueryTimelockedGovProposalRequest{Id: myProposal.ID},
+			expRsp: &types.QueryTimelockedGovProposalResponse{Proposal: myProposal},
+		},
+		"not found": {
+			src:     &types.QueryTimelockedGovProposalRequest{Id: myProposal.ID + 1},
+			expCode: codes.NotFound,
+		},
+		"empty id": {
+			src:     &types.QueryTimelockedGovProposalRequest{},
+			expCode: codes.InvalidArgument,
+		},
+		"empty request": {
+			expCode: codes.InvalidArgument,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := NewQuerier(k)
+			// when
+			gotRsp, gotErr := q.TimelockedGovProposal(sdk.WrapSDKContext(ctx), spec.src)
+			// then
+			if spec.expCode != codes.OK {
+				require.Error(t, gotErr)
+				assert.Equal(t, spec.expCode, status.Code(gotErr))
+				assert.Nil(t, gotRsp)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
 type MockQueryKeeper struct {
-	IteratePrivilegedFn              func(ctx sdk.Context, cb func(sdk.AccAddress) bool)
 	IterateContractCallbacksByTypeFn func(ctx sdk.Context, privilegeType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	IterateCallbackFailuresFn        func(ctx sdk.Context, cb func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint32) bool)
+	GetContractInfoFn                func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	IsPrivilegedFn                   func(ctx sdk.Context, contractAddr sdk.AccAddress) bool
+	IsPinnedCodeFn                   func(ctx sdk.Context, codeID uint64) bool
+	GetMintAllowanceFn               func(ctx sdk.Context, contractAddr sdk.AccAddress) *types.MintAllowance
+	IterateMintUsagesFn              func(ctx sdk.Context, contractAddr sdk.AccAddress, cb func(usage types.MintUsage) bool)
 }
 
-func (m MockQueryKeeper) IteratePrivileged(ctx sdk.Context, cb func(sdk.AccAddress) bool) {
-	if m.IteratePrivilegedFn == nil {
-		panic("not expected to be called")
-	}
-	m.IteratePrivilegedFn(ctx, cb)
+func (m MockQueryKeeper) PaginatePrivileged(ctx sdk.Context, pageReq *query.PageRequest, cb func(sdk.AccAddress)) (*query.PageResponse, error) {
+	panic("not expected to be called")
+}
+
+func (m MockQueryKeeper) PaginatePrivilegedContractsByType(ctx sdk.Context, privilegeType types.PrivilegeType, pageReq *query.PageRequest, cb func(pos uint8, contractAddr sdk.AccAddress)) (*query.PageResponse, error) {
+	panic("not expected to be called")
+}
+
+func (m MockQueryKeeper) PaginateScheduledCallbacks(ctx sdk.Context, contractAddr sdk.AccAddress, pageReq *query.PageRequest, cb func(callback types.ScheduledCallback)) (*query.PageResponse, error) {
+	panic("not expected to be called")
+}
+
+func (m MockQueryKeeper) PaginateTimelockedGovProposals(ctx sdk.Context, pageReq *query.PageRequest, cb func(proposal types.TimelockedGovProposal)) (*query.PageResponse, error) {
+	panic("not expected to be called")
+}
+
+func (m MockQueryKeeper) GetTimelockedGovProposal(ctx sdk.Context, id uint64) *types.TimelockedGovProposal {
+	panic("not expected to be called")
+}
+
+func (m MockQueryKeeper) PaginateDelegatorGrants(ctx sdk.Context, staker sdk.AccAddress, pageReq *query.PageRequest, cb func(grant types.DelegatorGrant)) (*query.PageResponse, error) {
+	panic("not expected to be called")
 }
 
 func (m MockQueryKeeper) IteratePrivilegedContractsByType(ctx sdk.Context, privilegeType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool) {
@@ -149,3 +633,101 @@ func (m MockQueryKeeper) IteratePrivilegedContractsByType(ctx sdk.Context, privi
 	}
 	m.IterateContractCallbacksByTypeFn(ctx, privilegeType, cb)
 }
+
+func (m MockQueryKeeper) IterateCallbackFailures(ctx sdk.Context, cb func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint32) bool) {
+	if m.IterateCallbackFailuresFn == nil {
+		panic("not expected to be called")
+	}
+	m.IterateCallbackFailuresFn(ctx, cb)
+}
+
+func (m MockQueryKeeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	if m.GetContractInfoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetContractInfoFn(ctx, contractAddress)
+}
+
+func (m MockQueryKeeper) IsPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
+	if m.IsPrivilegedFn == nil {
+		panic("not expected to be called")
+	}
+	return m.IsPrivilegedFn(ctx, contractAddr)
+}
+
+func (m MockQueryKeeper) IsPinnedCode(ctx sdk.Context, codeID uint64) bool {
+	if m.IsPinnedCodeFn == nil {
+		panic("not expected to be called")
+	}
+	return m.IsPinnedCodeFn(ctx, codeID)
+}
+
+func (m MockQueryKeeper) GetMintAllowance(ctx sdk.Context, contractAddr sdk.AccAddress) *types.MintAllowance {
+	if m.GetMintAllowanceFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetMintAllowanceFn(ctx, contractAddr)
+}
+
+func (m MockQueryKeeper) IterateMintUsages(ctx sdk.Context, contractAddr sdk.AccAddress, cb func(usage types.MintUsage) bool) {
+	if m.IterateMintUsagesFn == nil {
+		panic("not expected to be called")
+	}
+	m.IterateMintUsagesFn(ctx, contractAddr, cb)
+}
+
+func TestQueryDelegatorGrants(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	myStakerAddr := RandomAddress(t)
+	myGrant := types.DelegatorGrant{Staker: myStakerAddr.String(), ContractAddress: RandomAddress(t).String()}
+	otherGrant := types.DelegatorGrant{Staker: RandomAddress(t).String(), ContractAddress: RandomAddress(t).String()}
+	require.NoError(t, k.importDelegatorGrants(ctx, []types.DelegatorGrant{myGrant, otherGrant}))
+
+	specs := map[string]struct {
+		src       *types.QueryDelegatorGrantsRequest
+		expGrants []types.DelegatorGrant
+		expTotal  uint64
+		expCode   codes.Code
+	}{
+		"all": {
+			src:      &types.QueryDelegatorGrantsRequest{},
+			expTotal: 2,
+		},
+		"by staker": {
+			src:       &types.QueryDelegatorGrantsRequest{Staker: myStakerAddr.String()},
+			expGrants: []types.DelegatorGrant{myGrant},
+			expTotal:  1,
+		},
+		"unknown staker": {
+			src:       &types.QueryDelegatorGrantsRequest{Staker: RandomAddress(t).String()},
+			expGrants: []types.DelegatorGrant{},
+		},
+		"invalid staker": {
+			src:     &types.QueryDelegatorGrantsRequest{Staker: "invalid"},
+			expCode: codes.InvalidArgument,
+		},
+		"empty request": {
+			expCode: codes.InvalidArgument,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := NewQuerier(k)
+			// when
+			gotRsp, gotErr := q.DelegatorGrants(sdk.WrapSDKContext(ctx), spec.src)
+			// then
+			if spec.expCode != codes.OK {
+				assert.Equal(t, spec.expCode, status.Code(gotErr))
+				assert.Nil(t, gotRsp)
+				return
+			}
+			require.NoError(t, gotErr)
+			if spec.expGrants != nil {
+				assert.Equal(t, spec.expGrants, gotRsp.Grants)
+			}
+			assert.Len(t, gotRsp.Grants, int(spec.expTotal))
+			assert.Equal(t, spec.expTotal, gotRsp.Pagination.Total)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+// queue types to order the scheduled callbacks
+const (
+	scheduledByHeight byte = 0x1
+	scheduledByTime   byte = 0x2
+)
+
+// ScheduleCallback stores a callback that is executed in the end blocker when the block height or time is reached.
+// The callback ID must be unique for the contract.
+func (k Keeper) ScheduleCallback(ctx sdk.Context, callback types.ScheduledCallback) error {
+	if err := callback.ValidateBasic(); err != nil {
+		return err
+	}
+	if callback.IsDue(ctx) {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "height or time must be in the future")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(callback.ContractAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if ctx.KVStore(k.storeKey).Has(scheduledCallbackIndexKey(contractAddr, callback.ID)) {
+		return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "callback id %s", callback.ID)
+	}
+	k.storeScheduledCallback(ctx, contractAddr, callback)
+	return nil
+}
+
+// CancelScheduledCallback removes a pending callback of the contract
+func (k Keeper) CancelScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, id string) error {
+	store := ctx.KVStore(k.storeKey)
+	indexKey := scheduledCallbackIndexKey(contractAddr, id)
+	queueKey := store.Get(indexKey)
+	if queueKey == nil {
+		return sdkerrors.Wrapf(wasmtypes.ErrNotFound, "callback id %s", id)
+	}
+	store.Delete(queueKey)
+	store.Delete(indexKey)
+	return nil
+}
+
+// PopDueScheduledCallbacks removes and returns the callbacks that are due for the current block. Callbacks scheduled
+// by height come first, followed by the callbacks scheduled by time. The result is limited to max elements.
+func (k Keeper) PopDueScheduledCallbacks(ctx sdk.Context, max int) []types.ScheduledCallback {
+	store := ctx.KVStore(k.storeKey)
+	var result []types.ScheduledCallback
+	var queueKeys [][]byte
+	collect := func(queue byte, end uint64) {
+		start := append(append([]byte{}, scheduledCallbackQueuePrefix...), queue)
+		iter := store.Iterator(start, scheduledCallbackQueueKey(queue, end, nil, ""))
+		defer iter.Close()
+		for ; iter.Valid() && len(result) < max; iter.Next() {
+			var callback types.ScheduledCallback
+			k.cdc.MustUnmarshal(iter.Value(), &callback)
+			result = append(result, callback)
+			queueKeys = append(queueKeys, iter.Key())
+		}
+	}
+	// end keys are exclusive
+	collect(scheduledByHeight, uint64(ctx.BlockHeight())+1)
+	if blockTime := ctx.BlockTime().UnixNano(); blockTime > 0 {
+		collect(scheduledByTime, uint64(blockTime)+1)
+	}
+
+	// removed after the iteration to not modify the store while iterating
+	for i, c := range result {
+		contractAddr, err := sdk.AccAddressFromBech32(c.ContractAddress)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "stored scheduled callback address: %s", c.ContractAddress))
+		}
+		store.Delete(queueKeys[i])
+		store.Delete(scheduledCallbackIndexKey(contractAddr, c.ID))
+	}
+	return result
+}
+
+// IterateScheduledCallbacks iterates through all pending callbacks in the order of execution
+func (k Keeper) IterateScheduledCallbacks(ctx sdk.Context, cb func(callback types.ScheduledCallback) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), scheduledCallbackQueuePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var callback types.ScheduledCallback
+		k.cdc.MustUnmarshal(iter.Value(), &callback)
+		// cb returns true to stop early
+		if cb(callback) {
+			return
+		}
+	}
+}
+
+// PaginateScheduledCallbacks iterates through a page of pending callbacks. All contracts are included in the
+// order of execution when the contract address is empty. Otherwise, the callbacks of the contract are returned by ID ASC.
+func (k Keeper) PaginateScheduledCallbacks(ctx sdk.Context, contractAddr sdk.AccAddress, pageReq *query.PageRequest, cb func(callback types.ScheduledCallback)) (*query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	if len(contractAddr) == 0 {
+		return query.Paginate(prefix.NewStore(store, scheduledCallbackQueuePrefix), pageReq, func(_ []byte, value []byte) error {
+			var callback types.ScheduledCallback
+			k.cdc.MustUnmarshal(value, &callback)
+			cb(callback)
+			return nil
+		})
+	}
+	return query.Paginate(prefix.NewStore(store, scheduledCallbackIndexPrefixForContract(contractAddr)), pageReq, func(_ []byte, queueKey []byte) error {
+		var callback types.ScheduledCallback
+		k.cdc.MustUnmarshal(store.Get(queueKey), &callback)
+		cb(callback)
+		return nil
+	})
+}
+
+// removeScheduledCallbacks removes all pending callbacks of the contract
+func (k Keeper) removeScheduledCallbacks(ctx sdk.Context, contractAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, scheduledCallbackIndexPrefixForContract(contractAddr))
+	iter := prefixStore.Iterator(nil, nil)
+	var indexKeys, queueKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		indexKeys = append(indexKeys, iter.Key())
+		queueKeys = append(queueKeys, iter.Value())
+	}
+	iter.Close()
+	// removed after the iteration to not modify the store while iterating
+	for i := range indexKeys {
+		prefixStore.Delete(indexKeys[i])
+		store.Delete(queueKeys[i])
+	}
+}
+
+// importScheduledCallbacks stores the callbacks from genesis. They are not required to be in the future.
+func (k Keeper) importScheduledCallbacks(ctx sdk.Context, callbacks []types.ScheduledCallback) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, c := range callbacks {
+		if err := c.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "callback %s for %s", c.ID, c.ContractAddress)
+		}
+		contractAddr, err := sdk.AccAddressFromBech32(c.ContractAddress)
+		if err != nil {
+			return sdkerrors.Wrap(err, "contract address")
+		}
+		if store.Has(scheduledCallbackIndexKey(contractAddr, c.ID)) {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "callback %s for %s", c.ID, c.ContractAddress)
+		}
+		k.storeScheduledCallback(ctx, contractAddr, c)
+	}
+	return nil
+}
+
+func (k Keeper) storeScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, callback types.ScheduledCallback) {
+	queue, pos := scheduledByHeight, uint64(callback.Height)
+	if callback.Time != nil {
+		queue, pos = scheduledByTime, uint64(callback.Time.UnixNano())
+	}
+	queueKey := scheduledCallbackQueueKey(queue, pos, contractAddr, callback.ID)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(queueKey, k.cdc.MustMarshal(&callback))
+	store.Set(scheduledCallbackIndexKey(contractAddr, callback.ID), queueKey)
+}
+
+// scheduledCallbackQueueKey returns the key for the execution order
+// `<prefix><queue><height or time><len><contractAddr><id>`
+func scheduledCallbackQueueKey(queue byte, pos uint64, contractAddr sdk.AccAddress, id string) []byte {
+	r := append(append([]byte{}, scheduledCallbackQueuePrefix...), queue)
+	r = append(r, sdk.Uint64ToBigEndian(pos)...)
+	if len(contractAddr) == 0 {
+		return r
+	}
+	r = append(r, address.MustLengthPrefix(contractAddr)...)
+	return append(r, []byte(id)...)
+}
+
+// scheduledCallbackIndexKey returns the key for the lookup by contract
+// `<prefix><len><contractAddr><id>`
+func scheduledCallbackIndexKey(contractAddr sdk.AccAddress, id string) []byte {
+	return append(scheduledCallbackIndexPrefixForContract(contractAddr), []byte(id)...)
+}
+
+// scheduledCallbackIndexPrefixForContract returns `<prefix><len><contractAddr>`
+func scheduledCallbackIndexPrefixForContract(contractAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, scheduledCallbackIndexPrefix...), address.MustLengthPrefix(contractAddr)...)
+}
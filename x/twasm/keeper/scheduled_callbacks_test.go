@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+func TestScheduleCallback(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	myAddr := RandomAddress(t)
+	futureTime := ctx.BlockTime().Add(time.Second)
+
+	specs := map[string]struct {
+		src    types.ScheduledCallback
+		expErr *sdkerrors.Error
+	}{
+		"by height": {
+			src: types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "my-id", Height: ctx.BlockHeight() + 1},
+		},
+		"by time": {
+			src: types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "my-id", Time: &futureTime},
+		},
+		"current height": {
+			src:    types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "my-id", Height: ctx.BlockHeight()},
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"current time": {
+			src:    types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "my-id", Time: &[]time.Time{ctx.BlockTime()}[0]},
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"duplicate id": {
+			src:    types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "existing", Height: ctx.BlockHeight() + 1},
+			expErr: wasmtypes.ErrDuplicate,
+		},
+		"invalid": {
+			src:    types.ScheduledCallback{ContractAddress: myAddr.String(), Height: ctx.BlockHeight() + 1},
+			expErr: wasmtypes.ErrEmpty,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			existing := types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "existing", Height: ctx.BlockHeight() + 10}
+			require.NoError(t, k.ScheduleCallback(ctx, existing))
+			// when
+			gotErr := k.ScheduleCallback(ctx, spec.src)
+			// then
+			var got []types.ScheduledCallback
+			k.IterateScheduledCallbacks(ctx, func(callback types.ScheduledCallback) bool {
+				got = append(got, callback)
+				return false
+			})
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				assert.Equal(t, []types.ScheduledCallback{existing}, got)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Len(t, got, 2)
+			assert.Contains(t, got, spec.src)
+		})
+	}
+}
+
+func TestCancelScheduledCallback(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	myAddr, myOtherAddr := RandomAddress(t), RandomAddress(t)
+	myCallback := types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "my-id", Height: ctx.BlockHeight() + 1}
+	require.NoError(t, k.ScheduleCallback(ctx, myCallback))
+
+	specs := map[string]struct {
+		contractAddr sdk.AccAddress
+		id           string
+		expErr       *sdkerrors.Error
+	}{
+		"all good": {
+			contractAddr: myAddr,
+			id:           "my-id",
+		},
+		"unknown id": {
+			contractAddr: myAddr,
+			id:           "unknown",
+			expErr:       wasmtypes.ErrNotFound,
+		},
+		"other contract": {
+			contractAddr: myOtherAddr,
+			id:           "my-id",
+			expErr:       wasmtypes.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			// when
+			gotErr := k.CancelScheduledCallback(ctx, spec.contractAddr, spec.id)
+			// then
+			var got []types.ScheduledCallback
+			k.IterateScheduledCallbacks(ctx, func(callback types.ScheduledCallback) bool {
+				got = append(got, callback)
+				return false
+			})
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				assert.Equal(t, []types.ScheduledCallback{myCallback}, got)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Empty(t, got)
+			// and the id can be used again
+			require.NoError(t, k.ScheduleCallback(ctx, myCallback))
+		})
+	}
+}
+
+func TestPopDueScheduledCallbacks(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	myAddr := RandomAddress(t)
+	height, blockTime := ctx.BlockHeight(), ctx.BlockTime()
+	pastTime, futureTime := blockTime.Add(-time.Second), blockTime.Add(time.Second)
+
+	byHeight1 := types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "h1", Height: height - 1}
+	byHeight2 := types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "h2", Height: height}
+	futureHeight := types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "h3", Height: height + 1}
+	byTime1 := types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "t1", Time: &pastTime}
+	byTime2 := types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "t2", Time: &blockTime}
+	futureTimeCallback := types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "t3", Time: &futureTime}
+	all := []types.ScheduledCallback{futureTimeCallback, byTime2, byHeight2, futureHeight, byTime1, byHeight1}
+
+	specs := map[string]struct {
+		max          int
+		expPopped    []types.ScheduledCallback
+		expRemaining []types.ScheduledCallback
+	}{
+		"all due": {
+			max:          10,
+			expPopped:    []types.ScheduledCallback{byHeight1, byHeight2, byTime1, byTime2},
+			expRemaining: []types.ScheduledCallback{futureHeight, futureTimeCallback},
+		},
+		"limited": {
+			max:          3,
+			expPopped:    []types.ScheduledCallback{byHeight1, byHeight2, byTime1},
+			expRemaining: []types.ScheduledCallback{futureHeight, byTime2, futureTimeCallback},
+		},
+		"zero": {
+			expRemaining: []types.ScheduledCallback{byHeight1, byHeight2, futureHeight, byTime1, byTime2, futureTimeCallback},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			require.NoError(t, k.importScheduledCallbacks(ctx, all))
+			// when
+			got := k.PopDueScheduledCallbacks(ctx, spec.max)
+			// then
+			assert.Equal(t, spec.expPopped, got)
+			var remaining []types.ScheduledCallback
+			k.IterateScheduledCallbacks(ctx, func(callback types.ScheduledCallback) bool {
+				remaining = append(remaining, callback)
+				return false
+			})
+			assert.Equal(t, spec.expRemaining, remaining)
+			// and index cleared
+			for _, c := range spec.expPopped {
+				assert.False(t, ctx.KVStore(k.storeKey).Has(scheduledCallbackIndexKey(myAddr, c.ID)))
+			}
+		})
+	}
+}
+
+func TestScheduledCallbacksRemovedOnPrivilegeRelease(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	myAddr, myOtherAddr := RandomAddress(t), RandomAddress(t)
+	myCallback := types.ScheduledCallback{ContractAddress: myAddr.String(), ID: "my-id", Height: ctx.BlockHeight() + 1}
+	otherCallback := types.ScheduledCallback{ContractAddress: myOtherAddr.String(), ID: "my-id", Height: ctx.BlockHeight() + 1}
+	require.NoError(t, k.ScheduleCallback(ctx, myCallback))
+	require.NoError(t, k.ScheduleCallback(ctx, otherCallback))
+	pos, err := k.appendToPrivilegedContracts(ctx, types.PrivilegeTypeScheduledCallback, myAddr)
+	require.NoError(t, err)
+
+	// when
+	require.True(t, k.removePrivilegeRegistration(ctx, types.PrivilegeTypeScheduledCallback, pos, myAddr))
+
+	// then
+	var got []types.ScheduledCallback
+	k.IterateScheduledCallbacks(ctx, func(callback types.ScheduledCallback) bool {
+		got = append(got, callback)
+		return false
+	})
+	assert.Equal(t, []types.ScheduledCallback{otherCallback}, got)
+	assert.False(t, ctx.KVStore(k.storeKey).Has(scheduledCallbackIndexKey(myAddr, "my-id")))
+}
@@ -6,4 +6,13 @@ var (
 
 	privilegedContractsSecondaryIndexPrefix = []byte{0xa0}
 	contractCallbacksSecondaryIndexPrefix   = []byte{0xa1}
+	callbackFailuresPrefix                  = []byte{0xa2}
+	mintAllowancePrefix                     = []byte{0xa3}
+	mintUsagePrefix                         = []byte{0xa4}
+	scheduledCallbackQueuePrefix            = []byte{0xa5}
+	scheduledCallbackIndexPrefix            = []byte{0xa6}
+	timelockedGovProposalQueuePrefix        = []byte{0xa7}
+	timelockedGovProposalIndexPrefix        = []byte{0xa8}
+	timelockedGovProposalSequenceKey        = []byte{0xa9}
+	delegatorGrantPrefix                    = []byte{0xaa}
 )
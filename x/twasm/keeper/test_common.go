@@ -263,7 +263,7 @@ func createTestInput(
 			}),
 			nested,
 			// append our custom message handler
-			NewPetriHandler(appCodec, &keeper, bankKeeper, nil, nil),
+			NewPetriHandler(appCodec, &keeper, bankKeeper, nil, nil, nil),
 		)
 	})
 
@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/oldfurya/furya/x/twasm/contract"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+// QueueGovProposal stores the gov proposal content of a privileged contract to be executed in the end blocker when
+// the timelock has passed.
+func (k Keeper) QueueGovProposal(ctx sdk.Context, sender sdk.AccAddress, content govtypes.Content) (types.TimelockedGovProposal, error) {
+	id := k.nextTimelockedGovProposalID(ctx)
+	executeTime, executeHeight := k.timelockExecuteTimeAndHeight(ctx)
+	proposal, err := types.NewTimelockedGovProposal(id, sender, content, ctx.BlockHeight(), executeTime, executeHeight)
+	if err != nil {
+		return types.TimelockedGovProposal{}, sdkerrors.Wrap(err, "content")
+	}
+	return proposal, k.queueTimelockedGovProposal(ctx, proposal)
+}
+
+// QueueConsensusParamsUpdate stores the consensus params update of a privileged contract to be applied in the end
+// blocker when the timelock has passed.
+func (k Keeper) QueueConsensusParamsUpdate(ctx sdk.Context, sender sdk.AccAddress, update *contract.ConsensusParamsUpdate) (types.TimelockedGovProposal, error) {
+	if err := update.ValidateBasic(); err != nil {
+		return types.TimelockedGovProposal{}, err
+	}
+	bz, err := json.Marshal(update)
+	if err != nil {
+		return types.TimelockedGovProposal{}, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	id := k.nextTimelockedGovProposalID(ctx)
+	executeTime, executeHeight := k.timelockExecuteTimeAndHeight(ctx)
+	proposal := types.NewTimelockedConsensusParamsUpdate(id, sender, bz, ctx.BlockHeight(), executeTime, executeHeight)
+	return proposal, k.queueTimelockedGovProposal(ctx, proposal)
+}
+
+// timelockExecuteTimeAndHeight returns the earliest block time and height to execute a proposal queued in this block
+func (k Keeper) timelockExecuteTimeAndHeight(ctx sdk.Context) (time.Time, int64) {
+	params := k.GetTWasmParams(ctx)
+	return ctx.BlockTime().Add(params.GovProposalTimelock), ctx.BlockHeight() + int64(params.GovProposalTimelockBlocks)
+}
+
+func (k Keeper) queueTimelockedGovProposal(ctx sdk.Context, proposal types.TimelockedGovProposal) error {
+	if err := proposal.ValidateBasic(); err != nil {
+		return err
+	}
+	k.storeTimelockedGovProposal(ctx, proposal)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeQueueGovProposal,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, proposal.Sender),
+		sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyProposalType, proposal.ProposalType()),
+		sdk.NewAttribute(types.AttributeKeyExecuteTime, proposal.ExecuteTime.UTC().String()),
+		sdk.NewAttribute(types.AttributeKeyExecuteHeight, strconv.FormatInt(proposal.ExecuteHeight, 10)),
+	))
+	return nil
+}
+
+// VetoGovProposal removes the queued gov proposal so that it is never executed
+func (k Keeper) VetoGovProposal(ctx sdk.Context, vetoContract sdk.AccAddress, id uint64) error {
+	store := ctx.KVStore(k.storeKey)
+	indexKey := timelockedGovProposalIndexKey(id)
+	queueKey := store.Get(indexKey)
+	if queueKey == nil {
+		return sdkerrors.Wrapf(wasmtypes.ErrNotFound, "proposal id %d", id)
+	}
+	store.Delete(queueKey)
+	store.Delete(indexKey)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeVetoGovProposal,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, vetoContract.String()),
+		sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(id, 10)),
+	))
+	return nil
+}
+
+// GetTimelockedGovProposal returns the queued gov proposal for the id. Returns nil when not found.
+func (k Keeper) GetTimelockedGovProposal(ctx sdk.Context, id uint64) *types.TimelockedGovProposal {
+	store := ctx.KVStore(k.storeKey)
+	queueKey := store.Get(timelockedGovProposalIndexKey(id))
+	if queueKey == nil {
+		return nil
+	}
+	var proposal types.TimelockedGovProposal
+	k.cdc.MustUnmarshal(store.Get(queueKey), &proposal)
+	return &proposal
+}
+
+// PopDueTimelockedGovProposals removes and returns the queued gov proposals with an execute time and height that
+// are reached by the current block. The result is limited to max elements. Proposals are executed in the queue order
+// so that a proposal with an execute height not reached yet holds back the following ones.
+func (k Keeper) PopDueTimelockedGovProposals(ctx sdk.Context, max int) []types.TimelockedGovProposal {
+	blockTime := ctx.BlockTime().UnixNano()
+	if blockTime <= 0 {
+		return nil
+	}
+	height := ctx.BlockHeight()
+	store := ctx.KVStore(k.storeKey)
+	var result []types.TimelockedGovProposal
+	var queueKeys [][]byte
+	// end key is exclusive
+	iter := store.Iterator(timelockedGovProposalQueuePrefix, timelockedGovProposalQueueKey(uint64(blockTime)+1, 0))
+	for ; iter.Valid() && len(result) < max; iter.Next() {
+		var proposal types.TimelockedGovProposal
+		k.cdc.MustUnmarshal(iter.Value(), &proposal)
+		if proposal.ExecuteHeight > height {
+			break
+		}
+		result = append(result, proposal)
+		queueKeys = append(queueKeys, iter.Key())
+	}
+	iter.Close()
+	// removed after the iteration to not modify the store while iterating
+	for i, p := range result {
+		store.Delete(queueKeys[i])
+		store.Delete(timelockedGovProposalIndexKey(p.ID))
+	}
+	return result
+}
+
+// ExecuteTimelockedGovProposal routes the content of the queued proposal to the gov proposal handler or applies the
+// queued consensus params update. State changes are only persisted on success.
+func (k Keeper) ExecuteTimelockedGovProposal(ctx sdk.Context, proposal types.TimelockedGovProposal) error {
+	cacheCtx, commit := ctx.CacheContext()
+	var err error
+	if len(proposal.ConsensusParams) != 0 {
+		err = k.applyConsensusParamsUpdate(cacheCtx, proposal.ConsensusParams)
+	} else {
+		err = k.executeGovProposalContent(cacheCtx, proposal.ProposalContent())
+	}
+	if err == nil {
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExecuteGovProposal,
+		sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyProposalType, proposal.ProposalType()),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	))
+	return err
+}
+
+func (k Keeper) executeGovProposalContent(ctx sdk.Context, content govtypes.Content) error {
+	if content == nil {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "content")
+	}
+	router := restrictParamsDecorator(k.govRouter)
+	if !router.HasRoute(content.ProposalRoute()) {
+		return sdkerrors.Wrap(govtypes.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+	return router.GetRoute(content.ProposalRoute())(ctx, content)
+}
+
+func (k Keeper) applyConsensusParamsUpdate(ctx sdk.Context, bz []byte) error {
+	if k.consensusParamsUpdater == nil {
+		return sdkerrors.Wrap(wasmtypes.ErrNotFound, "consensus params updater")
+	}
+	var update contract.ConsensusParamsUpdate
+	if err := json.Unmarshal(bz, &update); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if err := update.ValidateBasic(); err != nil {
+		return err
+	}
+	params, err := mergeConsensusParamsUpdate(k.consensusParamsUpdater.GetConsensusParams(ctx), &update)
+	if err != nil {
+		return err
+	}
+	k.consensusParamsUpdater.StoreConsensusParams(ctx, params)
+	return nil
+}
+
+// IterateTimelockedGovProposals iterates through all queued gov proposals in the order of execution
+func (k Keeper) IterateTimelockedGovProposals(ctx sdk.Context, cb func(proposal types.TimelockedGovProposal) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), timelockedGovProposalQueuePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var proposal types.TimelockedGovProposal
+		k.cdc.MustUnmarshal(iter.Value(), &proposal)
+		// cb returns true to stop early
+		if cb(proposal) {
+			return
+		}
+	}
+}
+
+// PaginateTimelockedGovProposals iterates through a page of queued gov proposals in the order of execution
+func (k Keeper) PaginateTimelockedGovProposals(ctx sdk.Context, pageReq *query.PageRequest, cb func(proposal types.TimelockedGovProposal)) (*query.PageResponse, error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), timelockedGovProposalQueuePrefix)
+	return query.Paginate(prefixStore, pageReq, func(_ []byte, value []byte) error {
+		var proposal types.TimelockedGovProposal
+		k.cdc.MustUnmarshal(value, &proposal)
+		cb(proposal)
+		return nil
+	})
+}
+
+// GetTimelockedGovProposalSequence returns the id for the next queued gov proposal
+func (k Keeper) GetTimelockedGovProposalSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(timelockedGovProposalSequenceKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setTimelockedGovProposalSequence(ctx sdk.Context, seq uint64) {
+	ctx.KVStore(k.storeKey).Set(timelockedGovProposalSequenceKey, sdk.Uint64ToBigEndian(seq))
+}
+
+func (k Keeper) nextTimelockedGovProposalID(ctx sdk.Context) uint64 {
+	id := k.GetTimelockedGovProposalSequence(ctx)
+	k.setTimelockedGovProposalSequence(ctx, id+1)
+	return id
+}
+
+// importTimelockedGovProposals stores the queued proposals and the sequence from genesis. The execute time is not
+// required to be in the future.
+func (k Keeper) importTimelockedGovProposals(ctx sdk.Context, proposals []types.TimelockedGovProposal, seq uint64) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, p := range proposals {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "timelocked gov proposal %d", p.ID)
+		}
+		if store.Has(timelockedGovProposalIndexKey(p.ID)) {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "timelocked gov proposal %d", p.ID)
+		}
+		if p.ID >= seq {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "timelocked gov proposal %d must be lower than sequence %d", p.ID, seq)
+		}
+		k.storeTimelockedGovProposal(ctx, p)
+	}
+	if seq != 0 {
+		k.setTimelockedGovProposalSequence(ctx, seq)
+	}
+	return nil
+}
+
+func (k Keeper) storeTimelockedGovProposal(ctx sdk.Context, proposal types.TimelockedGovProposal) {
+	queueKey := timelockedGovProposalQueueKey(uint64(proposal.ExecuteTime.UnixNano()), proposal.ID)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(queueKey, k.cdc.MustMarshal(&proposal))
+	store.Set(timelockedGovProposalIndexKey(proposal.ID), queueKey)
+}
+
+// timelockedGovProposalQueueKey returns the key for the execution order
+// `<prefix><execute time><id>`
+func timelockedGovProposalQueueKey(executeTime uint64, id uint64) []byte {
+	r := append(append([]byte{}, timelockedGovProposalQueuePrefix...), sdk.Uint64ToBigEndian(executeTime)...)
+	return append(r, sdk.Uint64ToBigEndian(id)...)
+}
+
+// timelockedGovProposalIndexKey returns the key for the lookup by id
+// `<prefix><id>`
+func timelockedGovProposalIndexKey(id uint64) []byte {
+	return append(append([]byte{}, timelockedGovProposalIndexPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
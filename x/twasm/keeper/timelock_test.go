@@ -0,0 +1,335 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/oldfurya/furya/x/twasm/contract"
+	"github.com/oldfurya/furya/x/twasm/types"
+)
+
+func TestQueueGovProposal(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	params := k.GetTWasmParams(ctx)
+	params.GovProposalTimelock = time.Hour
+	params.GovProposalTimelockBlocks = 5
+	k.SetTWasmParams(ctx, params)
+	mySenderAddr := RandomAddress(t)
+
+	specs := map[string]struct {
+		src    govtypes.Content
+		expErr *sdkerrors.Error
+	}{
+		"all good": {
+			src: &govtypes.TextProposal{Title: "foo", Description: "bar"},
+		},
+		"invalid content": {
+			src:    &govtypes.TextProposal{},
+			expErr: govtypes.ErrInvalidProposalContent,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			// when
+			got, gotErr := k.QueueGovProposal(ctx, mySenderAddr, spec.src)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				assert.Nil(t, k.GetTimelockedGovProposal(ctx, 1))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, uint64(1), got.ID)
+			assert.Equal(t, mySenderAddr.String(), got.Sender)
+			assert.Equal(t, ctx.BlockHeight(), got.SubmitHeight)
+			assert.Equal(t, ctx.BlockTime().Add(time.Hour), got.ExecuteTime)
+			assert.Equal(t, ctx.BlockHeight()+5, got.ExecuteHeight)
+			assert.Equal(t, spec.src, got.ProposalContent())
+
+			stored := k.GetTimelockedGovProposal(ctx, got.ID)
+			require.NotNil(t, stored)
+			assert.Equal(t, spec.src, stored.ProposalContent())
+			assert.Equal(t, uint64(2), k.GetTimelockedGovProposalSequence(ctx))
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeQueueGovProposal, em.Events()[0].Type)
+		})
+	}
+}
+
+func TestQueueConsensusParamsUpdate(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	params := k.GetTWasmParams(ctx)
+	params.GovProposalTimelockBlocks = 5
+	k.SetTWasmParams(ctx, params)
+	mySenderAddr := RandomAddress(t)
+	var one int64 = 1
+
+	specs := map[string]struct {
+		src    contract.ConsensusParamsUpdate
+		expErr *sdkerrors.Error
+	}{
+		"all good": {
+			src: contract.ConsensusParamsUpdate{Block: &contract.BlockParams{MaxBytes: &one}},
+		},
+		"invalid update": {
+			src:    contract.ConsensusParamsUpdate{},
+			expErr: wasmtypes.ErrEmpty,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			// when
+			got, gotErr := k.QueueConsensusParamsUpdate(ctx, mySenderAddr, &spec.src)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				assert.Nil(t, k.GetTimelockedGovProposal(ctx, 1))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, types.TimelockTypeConsensusParams, got.ProposalType())
+			assert.Nil(t, got.Content)
+			assert.Equal(t, ctx.BlockTime(), got.ExecuteTime)
+			assert.Equal(t, ctx.BlockHeight()+5, got.ExecuteHeight)
+			stored := k.GetTimelockedGovProposal(ctx, got.ID)
+			require.NotNil(t, stored)
+			assert.JSONEq(t, `{"block":{"max_bytes":1}}`, string(stored.ConsensusParams))
+		})
+	}
+}
+
+func TestVetoGovProposal(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	myVetoAddr := RandomAddress(t)
+	queued, err := k.QueueGovProposal(ctx, RandomAddress(t), &govtypes.TextProposal{Title: "foo", Description: "bar"})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		id     uint64
+		expErr *sdkerrors.Error
+	}{
+		"all good": {
+			id: queued.ID,
+		},
+		"unknown id": {
+			id:     queued.ID + 1,
+			expErr: wasmtypes.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			// when
+			gotErr := k.VetoGovProposal(ctx, myVetoAddr, spec.id)
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				assert.NotNil(t, k.GetTimelockedGovProposal(ctx, queued.ID))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetTimelockedGovProposal(ctx, queued.ID))
+			var got []types.TimelockedGovProposal
+			k.IterateTimelockedGovProposals(ctx, func(p types.TimelockedGovProposal) bool {
+				got = append(got, p)
+				return false
+			})
+			assert.Empty(t, got)
+		})
+	}
+}
+
+func TestPopDueTimelockedGovProposals(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	blockTime := ctx.BlockTime()
+	atTime := func(id uint64, executeTime time.Time) types.TimelockedGovProposal {
+		return types.TimelockedGovProposalFixture(t, func(p *types.TimelockedGovProposal) {
+			p.ID, p.ExecuteTime = id, executeTime
+		})
+	}
+	past1, past2 := atTime(2, blockTime.Add(-time.Second)), atTime(1, blockTime)
+	future := atTime(3, blockTime.Add(time.Nanosecond))
+	all := []types.TimelockedGovProposal{future, past2, past1}
+
+	specs := map[string]struct {
+		src          []types.TimelockedGovProposal
+		max          int
+		expPopped    []uint64
+		expRemaining []uint64
+	}{
+		"height not reached": {
+			src: []types.TimelockedGovProposal{
+				future,
+				past2,
+				types.TimelockedGovProposalFixture(t, func(p *types.TimelockedGovProposal) {
+					p.ID, p.ExecuteTime, p.ExecuteHeight = 2, blockTime.Add(-time.Second), ctx.BlockHeight()+1
+				}),
+			},
+			max:          10,
+			expRemaining: []uint64{2, 1, 3},
+		},
+		"height reached": {
+			src: []types.TimelockedGovProposal{
+				future,
+				past2,
+				types.TimelockedGovProposalFixture(t, func(p *types.TimelockedGovProposal) {
+					p.ID, p.ExecuteTime, p.ExecuteHeight = 2, blockTime.Add(-time.Second), ctx.BlockHeight()
+				}),
+			},
+			max:          10,
+			expPopped:    []uint64{2, 1},
+			expRemaining: []uint64{3},
+		},
+		"all due": {
+			max:          10,
+			expPopped:    []uint64{2, 1},
+			expRemaining: []uint64{3},
+		},
+		"limited": {
+			max:          1,
+			expPopped:    []uint64{2},
+			expRemaining: []uint64{1, 3},
+		},
+		"zero": {
+			expRemaining: []uint64{2, 1, 3},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			src := all
+			if spec.src != nil {
+				src = spec.src
+			}
+			require.NoError(t, k.importTimelockedGovProposals(ctx, src, 4))
+			// when
+			got := k.PopDueTimelockedGovProposals(ctx, spec.max)
+			// then
+			var gotIDs []uint64
+			for _, p := range got {
+				gotIDs = append(gotIDs, p.ID)
+				assert.Nil(t, k.GetTimelockedGovProposal(ctx, p.ID))
+			}
+			assert.Equal(t, spec.expPopped, gotIDs)
+			var remaining []uint64
+			k.IterateTimelockedGovProposals(ctx, func(p types.TimelockedGovProposal) bool {
+				remaining = append(remaining, p.ID)
+				return false
+			})
+			assert.Equal(t, spec.expRemaining, remaining)
+		})
+	}
+}
+
+func TestExecuteTimelockedGovProposal(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := *keepers.TWasmKeeper
+	myKey := []byte("my-key")
+	var captured []govtypes.Content
+	k.govRouter = govtypes.NewRouter().
+		AddRoute(govtypes.RouterKey, func(ctx sdk.Context, content govtypes.Content) error {
+			captured = append(captured, content)
+			ctx.KVStore(k.storeKey).Set(myKey, []byte("value"))
+			ctx.EventManager().EmitEvent(sdk.NewEvent("testing"))
+			if content.GetTitle() == "fail" {
+				return errors.New("testing")
+			}
+			return nil
+		})
+
+	var storedParams *abci.ConsensusParams
+	k.SetConsensusParamsUpdater(ConsensusParamsStoreMock{
+		GetConsensusParamsFn: func(ctx sdk.Context) *abci.ConsensusParams {
+			return types.ConsensusParamsFixture()
+		},
+		StoreConsensusParamsFn: func(ctx sdk.Context, cp *abci.ConsensusParams) {
+			storedParams = cp
+		},
+	})
+
+	failingProposal, err := types.NewTimelockedGovProposal(1, RandomAddress(t), &govtypes.TextProposal{Title: "fail", Description: "bar"}, 1, time.Unix(1, 0).UTC(), 0)
+	require.NoError(t, err)
+	consensusParamsUpdate := func(update string) types.TimelockedGovProposal {
+		return types.NewTimelockedConsensusParamsUpdate(1, RandomAddress(t), []byte(update), 1, time.Unix(1, 0).UTC(), 0)
+	}
+
+	specs := map[string]struct {
+		src             types.TimelockedGovProposal
+		expErr          bool
+		expPersist      bool
+		expEvents       []string
+		expContent      bool
+		expStoredParams *abci.ConsensusParams
+	}{
+		"all good": {
+			src:        types.TimelockedGovProposalFixture(t),
+			expPersist: true,
+			expEvents:  []string{"testing", types.EventTypeExecuteGovProposal},
+			expContent: true,
+		},
+		"handler fails": {
+			src:        failingProposal,
+			expErr:     true,
+			expEvents:  []string{types.EventTypeExecuteGovProposal},
+			expContent: true,
+		},
+		"consensus params": {
+			src:       consensusParamsUpdate(`{"block":{"max_bytes":1}}`),
+			expEvents: []string{types.EventTypeExecuteGovProposal},
+			expStoredParams: types.ConsensusParamsFixture(func(c *abci.ConsensusParams) {
+				c.Block.MaxBytes = 1
+			}),
+		},
+		"invalid consensus params": {
+			src:       consensusParamsUpdate(`{"block":{"max_bytes":0}}`),
+			expErr:    true,
+			expEvents: []string{types.EventTypeExecuteGovProposal},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			captured, storedParams = nil, nil
+			ctx, _ := ctx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			// when
+			gotErr := k.ExecuteTimelockedGovProposal(ctx, spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+			} else {
+				require.NoError(t, gotErr)
+			}
+			if spec.expContent {
+				assert.Equal(t, []govtypes.Content{spec.src.ProposalContent()}, captured)
+			} else {
+				assert.Empty(t, captured)
+			}
+			assert.Equal(t, spec.expStoredParams, storedParams)
+			assert.Equal(t, spec.expPersist, ctx.KVStore(k.storeKey).Has(myKey))
+			var gotEvents []string
+			for _, e := range em.Events() {
+				gotEvents = append(gotEvents, e.Type)
+			}
+			assert.Equal(t, spec.expEvents, gotEvents)
+		})
+	}
+}
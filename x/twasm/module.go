@@ -6,7 +6,6 @@ import (
 	"math/rand"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
-	wasmcli "github.com/CosmWasm/wasmd/x/wasm/client/cli"
 	wasmrest "github.com/CosmWasm/wasmd/x/wasm/client/rest"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmsimulation "github.com/CosmWasm/wasmd/x/wasm/simulation"
@@ -60,7 +59,8 @@ func (AppModuleBasic) Name() string {
 // module.
 func (b AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(&types.GenesisState{
-		Params: wasmtypes.DefaultParams(),
+		Params:      wasmtypes.DefaultParams(),
+		TWasmParams: types.DefaultTWasmParams(),
 	})
 }
 
@@ -81,7 +81,7 @@ func (AppModuleBasic) RegisterRESTRoutes(cliCtx client.Context, rtr *mux.Router)
 
 // GetTxCmd returns the root tx command for the wasm module.
 func (b AppModuleBasic) GetTxCmd() *cobra.Command {
-	return wasmcli.GetTxCmd()
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd returns no root query command for the wasm module.
@@ -102,6 +102,7 @@ type AppModule struct {
 	validatorSetSource wasmkeeper.ValidatorSetSource
 	accountKeeper      wasmtypes.AccountKeeper // for simulation
 	bankKeeper         simulation.BankKeeper
+	privileges         *keeper.PrivilegeRegistry
 }
 
 // NewAppModule creates a new AppModule object
@@ -111,6 +112,7 @@ func NewAppModule(
 	validatorSetSource wasmkeeper.ValidatorSetSource,
 	ak wasmtypes.AccountKeeper,
 	bk simulation.BankKeeper,
+	privileges *keeper.PrivilegeRegistry,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic:     AppModuleBasic{},
@@ -119,13 +121,15 @@ func NewAppModule(
 		validatorSetSource: validatorSetSource,
 		accountKeeper:      ak,
 		bankKeeper:         bk,
+		privileges:         privileges,
 	}
 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	// wasm services
-	wasmtypes.RegisterMsgServer(cfg.MsgServer(), wasmkeeper.NewMsgServerImpl(wasmkeeper.NewDefaultPermissionKeeper(am.keeper)))
+	wasmtypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewPrivilegedMigrationMsgServer(am.keeper, wasmkeeper.NewMsgServerImpl(wasmkeeper.NewDefaultPermissionKeeper(am.keeper))))
 	wasmtypes.RegisterQueryServer(cfg.QueryServer(), keeper.WasmQuerier(am.keeper))
 }
 
@@ -133,8 +137,10 @@ func (am AppModule) LegacyQuerierHandler(amino *codec.LegacyAmino) sdk.Querier {
 	return wasmkeeper.NewLegacyQuerier(am.keeper, am.keeper.QueryGasLimit())
 }
 
-// RegisterInvariants registers the wasm module invariants.
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+// RegisterInvariants registers the twasm module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the wasm module.
 func (am AppModule) Route() sdk.Route {
@@ -168,12 +174,15 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // BeginBlock returns the begin blocker for the wasm module.
 func (am AppModule) BeginBlock(ctx sdk.Context, b abci.RequestBeginBlock) {
 	BeginBlocker(ctx, am.keeper, b)
+	NotifyPrivilegeExtensionsOnBeginBlock(ctx, am.keeper, am.privileges)
 }
 
 // EndBlock returns the end blocker for the wasm module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, b abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return EndBlocker(ctx, am.keeper)
+	updates := EndBlocker(ctx, am.keeper)
+	NotifyPrivilegeExtensions(ctx, am.keeper, am.privileges)
+	return updates
 }
 
 // GenerateGenesisState creates a randomized GenState of the bank module.
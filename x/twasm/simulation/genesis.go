@@ -19,6 +19,7 @@ func RandomizedGenState(simstate *module.SimulationState) {
 		GenMsgs:                     nil,
 		PrivilegedContractAddresses: nil,
 		PinnedCodeIDs:               nil,
+		TWasmParams:                 types.DefaultTWasmParams(),
 	}
 
 	simstate.GenState[wasmtypes.ModuleName] = simstate.Cdc.MustMarshalJSON(&twasmGenesis)
@@ -5,6 +5,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -13,7 +15,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	wasmtypes.RegisterLegacyAminoCodec(cdc)
 	cdc.RegisterConcrete(&PromoteToPrivilegedContractProposal{}, "twasm/PromoteToPrivilegedContractProposal", nil)
 	cdc.RegisterConcrete(&DemotePrivilegedContractProposal{}, "twasm/DemotePrivilegedContractProposal", nil)
+	cdc.RegisterConcrete(&SetPrivilegeCallbackOrderProposal{}, "twasm/SetPrivilegeCallbackOrderProposal", nil)
+	cdc.RegisterConcrete(&SetMintAllowanceProposal{}, "twasm/SetMintAllowanceProposal", nil)
 	cdc.RegisterConcrete(&PetriContractDetails{}, "twasm/PetriContractDetails", nil)
+	cdc.RegisterConcrete(&MsgGrantDelegator{}, "twasm/MsgGrantDelegator", nil)
+	cdc.RegisterConcrete(&MsgRevokeDelegator{}, "twasm/MsgRevokeDelegator", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -22,11 +28,24 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*govtypes.Content)(nil),
 		&PromoteToPrivilegedContractProposal{},
 		&DemotePrivilegedContractProposal{},
+		&SetPrivilegeCallbackOrderProposal{},
+		&SetMintAllowanceProposal{},
+	)
+	// the gov module is not used but text proposals from contracts can be stored in the timelock queue
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&govtypes.TextProposal{},
 	)
 	registry.RegisterImplementations(
 		(*wasmtypes.ContractInfoExtension)(nil),
 		&PetriContractDetails{},
 	)
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgGrantDelegator{},
+		&MsgRevokeDelegator{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 var (
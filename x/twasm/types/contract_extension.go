@@ -59,7 +59,12 @@ func (d PetriContractDetails) ValidateBasic() error {
 		}
 		unique[privilegeType] = struct{}{}
 	}
-	return nil
+	return sdkerrors.Wrap(validateCallbackGasLimits(d.CallbackGasLimits), "callback gas limits")
+}
+
+// CallbackGasLimit returns the contract specific gas limit for the given privilege type. Returns false when none is set.
+func (d PetriContractDetails) CallbackGasLimit(privilegeType PrivilegeType) (uint64, bool) {
+	return findCallbackGasLimit(d.CallbackGasLimits, privilegeType)
 }
 
 // ValidateBasic syntax checks
@@ -76,3 +81,39 @@ func (r RegisteredPrivilege) ValidateBasic() error {
 	}
 	return nil
 }
+
+// ValidateBasic syntax checks
+func (c CallbackGasLimit) ValidateBasic() error {
+	if PrivilegeTypeFrom(c.PrivilegeType) == nil {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "privilege type")
+	}
+	if c.GasLimit == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "gas limit")
+	}
+	return nil
+}
+
+// validateCallbackGasLimits ensures that all elements are valid and privilege types are unique
+func validateCallbackGasLimits(limits []CallbackGasLimit) error {
+	unique := make(map[string]struct{}, len(limits))
+	for i, v := range limits {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "gas limit %d", i)
+		}
+		if _, exists := unique[v.PrivilegeType]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "gas limit for %q", v.PrivilegeType)
+		}
+		unique[v.PrivilegeType] = struct{}{}
+	}
+	return nil
+}
+
+// findCallbackGasLimit returns the gas limit for the given type from the list or false when not found
+func findCallbackGasLimit(limits []CallbackGasLimit, privilegeType PrivilegeType) (uint64, bool) {
+	for _, v := range limits {
+		if v.PrivilegeType == privilegeType.String() {
+			return v.GasLimit, true
+		}
+	}
+	return 0, false
+}
@@ -31,6 +31,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // PetriContractDetails is a custom extension to the wasmd ContractInfo
 type PetriContractDetails struct {
 	RegisteredPrivileges []RegisteredPrivilege `protobuf:"bytes,1,rep,name=registered_privileges,json=registeredPrivileges,proto3" json:"registered_privileges"`
+	// CallbackGasLimits overwrite the default gas limits from the params for
+	// privileged callbacks to this contract
+	CallbackGasLimits []CallbackGasLimit `protobuf:"bytes,2,rep,name=callback_gas_limits,json=callbackGasLimits,proto3" json:"callback_gas_limits"`
 }
 
 func (m *PetriContractDetails) Reset()         { *m = PetriContractDetails{} }
@@ -115,9 +118,56 @@ func (m *RegisteredPrivilege) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegisteredPrivilege proto.InternalMessageInfo
 
+// CallbackGasLimit stores the max gas a privileged callback can consume
+type CallbackGasLimit struct {
+	// PrivilegeType name of the callback privilege
+	PrivilegeType string `protobuf:"bytes,1,opt,name=privilege_type,json=privilegeType,proto3" json:"privilege_type,omitempty"`
+	// GasLimit max gas for a single callback execution
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *CallbackGasLimit) Reset()         { *m = CallbackGasLimit{} }
+func (m *CallbackGasLimit) String() string { return proto.CompactTextString(m) }
+func (*CallbackGasLimit) ProtoMessage()    {}
+func (*CallbackGasLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbb24c05a9eda05e, []int{2}
+}
+
+func (m *CallbackGasLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CallbackGasLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackGasLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *CallbackGasLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackGasLimit.Merge(m, src)
+}
+
+func (m *CallbackGasLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CallbackGasLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackGasLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackGasLimit proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*PetriContractDetails)(nil), "confio.twasm.v1beta1.PetriContractDetails")
 	proto.RegisterType((*RegisteredPrivilege)(nil), "confio.twasm.v1beta1.RegisteredPrivilege")
+	proto.RegisterType((*CallbackGasLimit)(nil), "confio.twasm.v1beta1.CallbackGasLimit")
 }
 
 func init() {
@@ -125,28 +175,32 @@ func init() {
 }
 
 var fileDescriptor_cbb24c05a9eda05e = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbf, 0x4e, 0x02, 0x41,
-	0x10, 0xc6, 0x6f, 0xd5, 0x18, 0x3d, 0x83, 0xc5, 0x09, 0x09, 0x50, 0xac, 0x84, 0x44, 0x83, 0x05,
-	0xb7, 0x41, 0x3b, 0x4b, 0xd4, 0xc2, 0xc4, 0xc2, 0x5c, 0x28, 0x8c, 0xcd, 0xe5, 0x38, 0x86, 0x75,
-	0x13, 0xd8, 0xb9, 0xec, 0xae, 0x08, 0x6f, 0x61, 0xe9, 0x23, 0xf8, 0x00, 0x3e, 0x04, 0x25, 0xb1,
-	0xa2, 0x32, 0x72, 0xbc, 0x88, 0xe1, 0xfe, 0x35, 0xd2, 0xed, 0xec, 0xf7, 0x9b, 0xfd, 0x66, 0xe7,
-	0xb3, 0xdb, 0x21, 0xca, 0xa1, 0x40, 0x66, 0xde, 0x02, 0x3d, 0x66, 0x93, 0x4e, 0x1f, 0x4c, 0xd0,
-	0x61, 0x21, 0x4a, 0xa3, 0x82, 0xd0, 0xf8, 0x30, 0x35, 0x20, 0xb5, 0x40, 0xe9, 0x46, 0x0a, 0x0d,
-	0x3a, 0xe5, 0x14, 0x77, 0x13, 0xdc, 0xcd, 0xf0, 0x7a, 0x99, 0x23, 0xc7, 0x04, 0x60, 0x9b, 0x53,
-	0xca, 0xd6, 0x6b, 0x21, 0xea, 0x31, 0x6a, 0x3f, 0x15, 0xd2, 0x22, 0x95, 0x9a, 0x1f, 0xc4, 0xae,
-	0xf4, 0xb8, 0x0a, 0x06, 0x70, 0x93, 0x39, 0xdd, 0x82, 0x09, 0xc4, 0x48, 0x3b, 0x03, 0xbb, 0xa2,
-	0x80, 0x0b, 0x6d, 0x40, 0xc1, 0xc0, 0x8f, 0x94, 0x98, 0x88, 0x11, 0x70, 0xd0, 0x55, 0xd2, 0xd8,
-	0x6d, 0x1d, 0x5d, 0x5e, 0xb8, 0xdb, 0x06, 0x70, 0xbd, 0xa2, 0xe5, 0x31, 0xef, 0xe8, 0xee, 0xcd,
-	0x7f, 0x4e, 0x2d, 0xaf, 0xac, 0xfe, 0x4b, 0xfa, 0xba, 0xf6, 0xfd, 0xd5, 0xae, 0xe4, 0xd6, 0xf7,
-	0x72, 0x88, 0x77, 0xf9, 0x3f, 0x9b, 0x4f, 0xf6, 0xc9, 0x96, 0xd7, 0x9c, 0xba, 0x7d, 0x10, 0xa1,
-	0x16, 0x46, 0xa0, 0xac, 0x92, 0x06, 0x69, 0x95, 0xbc, 0xa2, 0x76, 0xce, 0xec, 0xe3, 0x62, 0x50,
-	0xdf, 0xcc, 0x22, 0xa8, 0xee, 0x34, 0x48, 0xeb, 0xd0, 0x2b, 0x15, 0xb7, 0xbd, 0x59, 0x04, 0xdd,
-	0x87, 0xf9, 0x8a, 0x5a, 0xcb, 0x15, 0x25, 0x9f, 0x31, 0x25, 0xf3, 0x98, 0x92, 0x45, 0x4c, 0xc9,
-	0x6f, 0x4c, 0xc9, 0xfb, 0x9a, 0x5a, 0x8b, 0x35, 0xb5, 0x96, 0x6b, 0x6a, 0x3d, 0x9f, 0x73, 0x61,
-	0x5e, 0x5e, 0xfb, 0x6e, 0x88, 0x63, 0x96, 0x67, 0x93, 0x6c, 0x8a, 0x4d, 0xb3, 0x90, 0x36, 0x0e,
-	0xba, 0xbf, 0x9f, 0x6c, 0xf2, 0xea, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x96, 0x22, 0xa5, 0xa2, 0xc1,
-	0x01, 0x00, 0x00,
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xdf, 0x8a, 0xda, 0x40,
+	0x14, 0xc6, 0x33, 0x56, 0x8a, 0x4e, 0xb1, 0xb4, 0x31, 0x42, 0xb4, 0x30, 0x15, 0xa1, 0xad, 0xbd,
+	0x30, 0xc1, 0xf6, 0xae, 0x97, 0xb5, 0xa5, 0x14, 0x5a, 0x90, 0x50, 0x4a, 0x29, 0x0b, 0x61, 0x32,
+	0x8e, 0xd9, 0x61, 0x93, 0x4c, 0x98, 0x19, 0x5d, 0x7d, 0x8b, 0x7d, 0x8c, 0x7d, 0x80, 0x7d, 0x08,
+	0x2f, 0x65, 0xaf, 0xbc, 0x5a, 0xd6, 0xf8, 0x0c, 0x7b, 0xbf, 0x98, 0x7f, 0x17, 0x6e, 0x6e, 0x42,
+	0xce, 0x39, 0xdf, 0x37, 0xbf, 0x73, 0xce, 0x0c, 0x1c, 0x11, 0x1e, 0xcd, 0x19, 0xb7, 0xd5, 0x25,
+	0x96, 0xa1, 0xbd, 0x1c, 0x7b, 0x54, 0xe1, 0xb1, 0x4d, 0x78, 0xa4, 0x04, 0x26, 0xca, 0xa5, 0x2b,
+	0x45, 0x23, 0xc9, 0x78, 0x64, 0xc5, 0x82, 0x2b, 0xae, 0x1b, 0x99, 0xdc, 0x4a, 0xe5, 0x56, 0x2e,
+	0xef, 0x19, 0x3e, 0xf7, 0x79, 0x2a, 0xb0, 0x8f, 0x7f, 0x99, 0xb6, 0xd7, 0x25, 0x5c, 0x86, 0x5c,
+	0xba, 0x59, 0x21, 0x0b, 0xb2, 0xd2, 0xe0, 0x01, 0x40, 0x63, 0x4a, 0x95, 0x60, 0x93, 0x1c, 0xf4,
+	0x8d, 0x2a, 0xcc, 0x02, 0xa9, 0xcf, 0x60, 0x47, 0x50, 0x9f, 0x49, 0x45, 0x05, 0x9d, 0xb9, 0xb1,
+	0x60, 0x4b, 0x16, 0x50, 0x9f, 0x4a, 0x13, 0xf4, 0x9f, 0x0d, 0x5f, 0x7c, 0xfa, 0x68, 0x55, 0xf1,
+	0x2d, 0xa7, 0xb4, 0x4c, 0x0b, 0xc7, 0xd7, 0xfa, 0xe6, 0xee, 0xad, 0xe6, 0x18, 0xe2, 0x69, 0x49,
+	0xea, 0x67, 0xb0, 0x4d, 0x70, 0x10, 0x78, 0x98, 0x5c, 0xb8, 0x3e, 0x96, 0x6e, 0xc0, 0x42, 0xa6,
+	0xa4, 0x59, 0x4b, 0x19, 0xef, 0xab, 0x19, 0x93, 0xdc, 0xf0, 0x03, 0xcb, 0x5f, 0x47, 0x79, 0x0e,
+	0x78, 0x4d, 0x4e, 0xf2, 0xf2, 0x4b, 0xf7, 0xf6, 0x66, 0xd4, 0x29, 0x06, 0xfb, 0x19, 0xcd, 0xf9,
+	0xf7, 0x62, 0x89, 0x83, 0x7f, 0xb0, 0x5d, 0xd1, 0xab, 0xde, 0x83, 0x8d, 0x98, 0x4b, 0xa6, 0x18,
+	0x8f, 0x4c, 0xd0, 0x07, 0xc3, 0x96, 0x53, 0xc6, 0xfa, 0x3b, 0xf8, 0xb2, 0x5c, 0x83, 0xab, 0xd6,
+	0x31, 0x35, 0x6b, 0x7d, 0x30, 0x6c, 0x3a, 0xad, 0x32, 0xfb, 0x67, 0x1d, 0xd3, 0xc1, 0x5f, 0xf8,
+	0xea, 0xb4, 0xc3, 0x0a, 0x2b, 0xa8, 0xb0, 0xea, 0x6f, 0x60, 0xb3, 0x5c, 0x42, 0x7a, 0x78, 0xdd,
+	0x69, 0xf8, 0xc5, 0x94, 0xbf, 0x37, 0x7b, 0xa4, 0xed, 0xf6, 0x08, 0x5c, 0x27, 0x08, 0x6c, 0x12,
+	0x04, 0xb6, 0x09, 0x02, 0xf7, 0x09, 0x02, 0x57, 0x07, 0xa4, 0x6d, 0x0f, 0x48, 0xdb, 0x1d, 0x90,
+	0xf6, 0xff, 0x83, 0xcf, 0xd4, 0xf9, 0xc2, 0xb3, 0x08, 0x0f, 0x6d, 0x1e, 0xcc, 0xe6, 0x0b, 0xb1,
+	0xc6, 0x76, 0xf6, 0x5d, 0xe5, 0x4f, 0xeb, 0xc8, 0x97, 0xde, 0xf3, 0xf4, 0xfe, 0x3f, 0x3f, 0x0e,
+	0x00, 0xb5, 0xf6, 0x70, 0x91, 0x77, 0x02, 0x00, 0x00,
 }
 
 func (this *PetriContractDetails) Equal(that interface{}) bool {
@@ -176,6 +230,14 @@ func (this *PetriContractDetails) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.CallbackGasLimits) != len(that1.CallbackGasLimits) {
+		return false
+	}
+	for i := range this.CallbackGasLimits {
+		if !this.CallbackGasLimits[i].Equal(&that1.CallbackGasLimits[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -207,6 +269,34 @@ func (this *RegisteredPrivilege) Equal(that interface{}) bool {
 	return true
 }
 
+func (this *CallbackGasLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CallbackGasLimit)
+	if !ok {
+		that2, ok := that.(CallbackGasLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PrivilegeType != that1.PrivilegeType {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+
 func (m *PetriContractDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -227,6 +317,20 @@ func (m *PetriContractDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CallbackGasLimits) > 0 {
+		for iNdEx := len(m.CallbackGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintContractExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.RegisteredPrivileges) > 0 {
 		for iNdEx := len(m.RegisteredPrivileges) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -279,6 +383,41 @@ func (m *RegisteredPrivilege) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CallbackGasLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackGasLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackGasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintContractExtension(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PrivilegeType) > 0 {
+		i -= len(m.PrivilegeType)
+		copy(dAtA[i:], m.PrivilegeType)
+		i = encodeVarintContractExtension(dAtA, i, uint64(len(m.PrivilegeType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintContractExtension(dAtA []byte, offset int, v uint64) int {
 	offset -= sovContractExtension(v)
 	base := offset
@@ -303,6 +442,12 @@ func (m *PetriContractDetails) Size() (n int) {
 			n += 1 + l + sovContractExtension(uint64(l))
 		}
 	}
+	if len(m.CallbackGasLimits) > 0 {
+		for _, e := range m.CallbackGasLimits {
+			l = e.Size()
+			n += 1 + l + sovContractExtension(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -322,6 +467,22 @@ func (m *RegisteredPrivilege) Size() (n int) {
 	return n
 }
 
+func (m *CallbackGasLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivilegeType)
+	if l > 0 {
+		n += 1 + l + sovContractExtension(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovContractExtension(uint64(m.GasLimit))
+	}
+	return n
+}
+
 func sovContractExtension(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -393,6 +554,40 @@ func (m *PetriContractDetails) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackGasLimits = append(m.CallbackGasLimits, CallbackGasLimit{})
+			if err := m.CallbackGasLimits[len(m.CallbackGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipContractExtension(dAtA[iNdEx:])
@@ -517,6 +712,108 @@ func (m *RegisteredPrivilege) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *CallbackGasLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackGasLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackGasLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipContractExtension(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -61,6 +61,35 @@ func TestPetriContractDetailsValidation(t *testing.T) {
 			}),
 			expErr: true,
 		},
+		"with callback gas limits": {
+			src: PetriContractDetailsFixture(t, func(d *PetriContractDetails) {
+				d.CallbackGasLimits = []CallbackGasLimit{
+					{PrivilegeType: "begin_blocker", GasLimit: 1},
+					{PrivilegeType: "end_blocker", GasLimit: math.MaxUint64},
+				}
+			}),
+		},
+		"duplicate callback gas limits": {
+			src: PetriContractDetailsFixture(t, func(d *PetriContractDetails) {
+				d.CallbackGasLimits = []CallbackGasLimit{
+					{PrivilegeType: "begin_blocker", GasLimit: 1},
+					{PrivilegeType: "begin_blocker", GasLimit: 2},
+				}
+			}),
+			expErr: true,
+		},
+		"unknown callback gas limit type": {
+			src: PetriContractDetailsFixture(t, func(d *PetriContractDetails) {
+				d.CallbackGasLimits = []CallbackGasLimit{{PrivilegeType: "unknown", GasLimit: 1}}
+			}),
+			expErr: true,
+		},
+		"empty callback gas limit": {
+			src: PetriContractDetailsFixture(t, func(d *PetriContractDetails) {
+				d.CallbackGasLimits = []CallbackGasLimit{{PrivilegeType: "begin_blocker"}}
+			}),
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
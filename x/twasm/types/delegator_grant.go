@@ -0,0 +1,52 @@
+package types
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic validates the delegator grant
+func (g DelegatorGrant) ValidateBasic() error {
+	staker, err := sdk.AccAddressFromBech32(g.Staker)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "staker: %s", err)
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(g.ContractAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "contract address: %s", err)
+	}
+	if staker.Equals(contractAddr) {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "staker must not be the contract")
+	}
+	if g.Expiration != nil && g.Expiration.UnixNano() <= 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "expiration must be after unix epoch")
+	}
+	return nil
+}
+
+// IsExpired returns true when the expiration is reached by the block
+func (g DelegatorGrant) IsExpired(ctx sdk.Context) bool {
+	return g.Expiration != nil && !g.Expiration.After(ctx.BlockTime())
+}
+
+type contextKey int
+
+const contextKeyTxSigners contextKey = iota
+
+// WithTxSigners stores the signers of the current tx in the context
+func WithTxSigners(ctx sdk.Context, signers []sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(contextKeyTxSigners, signers)
+}
+
+// TxSigners returns the signers of the current tx. Returns nil when not set, for example in the begin or end blocker.
+func TxSigners(ctx sdk.Context) []sdk.AccAddress {
+	if ctx.Context() == nil {
+		return nil
+	}
+	signers, ok := ctx.Value(contextKeyTxSigners).([]sdk.AccAddress)
+	if !ok {
+		return nil
+	}
+	return signers
+}
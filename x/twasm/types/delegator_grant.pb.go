@@ -0,0 +1,491 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: confio/twasm/v1beta1/delegator_grant.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DelegatorGrant authorizes a contract with the delegator privilege to
+// delegate the tokens of the staker account.
+type DelegatorGrant struct {
+	// Staker is the address of the account that owns the tokens
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// ContractAddress is the address of the authorized contract
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Expiration is the block time when the grant ends. Empty for no expiration.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *DelegatorGrant) Reset()         { *m = DelegatorGrant{} }
+func (m *DelegatorGrant) String() string { return proto.CompactTextString(m) }
+func (*DelegatorGrant) ProtoMessage()    {}
+func (*DelegatorGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8422d391de9c1b0, []int{0}
+}
+
+func (m *DelegatorGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *DelegatorGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *DelegatorGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorGrant.Merge(m, src)
+}
+
+func (m *DelegatorGrant) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *DelegatorGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorGrant proto.InternalMessageInfo
+
+func (m *DelegatorGrant) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *DelegatorGrant) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *DelegatorGrant) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DelegatorGrant)(nil), "confio.twasm.v1beta1.DelegatorGrant")
+}
+
+func init() {
+	proto.RegisterFile("confio/twasm/v1beta1/delegator_grant.proto", fileDescriptor_c8422d391de9c1b0)
+}
+
+var fileDescriptor_c8422d391de9c1b0 = []byte{
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x41, 0x4b, 0xfb, 0x40,
+	0x10, 0xc5, 0xbb, 0xff, 0x7f, 0x29, 0xb8, 0x82, 0x4a, 0x28, 0x52, 0x7a, 0xd8, 0x14, 0x2f, 0x56,
+	0x0f, 0xbb, 0x54, 0x6f, 0x9e, 0x6c, 0x11, 0xbc, 0x17, 0x4f, 0x5e, 0xca, 0x26, 0xd9, 0xac, 0xc1,
+	0x24, 0x13, 0x76, 0x27, 0xda, 0x7e, 0x8b, 0xde, 0xbd, 0xf8, 0x71, 0x3c, 0xf6, 0xe8, 0x4d, 0x49,
+	0x2e, 0x7e, 0x0c, 0x69, 0x36, 0x01, 0x2f, 0xc3, 0xbc, 0xc7, 0x6f, 0x78, 0x8f, 0xa1, 0x97, 0x21,
+	0xe4, 0x71, 0x02, 0x02, 0x5f, 0xa5, 0xcd, 0xc4, 0xcb, 0x2c, 0x50, 0x28, 0x67, 0x22, 0x52, 0xa9,
+	0xd2, 0x12, 0xc1, 0xac, 0xb4, 0x91, 0x39, 0xf2, 0xc2, 0x00, 0x82, 0x37, 0x74, 0x2c, 0x6f, 0x58,
+	0xde, 0xb2, 0xe3, 0xa1, 0x06, 0x0d, 0x0d, 0x20, 0xf6, 0x9b, 0x63, 0xc7, 0xbe, 0x06, 0xd0, 0xa9,
+	0x12, 0x8d, 0x0a, 0xca, 0x58, 0x60, 0x92, 0x29, 0x8b, 0x32, 0x2b, 0x1c, 0x70, 0xf6, 0x46, 0xe8,
+	0xd1, 0x5d, 0x17, 0x73, 0xbf, 0x4f, 0xf1, 0x4e, 0xe9, 0xc0, 0xa2, 0x7c, 0x56, 0x66, 0x44, 0x26,
+	0x64, 0x7a, 0xb0, 0x6c, 0x95, 0x77, 0x41, 0x4f, 0x42, 0xc8, 0xd1, 0xc8, 0x10, 0x57, 0x32, 0x8a,
+	0x8c, 0xb2, 0x76, 0xf4, 0xaf, 0x21, 0x8e, 0x3b, 0x7f, 0xee, 0x6c, 0xef, 0x96, 0x52, 0xb5, 0x2e,
+	0x12, 0x23, 0x31, 0x81, 0x7c, 0xf4, 0x7f, 0x42, 0xa6, 0x87, 0x57, 0x63, 0xee, 0xba, 0xf0, 0xae,
+	0x0b, 0x7f, 0xe8, 0xba, 0x2c, 0xfa, 0xdb, 0x2f, 0x9f, 0x2c, 0xff, 0xdc, 0xdc, 0xf4, 0x7f, 0xde,
+	0x7d, 0xb2, 0x98, 0x7f, 0x54, 0x8c, 0xec, 0x2a, 0x46, 0xbe, 0x2b, 0x46, 0xb6, 0x35, 0xeb, 0xed,
+	0x6a, 0xd6, 0xfb, 0xac, 0x59, 0xef, 0xf1, 0x5c, 0x27, 0xf8, 0x54, 0x06, 0x3c, 0x84, 0x4c, 0x40,
+	0x1a, 0xc5, 0xa5, 0xd9, 0x48, 0xe1, 0xe6, 0xba, 0xfd, 0x22, 0x6e, 0x0a, 0x65, 0x83, 0x41, 0x13,
+	0x77, 0xfd, 0x3b, 0x00, 0x21, 0xa3, 0x6d, 0x43, 0x62, 0x01, 0x00, 0x00,
+}
+
+func (this *DelegatorGrant) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorGrant)
+	if !ok {
+		that2, ok := that.(DelegatorGrant)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Staker != that1.Staker {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if that1.Expiration == nil {
+		if this.Expiration != nil {
+			return false
+		}
+	} else if !this.Expiration.Equal(*that1.Expiration) {
+		return false
+	}
+	return true
+}
+
+func (m *DelegatorGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintDelegatorGrant(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintDelegatorGrant(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintDelegatorGrant(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelegatorGrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelegatorGrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *DelegatorGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovDelegatorGrant(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegatorGrant(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovDelegatorGrant(uint64(l))
+	}
+	return n
+}
+
+func sovDelegatorGrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozDelegatorGrant(x uint64) (n int) {
+	return sovDelegatorGrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *DelegatorGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegatorGrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegatorGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegatorGrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegatorGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegatorGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegatorGrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegatorGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegatorGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegatorGrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegatorGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegatorGrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegatorGrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipDelegatorGrant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDelegatorGrant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelegatorGrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelegatorGrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDelegatorGrant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDelegatorGrant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDelegatorGrant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDelegatorGrant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDelegatorGrant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDelegatorGrant = fmt.Errorf("proto: unexpected end of group")
+)
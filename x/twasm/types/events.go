@@ -1,17 +1,41 @@
 package types
 
 const (
-	EventTypeSetPrivileged     = "set_privileged_contract"
-	EventTypeUnsetPrivileged   = "unset_privileged_contract"
-	EventTypeRegisterPrivilege = "register_privilege"
-	EventTypeReleasePrivilege  = "release_privilege"
-	EventTypeMintTokens        = "mint"
-	EventTypeDelegateTokens    = "delegate"
-	EventTypeUndelegateTokens  = "undelegate"
+	EventTypeSetPrivileged      = "set_privileged_contract"
+	EventTypeUnsetPrivileged    = "unset_privileged_contract"
+	EventTypeMigratePrivileged  = "migrate_privileged_contract"
+	EventTypeRegisterPrivilege  = "register_privilege"
+	EventTypeReleasePrivilege   = "release_privilege"
+	EventTypeMintTokens         = "mint"
+	EventTypeBurnTokens         = "burn_tokens"
+	EventTypeDelegateTokens     = "delegate"
+	EventTypeUndelegateTokens   = "undelegate"
+	EventTypeCallbackOutOfGas   = "privileged_callback_out_of_gas"
+	EventTypeCallbackFailed     = "privileged_callback_failed"
+	EventTypeQuarantined        = "privilege_quarantined"
+	EventTypeSetCallbackOrder   = "set_privilege_callback_order"
+	EventTypeSetMintAllowance   = "set_mint_allowance"
+	EventTypeScheduleCallback   = "schedule_callback"
+	EventTypeCancelCallback     = "cancel_callback"
+	EventTypeQueueGovProposal   = "queue_gov_proposal"
+	EventTypeExecuteGovProposal = "execute_timelocked_gov_proposal"
+	EventTypeVetoGovProposal    = "veto_gov_proposal"
+	EventTypeGrantDelegator     = "grant_delegator"
+	EventTypeRevokeDelegator    = "revoke_delegator"
 )
 
 const ( // event attributes
-	AttributeKeyCallbackType = "privilege_type"
-	AttributeKeyRecipient    = "recipient"
-	AttributeKeySender       = "sender"
+	AttributeKeyCallbackType  = "privilege_type"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeySender        = "sender"
+	AttributeKeyGasLimit      = "gas_limit"
+	AttributeKeyFailures      = "failures"
+	AttributeKeyCallbackID    = "callback_id"
+	AttributeKeyProposalID    = "proposal_id"
+	AttributeKeyProposalType  = "proposal_type"
+	AttributeKeyExecuteTime   = "execute_time"
+	AttributeKeyExecuteHeight = "execute_height"
+	AttributeKeySuccess       = "success"
+	AttributeKeyStaker        = "staker"
+	AttributeKeyOldCodeID     = "old_code_id"
 )
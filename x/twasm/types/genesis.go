@@ -16,6 +16,9 @@ func (g GenesisState) ValidateBasic() error {
 	if err := wasmState.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "wasm")
 	}
+	if err := g.TWasmParams.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "twasm params")
+	}
 	for _, c := range wasmState.Contracts {
 		if c.ContractInfo.Extension != nil {
 			if furyaExtType != c.ContractInfo.Extension.TypeUrl {
@@ -24,6 +27,14 @@ func (g GenesisState) ValidateBasic() error {
 		}
 	}
 
+	for _, c := range g.Contracts {
+		if m := c.GetCustomModel(); m != nil {
+			if err := m.ValidateBasic(); err != nil {
+				return sdkerrors.Wrapf(err, "custom model of contract %s", c.ContractAddress)
+			}
+		}
+	}
+
 	uniqueAddr := make(map[string]struct{}, len(g.PrivilegedContractAddresses))
 	for i, a := range g.PrivilegedContractAddresses {
 		if _, err := sdk.AccAddressFromBech32(a); err != nil {
@@ -59,6 +70,65 @@ func (g GenesisState) ValidateBasic() error {
 		return sdkerrors.Wrapf(wasmtypes.ErrInvalidGenesis, "%d privileged contract addresses not found in genesis contract addresses", len(uniqueAddr))
 	}
 
+	uniqueAllowances := make(map[string]struct{}, len(g.MintAllowances))
+	for _, a := range g.MintAllowances {
+		if err := a.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "mint allowance %s", a.ContractAddress)
+		}
+		if _, exists := uniqueAllowances[a.ContractAddress]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "mint allowance %s", a.ContractAddress)
+		}
+		uniqueAllowances[a.ContractAddress] = struct{}{}
+	}
+	uniqueUsages := make(map[string]struct{}, len(g.MintUsages))
+	for _, u := range g.MintUsages {
+		if err := u.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "mint usage %s", u.ContractAddress)
+		}
+		if _, exists := uniqueUsages[u.ContractAddress+u.Denom]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "mint usage %s, %s", u.ContractAddress, u.Denom)
+		}
+		uniqueUsages[u.ContractAddress+u.Denom] = struct{}{}
+	}
+
+	uniqueCallbacks := make(map[[2]string]struct{}, len(g.ScheduledCallbacks))
+	for _, c := range g.ScheduledCallbacks {
+		if err := c.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "scheduled callback %s, %s", c.ContractAddress, c.ID)
+		}
+		key := [2]string{c.ContractAddress, c.ID}
+		if _, exists := uniqueCallbacks[key]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "scheduled callback %s, %s", c.ContractAddress, c.ID)
+		}
+		uniqueCallbacks[key] = struct{}{}
+	}
+
+	uniqueProposals := make(map[uint64]struct{}, len(g.TimelockedGovProposals))
+	for _, p := range g.TimelockedGovProposals {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "timelocked gov proposal %d", p.ID)
+		}
+		if _, exists := uniqueProposals[p.ID]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "timelocked gov proposal %d", p.ID)
+		}
+		if p.ID >= g.TimelockedGovProposalSequence {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "timelocked gov proposal %d must be lower than sequence", p.ID)
+		}
+		uniqueProposals[p.ID] = struct{}{}
+	}
+
+	uniqueGrants := make(map[[2]string]struct{}, len(g.DelegatorGrants))
+	for _, d := range g.DelegatorGrants {
+		if err := d.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "delegator grant %s, %s", d.Staker, d.ContractAddress)
+		}
+		key := [2]string{d.Staker, d.ContractAddress}
+		if _, exists := uniqueGrants[key]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "delegator grant %s, %s", d.Staker, d.ContractAddress)
+		}
+		uniqueGrants[key] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -86,6 +156,19 @@ func (g GenesisState) RawWasmState() wasmtypes.GenesisState {
 	}
 }
 
+// ValidateBasic checks that either a single import message or the chunks of a paginated import are set
+func (m CustomModel) ValidateBasic() error {
+	if len(m.Msg) != 0 && len(m.Chunks) != 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "msg and chunks must not both be set")
+	}
+	for i, c := range m.Chunks {
+		if err := c.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "chunk %d", i)
+		}
+	}
+	return nil
+}
+
 var _ codectypes.UnpackInterfacesMessage = GenesisState{}
 
 // UnpackInterfaces implements codectypes.UnpackInterfaces
@@ -95,6 +178,11 @@ func (g GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, v := range g.TimelockedGovProposals {
+		if err := v.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
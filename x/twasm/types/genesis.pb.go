@@ -46,6 +46,20 @@ type GenesisState struct {
 	PrivilegedContractAddresses []string `protobuf:"bytes,6,rep,name=privileged_contract_addresses,json=privilegedContractAddresses,proto3" json:"privileged_contract_addresses,omitempty"`
 	// PinnedCodeIDs has codeInfo ids for wasm codes that are pinned in cache
 	PinnedCodeIDs []uint64 `protobuf:"varint,7,rep,packed,name=pinned_code_ids,json=pinnedCodeIds,proto3" json:"pinned_code_ids,omitempty"`
+	// TWasmParams twasm specific params
+	TWasmParams TWasmParams `protobuf:"bytes,8,opt,name=twasm_params,json=twasmParams,proto3" json:"twasm_params"`
+	// MintAllowances restrictions for minting contracts
+	MintAllowances []MintAllowance `protobuf:"bytes,9,rep,name=mint_allowances,json=mintAllowances,proto3" json:"mint_allowances,omitempty"`
+	// MintUsages tokens minted by contracts with an allowance
+	MintUsages []MintUsage `protobuf:"bytes,10,rep,name=mint_usages,json=mintUsages,proto3" json:"mint_usages,omitempty"`
+	// ScheduledCallbacks pending contract callbacks
+	ScheduledCallbacks []ScheduledCallback `protobuf:"bytes,11,rep,name=scheduled_callbacks,json=scheduledCallbacks,proto3" json:"scheduled_callbacks,omitempty"`
+	// TimelockedGovProposals queued gov proposals from privileged contracts
+	TimelockedGovProposals []TimelockedGovProposal `protobuf:"bytes,12,rep,name=timelocked_gov_proposals,json=timelockedGovProposals,proto3" json:"timelocked_gov_proposals,omitempty"`
+	// TimelockedGovProposalSequence is the next id for a queued gov proposal
+	TimelockedGovProposalSequence uint64 `protobuf:"varint,13,opt,name=timelocked_gov_proposal_sequence,json=timelockedGovProposalSequence,proto3" json:"timelocked_gov_proposal_sequence,omitempty"`
+	// DelegatorGrants authorizations of contracts to delegate staker tokens
+	DelegatorGrants []DelegatorGrant `protobuf:"bytes,14,rep,name=delegator_grants,json=delegatorGrants,proto3" json:"delegator_grants,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -135,6 +149,55 @@ func (m *GenesisState) GetPinnedCodeIDs() []uint64 {
 	return nil
 }
 
+func (m *GenesisState) GetTWasmParams() TWasmParams {
+	if m != nil {
+		return m.TWasmParams
+	}
+	return TWasmParams{}
+}
+
+func (m *GenesisState) GetMintAllowances() []MintAllowance {
+	if m != nil {
+		return m.MintAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintUsages() []MintUsage {
+	if m != nil {
+		return m.MintUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledCallbacks() []ScheduledCallback {
+	if m != nil {
+		return m.ScheduledCallbacks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockedGovProposals() []TimelockedGovProposal {
+	if m != nil {
+		return m.TimelockedGovProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockedGovProposalSequence() uint64 {
+	if m != nil {
+		return m.TimelockedGovProposalSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDelegatorGrants() []DelegatorGrant {
+	if m != nil {
+		return m.DelegatorGrants
+	}
+	return nil
+}
+
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractAddress string             `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
@@ -295,10 +358,14 @@ func (m *KVModel) GetModels() []types.Model {
 }
 
 // CustomModel contains the raw json data for a contract to seed its state on
-// import
+// import. Either msg for a single import or chunks for a paginated import is
+// set.
 type CustomModel struct {
 	// Msg json encoded message to be passed to the contract on import
 	Msg github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,5,opt,name=msg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"msg,omitempty"`
+	// Chunks json encoded messages to be passed to the contract one by one on
+	// paginated import
+	Chunks []github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,6,rep,name=chunks,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"chunks,omitempty"`
 }
 
 func (m *CustomModel) Reset()         { *m = CustomModel{} }
@@ -346,6 +413,13 @@ func (m *CustomModel) GetMsg() github_com_CosmWasm_wasmd_x_wasm_types.RawContrac
 	return nil
 }
 
+func (m *CustomModel) GetChunks() []github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "confio.twasm.v1beta1.GenesisState")
 	proto.RegisterType((*Contract)(nil), "confio.twasm.v1beta1.Contract")
@@ -358,49 +432,69 @@ func init() {
 }
 
 var fileDescriptor_89c4cd47eb0533ed = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x5f, 0x4f, 0xd3, 0x50,
-	0x14, 0xc0, 0x37, 0x36, 0x36, 0x76, 0x37, 0x84, 0x5c, 0x89, 0x94, 0x21, 0xdd, 0xdc, 0x03, 0xce,
-	0x68, 0xda, 0x80, 0xd1, 0x44, 0x13, 0x13, 0x2c, 0xfe, 0x23, 0x86, 0x48, 0x46, 0xc4, 0x68, 0xa2,
-	0x4b, 0x77, 0x7b, 0xb9, 0x36, 0xd0, 0xde, 0xba, 0x73, 0x19, 0xf0, 0x2d, 0x7c, 0xf2, 0x33, 0xf1,
-	0xc8, 0xa3, 0x4f, 0x8b, 0x19, 0x0f, 0x26, 0x7c, 0x04, 0x9f, 0x4c, 0x6f, 0x6f, 0xbb, 0x42, 0xa7,
-	0x6f, 0x6d, 0xcf, 0xef, 0xfc, 0xce, 0xbd, 0x67, 0x67, 0x07, 0xb5, 0x08, 0xf7, 0xf7, 0x5d, 0x6e,
-	0x8a, 0x63, 0x1b, 0x3c, 0x73, 0xb0, 0xd6, 0xa3, 0xc2, 0x5e, 0x33, 0x19, 0xf5, 0x29, 0xb8, 0x60,
-	0x04, 0x7d, 0x2e, 0x38, 0x5e, 0x88, 0x18, 0x43, 0x32, 0x86, 0x62, 0xea, 0x0b, 0x8c, 0x33, 0x2e,
-	0x01, 0x33, 0x7c, 0x8a, 0xd8, 0xba, 0x4e, 0x38, 0x78, 0x1c, 0xcc, 0x9e, 0x0d, 0x34, 0xd1, 0x11,
-	0xee, 0xfa, 0xe9, 0xb8, 0xac, 0xa5, 0x0a, 0x5e, 0xad, 0x55, 0xbf, 0x9d, 0x89, 0x8b, 0xd3, 0x80,
-	0xc6, 0xd1, 0xa5, 0x6c, 0xf4, 0x24, 0x0a, 0xb5, 0x7e, 0x17, 0x51, 0xed, 0x75, 0xa4, 0xda, 0x15,
-	0xb6, 0xa0, 0xf8, 0x31, 0x2a, 0x05, 0x76, 0xdf, 0xf6, 0x40, 0xcb, 0x37, 0xf3, 0xed, 0xea, 0xba,
-	0x66, 0xc4, 0xc9, 0x86, 0xba, 0x87, 0xb1, 0x23, 0xe3, 0x56, 0xf1, 0x6c, 0xd8, 0xc8, 0x75, 0x14,
-	0x8d, 0x5f, 0xa2, 0x69, 0xc2, 0x1d, 0x0a, 0xda, 0x54, 0xb3, 0xd0, 0xae, 0xae, 0xdf, 0xca, 0xa6,
-	0x6d, 0x72, 0x87, 0x5a, 0x8b, 0x61, 0xd2, 0xe5, 0xb0, 0x31, 0x27, 0xe1, 0x07, 0xdc, 0x73, 0x05,
-	0xf5, 0x02, 0x71, 0xda, 0x89, 0xb2, 0xf1, 0x47, 0x54, 0x21, 0xdc, 0x17, 0x7d, 0x9b, 0x08, 0xd0,
-	0x0a, 0x52, 0xa5, 0x1b, 0x93, 0x1a, 0x69, 0x6c, 0x2a, 0xcc, 0x5a, 0x56, 0xca, 0x9b, 0x49, 0x62,
-	0x4a, 0x3b, 0xb6, 0xe1, 0xf7, 0xa8, 0x02, 0xf4, 0xdb, 0x11, 0xf5, 0x09, 0x05, 0xad, 0x28, 0xd5,
-	0xf5, 0xec, 0x29, 0x77, 0x15, 0x32, 0xd6, 0x26, 0x49, 0x69, 0x6d, 0xf2, 0x11, 0x7f, 0x46, 0x33,
-	0x8c, 0xfa, 0x5d, 0x0f, 0x18, 0x68, 0xd3, 0xd2, 0xba, 0x9a, 0xb5, 0xa6, 0x5b, 0x1c, 0xbe, 0x6c,
-	0x03, 0x03, 0xab, 0xae, 0x2a, 0xe0, 0x38, 0x3f, 0x55, 0xa0, 0xcc, 0x22, 0x08, 0x73, 0xb4, 0x12,
-	0xf4, 0xdd, 0x81, 0x7b, 0x48, 0x19, 0x75, 0xba, 0xf1, 0x6d, 0xba, 0xb6, 0xe3, 0xf4, 0x29, 0x00,
-	0x05, 0xad, 0xd4, 0x2c, 0xb4, 0x2b, 0xd6, 0xfd, 0xcb, 0x61, 0xe3, 0xee, 0x7f, 0xc1, 0x94, 0x7c,
-	0x79, 0x0c, 0xc6, 0x5d, 0x7c, 0x1e, 0x63, 0x78, 0x0f, 0xcd, 0x05, 0xae, 0xef, 0x4b, 0x87, 0x43,
-	0xbb, 0xae, 0x03, 0x5a, 0xb9, 0x59, 0x68, 0x17, 0x2d, 0x63, 0x34, 0x6c, 0xcc, 0xee, 0xc8, 0x50,
-	0xf8, 0x53, 0x6e, 0xbd, 0x80, 0xcb, 0x61, 0x63, 0xe9, 0x1a, 0x9b, 0xaa, 0x32, 0x1b, 0x8c, 0x59,
-	0x07, 0x5a, 0x3f, 0xa6, 0xd0, 0x4c, 0x5c, 0x0d, 0xdf, 0x43, 0xf3, 0xd7, 0x4f, 0x28, 0xe7, 0xad,
-	0xd2, 0x99, 0x23, 0x57, 0x4f, 0x84, 0xb7, 0xd0, 0x6c, 0x82, 0xba, 0xfe, 0x3e, 0xd7, 0xa6, 0xe4,
-	0x5c, 0xea, 0x93, 0x06, 0x2c, 0xc2, 0xb6, 0xfc, 0x7d, 0xae, 0xa6, 0xb3, 0x46, 0x52, 0xdf, 0xf0,
-	0x53, 0x34, 0x73, 0x30, 0xe8, 0x7a, 0xdc, 0xa1, 0x87, 0x5a, 0x41, 0x5a, 0x56, 0x26, 0xcf, 0xd6,
-	0xdb, 0xbd, 0xed, 0x10, 0x7a, 0x93, 0xeb, 0x94, 0x0f, 0x06, 0xf2, 0x11, 0xbf, 0x42, 0x35, 0x72,
-	0x04, 0x82, 0x7b, 0x2a, 0xbf, 0x28, 0xf3, 0xef, 0xfc, 0x63, 0x36, 0x25, 0x19, 0x3b, 0xaa, 0x64,
-	0xfc, 0x6a, 0xcd, 0xa3, 0x1b, 0xc9, 0x75, 0x20, 0x1c, 0x87, 0xd6, 0x06, 0x2a, 0xab, 0x7a, 0xf8,
-	0x11, 0x2a, 0x49, 0x7b, 0xd8, 0x8c, 0x70, 0x92, 0x16, 0xb3, 0x97, 0x8c, 0x2c, 0xea, 0xbf, 0x17,
-	0xc1, 0xad, 0x2f, 0xa8, 0x9a, 0xaa, 0x88, 0xdf, 0xa1, 0x82, 0x07, 0x4c, 0x9b, 0x6e, 0xe6, 0xdb,
-	0x35, 0xeb, 0xd9, 0x9f, 0x61, 0xe3, 0x09, 0x73, 0xc5, 0xd7, 0xa3, 0x9e, 0x41, 0xb8, 0x67, 0x6e,
-	0x72, 0xf0, 0x3e, 0xc4, 0xab, 0xc0, 0x31, 0x4f, 0xa2, 0x95, 0x10, 0x6d, 0x8b, 0x8e, 0x7d, 0x1c,
-	0xf7, 0x70, 0x9b, 0x02, 0xd8, 0x8c, 0x76, 0x42, 0x93, 0xb5, 0x71, 0x36, 0xd2, 0xf3, 0xe7, 0x23,
-	0x3d, 0xff, 0x6b, 0xa4, 0xe7, 0xbf, 0x5f, 0xe8, 0xb9, 0xf3, 0x0b, 0x3d, 0xf7, 0xf3, 0x42, 0xcf,
-	0x7d, 0x5a, 0x4d, 0x99, 0xe3, 0x95, 0xc8, 0xfa, 0xb6, 0x43, 0xcd, 0x13, 0xb5, 0x1b, 0xa5, 0xb9,
-	0x57, 0x92, 0xdb, 0xe6, 0xe1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x74, 0x37, 0x09, 0x76, 0x38,
-	0x05, 0x00, 0x00,
+	// 984 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4f, 0x6f, 0xe3, 0x44,
+	0x18, 0xc6, 0xeb, 0x4d, 0xff, 0x4e, 0xd2, 0xa6, 0x9a, 0x5d, 0x76, 0xdd, 0x2c, 0x89, 0xd3, 0x14,
+	0x68, 0xe8, 0x22, 0x47, 0xbb, 0x08, 0x24, 0x90, 0x90, 0xa8, 0xb3, 0x50, 0x2a, 0x54, 0x51, 0xa5,
+	0x74, 0x57, 0x20, 0x21, 0x6b, 0x62, 0x4f, 0x5d, 0x2b, 0xb6, 0x27, 0xe4, 0x9d, 0x24, 0xed, 0x89,
+	0xaf, 0x00, 0x17, 0xbe, 0x02, 0x9f, 0x80, 0xef, 0xb0, 0xc7, 0x3d, 0x72, 0x8a, 0x50, 0x7a, 0xcb,
+	0x47, 0xe0, 0x84, 0x3c, 0x9e, 0x49, 0xdc, 0xc4, 0x2d, 0x07, 0x2e, 0x6d, 0xe2, 0xf9, 0x3d, 0xcf,
+	0x33, 0x33, 0xef, 0xcc, 0x1b, 0xa3, 0x9a, 0xc3, 0xa2, 0x0b, 0x9f, 0x35, 0xf8, 0x90, 0x40, 0xd8,
+	0x18, 0x3c, 0x6f, 0x53, 0x4e, 0x9e, 0x37, 0x3c, 0x1a, 0x51, 0xf0, 0xc1, 0xec, 0xf6, 0x18, 0x67,
+	0xf8, 0x51, 0xc2, 0x98, 0x82, 0x31, 0x25, 0x53, 0x7a, 0xe4, 0x31, 0x8f, 0x09, 0xa0, 0x11, 0x7f,
+	0x4a, 0xd8, 0x52, 0xc5, 0x61, 0x10, 0x32, 0x68, 0xb4, 0x09, 0xd0, 0xa9, 0x9d, 0xc3, 0xfc, 0x28,
+	0x3d, 0x2e, 0xb2, 0x64, 0xe0, 0xed, 0xac, 0xd2, 0xbb, 0x0b, 0xe3, 0xfc, 0xba, 0x4b, 0xd5, 0xe8,
+	0xce, 0xe2, 0xe8, 0x95, 0x1c, 0xda, 0xcd, 0x5c, 0x48, 0x97, 0xf4, 0x48, 0xa8, 0xd4, 0x46, 0x26,
+	0x12, 0xfa, 0x11, 0x97, 0xc0, 0x5e, 0x26, 0x00, 0xce, 0x25, 0x75, 0xfb, 0x01, 0xbd, 0x17, 0xe2,
+	0x7e, 0x48, 0x03, 0xe6, 0x74, 0x24, 0x74, 0x90, 0x09, 0xb9, 0x34, 0xa0, 0x1e, 0xe1, 0xac, 0x67,
+	0x7b, 0x3d, 0xa2, 0x52, 0x6b, 0x7f, 0xe4, 0x51, 0xe1, 0x28, 0xd9, 0x84, 0x33, 0x4e, 0x38, 0xc5,
+	0x9f, 0xa2, 0xd5, 0x64, 0xde, 0xba, 0x56, 0xd5, 0xea, 0xf9, 0x17, 0xba, 0xa9, 0x96, 0x6d, 0xca,
+	0x0a, 0x98, 0xa7, 0x62, 0xdc, 0x5a, 0x7e, 0x33, 0x32, 0x96, 0x5a, 0x92, 0xc6, 0x5f, 0xa1, 0x15,
+	0x87, 0xb9, 0x14, 0xf4, 0x07, 0xd5, 0x5c, 0x3d, 0xff, 0xe2, 0xf1, 0xa2, 0xac, 0xc9, 0x5c, 0x6a,
+	0x3d, 0x89, 0x45, 0x93, 0x91, 0x51, 0x14, 0xf0, 0x47, 0x2c, 0xf4, 0x39, 0x0d, 0xbb, 0xfc, 0xba,
+	0x95, 0xa8, 0xf1, 0x0f, 0x68, 0xc3, 0x61, 0x11, 0xef, 0x11, 0x87, 0x83, 0x9e, 0x13, 0x56, 0x15,
+	0x33, 0xeb, 0x08, 0x98, 0x4d, 0x89, 0x59, 0x4f, 0xa5, 0xe5, 0xc3, 0xa9, 0x30, 0x65, 0x3b, 0x73,
+	0xc3, 0xe7, 0x68, 0x03, 0xe8, 0xcf, 0x7d, 0x1a, 0x39, 0x14, 0xf4, 0x65, 0x61, 0x5d, 0x5a, 0x9c,
+	0xe5, 0x99, 0x44, 0x66, 0xb6, 0x53, 0x51, 0xda, 0x76, 0xfa, 0x10, 0xff, 0x84, 0xd6, 0x3d, 0x1a,
+	0xd9, 0x21, 0x78, 0xa0, 0xaf, 0x08, 0xd7, 0x0f, 0x16, 0x5d, 0xd3, 0x5b, 0x1c, 0x7f, 0x39, 0x01,
+	0x0f, 0xac, 0x92, 0x4c, 0xc0, 0x4a, 0x9f, 0x0a, 0x58, 0xf3, 0x12, 0x08, 0x33, 0x54, 0xee, 0xf6,
+	0xfc, 0x81, 0x1f, 0x50, 0x8f, 0xba, 0xb6, 0x5a, 0x8d, 0x4d, 0x5c, 0xb7, 0x47, 0x01, 0x28, 0xe8,
+	0xab, 0xd5, 0x5c, 0x7d, 0xc3, 0x7a, 0x36, 0x19, 0x19, 0xfb, 0xf7, 0x82, 0x29, 0xf3, 0xa7, 0x33,
+	0x50, 0xed, 0xe2, 0xa1, 0xc2, 0xf0, 0x2b, 0x54, 0xec, 0xfa, 0x51, 0x24, 0x3c, 0x5c, 0x6a, 0xfb,
+	0x2e, 0xe8, 0x6b, 0xd5, 0x5c, 0x7d, 0xd9, 0x32, 0xc7, 0x23, 0x63, 0xf3, 0x54, 0x0c, 0xc5, 0xa5,
+	0x3c, 0x7e, 0x09, 0x93, 0x91, 0xb1, 0x33, 0xc7, 0xa6, 0x52, 0x36, 0xbb, 0x33, 0xd6, 0x05, 0xec,
+	0xa3, 0x82, 0x28, 0xa0, 0x2d, 0x8f, 0xd7, 0xba, 0x38, 0x5e, 0xbb, 0xd9, 0xc5, 0xfd, 0xfe, 0x35,
+	0x81, 0x50, 0x9e, 0xb3, 0xbd, 0x78, 0x9b, 0xc6, 0x23, 0x23, 0x9f, 0x7a, 0x38, 0x19, 0x19, 0xb7,
+	0xdc, 0x5a, 0x79, 0x3e, 0x9c, 0x0e, 0x62, 0x86, 0x8a, 0xf1, 0xc5, 0xb2, 0x49, 0x10, 0xb0, 0x21,
+	0x11, 0xf5, 0xde, 0x10, 0x95, 0xd9, 0xcb, 0x4e, 0x3b, 0xf1, 0x23, 0x7e, 0xa8, 0x58, 0x6b, 0x57,
+	0x96, 0x65, 0x67, 0xce, 0x23, 0xb5, 0xb4, 0xad, 0x30, 0xad, 0x00, 0x6c, 0xa3, 0xbc, 0x80, 0xfb,
+	0x40, 0x3c, 0x0a, 0x3a, 0x12, 0x61, 0xc6, 0xdd, 0x61, 0xe7, 0x31, 0x67, 0x95, 0x65, 0xd0, 0x3b,
+	0x29, 0x6d, 0x2a, 0x04, 0x85, 0x8a, 0x04, 0xfc, 0x0b, 0x7a, 0xa8, 0x3a, 0x81, 0x6b, 0x3b, 0x24,
+	0x08, 0xda, 0xc4, 0xe9, 0x80, 0x9e, 0x17, 0x41, 0xfb, 0xd9, 0x41, 0x67, 0x4a, 0xd0, 0x94, 0xbc,
+	0xf5, 0xbe, 0x0c, 0x2c, 0x67, 0x78, 0xa5, 0x82, 0x31, 0xcc, 0x2b, 0x01, 0xff, 0xa6, 0x21, 0x5d,
+	0xb5, 0x19, 0xea, 0xda, 0x1e, 0x1b, 0xd8, 0xdd, 0x1e, 0xeb, 0x32, 0x20, 0x01, 0xe8, 0x05, 0x31,
+	0x8d, 0x67, 0x77, 0x94, 0x72, 0xaa, 0x3a, 0x62, 0x83, 0x53, 0xa9, 0xb1, 0x0e, 0xe4, 0x54, 0x6a,
+	0x77, 0x99, 0xa6, 0xe6, 0xf3, 0x98, 0x67, 0x59, 0x00, 0x1e, 0xa2, 0xea, 0x1d, 0x6a, 0x5b, 0x5d,
+	0x4f, 0x7d, 0xb3, 0xaa, 0xc5, 0x47, 0x77, 0x32, 0x32, 0x0e, 0xfe, 0x8b, 0x4d, 0x25, 0x96, 0x33,
+	0x13, 0x55, 0x6b, 0xc0, 0x80, 0xb6, 0xe7, 0xba, 0x29, 0xe8, 0x5b, 0x62, 0x0f, 0xde, 0xcb, 0xde,
+	0x83, 0x97, 0x8a, 0x3e, 0x8a, 0x61, 0xab, 0x26, 0x17, 0x5f, 0x9a, 0x77, 0x49, 0x4d, 0xa1, 0xe8,
+	0xde, 0xd2, 0x40, 0xed, 0xf7, 0x07, 0x68, 0x5d, 0xdd, 0x56, 0xfc, 0x21, 0xda, 0x9e, 0xbf, 0xe1,
+	0xa2, 0x5f, 0x6f, 0xb4, 0x8a, 0xce, 0xed, 0x1b, 0x8d, 0x8f, 0xd1, 0xe6, 0x14, 0xf5, 0xa3, 0x0b,
+	0xa6, 0x3f, 0xa8, 0x6a, 0xb2, 0xab, 0x2e, 0x34, 0xe8, 0x04, 0x3b, 0x8e, 0x2e, 0x98, 0xec, 0xee,
+	0x05, 0x27, 0xf5, 0x0c, 0x7f, 0x8e, 0xd6, 0x3b, 0x03, 0x3b, 0x64, 0x2e, 0x0d, 0xf4, 0x9c, 0x70,
+	0x29, 0x67, 0xaf, 0xf7, 0xdb, 0x57, 0x27, 0x31, 0xf4, 0xcd, 0x52, 0x6b, 0xad, 0x33, 0x10, 0x1f,
+	0xf1, 0xd7, 0xa8, 0xe0, 0xf4, 0x81, 0xb3, 0x50, 0xea, 0x97, 0xef, 0xbb, 0xfe, 0x4d, 0x41, 0x2a,
+	0x8f, 0xbc, 0x33, 0xfb, 0x6a, 0x6d, 0xa3, 0xad, 0xe9, 0x72, 0x80, 0x13, 0x4e, 0x6b, 0x5f, 0xa2,
+	0x35, 0x99, 0x87, 0x3f, 0x41, 0xab, 0xc2, 0x3d, 0xde, 0x8c, 0xb8, 0x1c, 0x4f, 0x16, 0x17, 0x99,
+	0xb8, 0xc8, 0xdf, 0xae, 0x04, 0xae, 0xfd, 0xa9, 0xa1, 0x7c, 0x2a, 0x12, 0x7f, 0x87, 0x72, 0x21,
+	0x78, 0xfa, 0x4a, 0x55, 0xab, 0x17, 0xac, 0x2f, 0xfe, 0x19, 0x19, 0x9f, 0x79, 0x3e, 0xbf, 0xec,
+	0xb7, 0x4d, 0x87, 0x85, 0x8d, 0x26, 0x83, 0xf0, 0xb5, 0x7a, 0x0b, 0x70, 0x1b, 0x57, 0xe2, 0xbf,
+	0x7c, 0x51, 0x68, 0x91, 0xa1, 0xda, 0xc4, 0x13, 0x0a, 0xf1, 0xd5, 0x6d, 0xc5, 0x4e, 0xf8, 0x1c,
+	0xad, 0x3a, 0x97, 0xfd, 0xa8, 0x93, 0x74, 0xeb, 0xff, 0xed, 0x29, 0xcd, 0xac, 0xc3, 0x37, 0xe3,
+	0x8a, 0xf6, 0x76, 0x5c, 0xd1, 0xfe, 0x1e, 0x57, 0xb4, 0x5f, 0x6f, 0x2a, 0x4b, 0x6f, 0x6f, 0x2a,
+	0x4b, 0x7f, 0xdd, 0x54, 0x96, 0x7e, 0xdc, 0x4f, 0x99, 0xb3, 0xc0, 0xbd, 0xe8, 0xf7, 0xae, 0x49,
+	0x23, 0xf9, 0x7b, 0xd5, 0xe0, 0x33, 0xf7, 0xf6, 0xaa, 0x78, 0x0d, 0xf8, 0xf8, 0xdf, 0x01, 0x00,
+	0x76, 0xa6, 0x24, 0xf4, 0x8b, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -423,21 +517,106 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorGrants) > 0 {
+		for iNdEx := len(m.DelegatorGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.TimelockedGovProposalSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimelockedGovProposalSequence))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.TimelockedGovProposals) > 0 {
+		for iNdEx := len(m.TimelockedGovProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimelockedGovProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ScheduledCallbacks) > 0 {
+		for iNdEx := len(m.ScheduledCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MintUsages) > 0 {
+		for iNdEx := len(m.MintUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MintAllowances) > 0 {
+		for iNdEx := len(m.MintAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.TWasmParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if len(m.PinnedCodeIDs) > 0 {
-		dAtA2 := make([]byte, len(m.PinnedCodeIDs)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.PinnedCodeIDs)*10)
+		var j2 int
 		for _, num := range m.PinnedCodeIDs {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -669,6 +848,15 @@ func (m *CustomModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Chunks[iNdEx])
+			copy(dAtA[i:], m.Chunks[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Chunks[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Msg) > 0 {
 		i -= len(m.Msg)
 		copy(dAtA[i:], m.Msg)
@@ -736,6 +924,41 @@ func (m *GenesisState) Size() (n int) {
 		}
 		n += 1 + sovGenesis(uint64(l)) + l
 	}
+	l = m.TWasmParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MintAllowances) > 0 {
+		for _, e := range m.MintAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintUsages) > 0 {
+		for _, e := range m.MintUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledCallbacks) > 0 {
+		for _, e := range m.ScheduledCallbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimelockedGovProposals) > 0 {
+		for _, e := range m.TimelockedGovProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TimelockedGovProposalSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.TimelockedGovProposalSequence))
+	}
+	if len(m.DelegatorGrants) > 0 {
+		for _, e := range m.DelegatorGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -808,6 +1031,12 @@ func (m *CustomModel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Chunks) > 0 {
+		for _, b := range m.Chunks {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1125,6 +1354,228 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PinnedCodeIDs", wireType)
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TWasmParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TWasmParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAllowances = append(m.MintAllowances, MintAllowance{})
+			if err := m.MintAllowances[len(m.MintAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintUsages = append(m.MintUsages, MintUsage{})
+			if err := m.MintUsages[len(m.MintUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCallbacks = append(m.ScheduledCallbacks, ScheduledCallback{})
+			if err := m.ScheduledCallbacks[len(m.ScheduledCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockedGovProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockedGovProposals = append(m.TimelockedGovProposals, TimelockedGovProposal{})
+			if err := m.TimelockedGovProposals[len(m.TimelockedGovProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockedGovProposalSequence", wireType)
+			}
+			m.TimelockedGovProposalSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimelockedGovProposalSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorGrants = append(m.DelegatorGrants, DelegatorGrant{})
+			if err := m.DelegatorGrants[len(m.DelegatorGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1481,6 +1932,38 @@ func (m *CustomModel) Unmarshal(dAtA []byte) error {
 				m.Msg = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, make([]byte, postIndex-iNdEx))
+			copy(m.Chunks[len(m.Chunks)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,10 +1,13 @@
 package types
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 )
@@ -23,6 +26,12 @@ func TestGenesisValidate(t *testing.T) {
 			}),
 			expErr: true,
 		},
+		"twasm params invalid": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.TWasmParams.CallbackGasLimits = []CallbackGasLimit{{PrivilegeType: "begin_blocker"}}
+			}),
+			expErr: true,
+		},
 		"privileged address empty": {
 			state: GenesisStateFixture(t, func(state *GenesisState) {
 				state.PrivilegedContractAddresses = []string{""}
@@ -49,6 +58,30 @@ func TestGenesisValidate(t *testing.T) {
 			}),
 			expErr: true,
 		},
+		"custom model with chunks": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.Contracts[0].ContractState = &Contract_CustomModel{CustomModel: &CustomModel{
+					Chunks: []types.RawContractMessage{[]byte(`{"page":1}`), []byte(`{"page":2}`)},
+				}}
+			}),
+		},
+		"custom model with msg and chunks": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.Contracts[0].ContractState = &Contract_CustomModel{CustomModel: &CustomModel{
+					Msg:    []byte(`{}`),
+					Chunks: []types.RawContractMessage{[]byte(`{"page":1}`)},
+				}}
+			}),
+			expErr: true,
+		},
+		"custom model with invalid chunk": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.Contracts[0].ContractState = &Contract_CustomModel{CustomModel: &CustomModel{
+					Chunks: []types.RawContractMessage{[]byte(`not json`)},
+				}}
+			}),
+			expErr: true,
+		},
 		"unique pinned codeIDs": {
 			state: GenesisStateFixture(t, func(state *GenesisState) {
 				state.PinnedCodeIDs = []uint64{1, 2, 3}
@@ -86,6 +119,145 @@ func TestGenesisValidate(t *testing.T) {
 			}),
 			expErr: true,
 		},
+		"mint allowances and usages": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				addr := RandomBech32Address(t)
+				state.MintAllowances = []MintAllowance{{ContractAddress: addr, Limits: []MintLimit{{Denom: "ufury"}}}}
+				state.MintUsages = []MintUsage{{ContractAddress: addr, Denom: "ufury", TotalMinted: sdk.OneInt(), PeriodMinted: sdk.OneInt()}}
+			}),
+		},
+		"duplicate mint allowance": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				addr := RandomBech32Address(t)
+				state.MintAllowances = []MintAllowance{{ContractAddress: addr}, {ContractAddress: addr}}
+			}),
+			expErr: true,
+		},
+		"invalid mint allowance": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.MintAllowances = []MintAllowance{{ContractAddress: "invalid"}}
+			}),
+			expErr: true,
+		},
+		"duplicate mint usage": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				usage := MintUsage{ContractAddress: RandomBech32Address(t), Denom: "ufury", TotalMinted: sdk.OneInt(), PeriodMinted: sdk.OneInt()}
+				state.MintUsages = []MintUsage{usage, usage}
+			}),
+			expErr: true,
+		},
+		"invalid mint usage": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.MintUsages = []MintUsage{{ContractAddress: RandomBech32Address(t), Denom: "ufury"}}
+			}),
+			expErr: true,
+		},
+		"scheduled callbacks": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				addr, myTime := RandomBech32Address(t), time.Unix(1, 0).UTC()
+				state.ScheduledCallbacks = []ScheduledCallback{
+					{ContractAddress: addr, ID: "by-height", Height: 1, Payload: []byte(`{}`)},
+					{ContractAddress: addr, ID: "by-time", Time: &myTime},
+				}
+			}),
+		},
+		"duplicate scheduled callback": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				callback := ScheduledCallback{ContractAddress: RandomBech32Address(t), ID: "my-id", Height: 1}
+				state.ScheduledCallbacks = []ScheduledCallback{callback, callback}
+			}),
+			expErr: true,
+		},
+		"scheduled callback without height and time": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.ScheduledCallbacks = []ScheduledCallback{{ContractAddress: RandomBech32Address(t), ID: "my-id"}}
+			}),
+			expErr: true,
+		},
+		"scheduled callback with height and time": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				myTime := time.Unix(1, 0).UTC()
+				state.ScheduledCallbacks = []ScheduledCallback{{ContractAddress: RandomBech32Address(t), ID: "my-id", Height: 1, Time: &myTime}}
+			}),
+			expErr: true,
+		},
+		"scheduled callback with negative height": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.ScheduledCallbacks = []ScheduledCallback{{ContractAddress: RandomBech32Address(t), ID: "my-id", Height: -1}}
+			}),
+			expErr: true,
+		},
+		"scheduled callback without id": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.ScheduledCallbacks = []ScheduledCallback{{ContractAddress: RandomBech32Address(t), Height: 1}}
+			}),
+			expErr: true,
+		},
+		"scheduled callback id too long": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				id := strings.Repeat("a", MaxScheduledCallbackIDLength+1)
+				state.ScheduledCallbacks = []ScheduledCallback{{ContractAddress: RandomBech32Address(t), ID: id, Height: 1}}
+			}),
+			expErr: true,
+		},
+		"scheduled callback invalid address": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.ScheduledCallbacks = []ScheduledCallback{{ContractAddress: "invalid", ID: "my-id", Height: 1}}
+			}),
+			expErr: true,
+		},
+		"timelocked gov proposals": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.TimelockedGovProposals = []TimelockedGovProposal{
+					TimelockedGovProposalFixture(t),
+					TimelockedGovProposalFixture(t, func(p *TimelockedGovProposal) { p.ID = 2 }),
+				}
+				state.TimelockedGovProposalSequence = 3
+			}),
+		},
+		"duplicate timelocked gov proposal id": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.TimelockedGovProposals = []TimelockedGovProposal{TimelockedGovProposalFixture(t), TimelockedGovProposalFixture(t)}
+				state.TimelockedGovProposalSequence = 2
+			}),
+			expErr: true,
+		},
+		"timelocked gov proposal id not below sequence": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.TimelockedGovProposals = []TimelockedGovProposal{TimelockedGovProposalFixture(t)}
+				state.TimelockedGovProposalSequence = 1
+			}),
+			expErr: true,
+		},
+		"delegator grants": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				staker := RandomBech32Address(t)
+				state.DelegatorGrants = []DelegatorGrant{
+					{Staker: staker, ContractAddress: RandomBech32Address(t)},
+					{Staker: staker, ContractAddress: RandomBech32Address(t)},
+				}
+			}),
+		},
+		"duplicate delegator grant": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				grant := DelegatorGrant{Staker: RandomBech32Address(t), ContractAddress: RandomBech32Address(t)}
+				state.DelegatorGrants = []DelegatorGrant{grant, grant}
+			}),
+			expErr: true,
+		},
+		"invalid delegator grant": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.DelegatorGrants = []DelegatorGrant{{Staker: "invalid", ContractAddress: RandomBech32Address(t)}}
+			}),
+			expErr: true,
+		},
+		"invalid timelocked gov proposal": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.TimelockedGovProposals = []TimelockedGovProposal{TimelockedGovProposalFixture(t, func(p *TimelockedGovProposal) { p.Sender = "invalid" })}
+				state.TimelockedGovProposalSequence = 2
+			}),
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,87 @@
+package types
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic validates the allowance
+func (a MintAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	return ValidateMintLimits(a.Limits)
+}
+
+// LimitFor returns the limit for the given denom. Returns false when the denom is not allowed.
+func (a MintAllowance) LimitFor(denom string) (MintLimit, bool) {
+	for _, l := range a.Limits {
+		if l.Denom == denom {
+			return l, true
+		}
+	}
+	return MintLimit{}, false
+}
+
+// ValidateMintLimits validates all limits and ensures that a denom is not used more than once
+func ValidateMintLimits(limits []MintLimit) error {
+	uniqueDenoms := make(map[string]struct{}, len(limits))
+	for _, l := range limits {
+		if err := l.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "limit %s", l.Denom)
+		}
+		if _, exists := uniqueDenoms[l.Denom]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "denom %s", l.Denom)
+		}
+		uniqueDenoms[l.Denom] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic validates the limit
+func (l MintLimit) ValidateBasic() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if !l.TotalCap.IsNil() && l.TotalCap.IsNegative() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "total cap must not be negative")
+	}
+	if !l.RateLimit.IsNil() && l.RateLimit.IsNegative() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "rate limit must not be negative")
+	}
+	if l.HasRateLimit() && l.RatePeriod == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "rate period must not be empty with a rate limit")
+	}
+	return nil
+}
+
+// HasTotalCap returns true when the total minted amount is limited
+func (l MintLimit) HasTotalCap() bool {
+	return !l.TotalCap.IsNil() && l.TotalCap.IsPositive()
+}
+
+// HasRateLimit returns true when the minted amount within a rate period is limited
+func (l MintLimit) HasRateLimit() bool {
+	return !l.RateLimit.IsNil() && l.RateLimit.IsPositive()
+}
+
+// ValidateBasic validates the usage
+func (u MintUsage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if err := sdk.ValidateDenom(u.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if u.TotalMinted.IsNil() || u.TotalMinted.IsNegative() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "total minted must not be negative")
+	}
+	if u.PeriodMinted.IsNil() || u.PeriodMinted.IsNegative() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "period minted must not be negative")
+	}
+	if u.PeriodStartHeight < 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "period start height must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,1196 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: confio/twasm/v1beta1/mint.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintAllowance restricts the tokens that a contract with the token minter
+// privilege can mint. Contracts without an allowance are not restricted.
+type MintAllowance struct {
+	// ContractAddress is the address of the minting contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// Limits for each denom that the contract can mint. Denoms not in the list
+	// can not be minted.
+	Limits []MintLimit `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits" yaml:"limits"`
+}
+
+func (m *MintAllowance) Reset()         { *m = MintAllowance{} }
+func (m *MintAllowance) String() string { return proto.CompactTextString(m) }
+func (*MintAllowance) ProtoMessage()    {}
+func (*MintAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d86458888b3312e, []int{0}
+}
+
+func (m *MintAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MintAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MintAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintAllowance.Merge(m, src)
+}
+
+func (m *MintAllowance) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MintAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintAllowance proto.InternalMessageInfo
+
+func (m *MintAllowance) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MintAllowance) GetLimits() []MintLimit {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+// MintLimit defines how many tokens of a denom can be minted
+type MintLimit struct {
+	// Denom of the tokens
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// TotalCap max amount that can be minted in total. 0 for no cap.
+	TotalCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_cap,json=totalCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_cap" yaml:"total_cap"`
+	// RateLimit max amount that can be minted within a rate period. 0 for no
+	// rate limit.
+	RateLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=rate_limit,json=rateLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"rate_limit" yaml:"rate_limit"`
+	// RatePeriod number of blocks that a rate period lasts. Use 1 for a limit
+	// per block.
+	RatePeriod uint64 `protobuf:"varint,4,opt,name=rate_period,json=ratePeriod,proto3" json:"rate_period,omitempty" yaml:"rate_period"`
+}
+
+func (m *MintLimit) Reset()         { *m = MintLimit{} }
+func (m *MintLimit) String() string { return proto.CompactTextString(m) }
+func (*MintLimit) ProtoMessage()    {}
+func (*MintLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d86458888b3312e, []int{1}
+}
+
+func (m *MintLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MintLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MintLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintLimit.Merge(m, src)
+}
+
+func (m *MintLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MintLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintLimit proto.InternalMessageInfo
+
+func (m *MintLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintLimit) GetRatePeriod() uint64 {
+	if m != nil {
+		return m.RatePeriod
+	}
+	return 0
+}
+
+// MintUsage tracks the tokens minted by a contract for a denom
+type MintUsage struct {
+	// ContractAddress is the address of the minting contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Denom of the tokens
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// TotalMinted amount minted in total
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_minted,json=totalMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_minted"`
+	// PeriodStartHeight block height when the current rate period started
+	PeriodStartHeight int64 `protobuf:"varint,4,opt,name=period_start_height,json=periodStartHeight,proto3" json:"period_start_height,omitempty"`
+	// PeriodMinted amount minted within the current rate period
+	PeriodMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=period_minted,json=periodMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"period_minted"`
+}
+
+func (m *MintUsage) Reset()         { *m = MintUsage{} }
+func (m *MintUsage) String() string { return proto.CompactTextString(m) }
+func (*MintUsage) ProtoMessage()    {}
+func (*MintUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d86458888b3312e, []int{2}
+}
+
+func (m *MintUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MintUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MintUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintUsage.Merge(m, src)
+}
+
+func (m *MintUsage) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MintUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintUsage proto.InternalMessageInfo
+
+func (m *MintUsage) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MintUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintUsage) GetPeriodStartHeight() int64 {
+	if m != nil {
+		return m.PeriodStartHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MintAllowance)(nil), "confio.twasm.v1beta1.MintAllowance")
+	proto.RegisterType((*MintLimit)(nil), "confio.twasm.v1beta1.MintLimit")
+	proto.RegisterType((*MintUsage)(nil), "confio.twasm.v1beta1.MintUsage")
+}
+
+func init() { proto.RegisterFile("confio/twasm/v1beta1/mint.proto", fileDescriptor_5d86458888b3312e) }
+
+var fileDescriptor_5d86458888b3312e = []byte{
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x1c, 0x8d, 0x93, 0xb4, 0x22, 0x97, 0x44, 0xa4, 0x47, 0x00, 0x0b, 0x24, 0x5f, 0xe4, 0xa1, 0x84,
+	0x01, 0x5b, 0x85, 0x01, 0xa9, 0x5b, 0x5c, 0x09, 0x81, 0x44, 0x11, 0xb8, 0x62, 0x61, 0xb1, 0x2e,
+	0xf6, 0xd5, 0xb1, 0xb0, 0x7d, 0x96, 0xef, 0x4a, 0xc9, 0xb7, 0xe0, 0x23, 0x30, 0x23, 0x31, 0xf3,
+	0x15, 0x3a, 0x76, 0x44, 0x0c, 0x16, 0x4a, 0x16, 0x66, 0x7f, 0x02, 0xe4, 0xfb, 0x5d, 0x4a, 0xc5,
+	0x9f, 0xa1, 0x2c, 0xc9, 0xdd, 0xfd, 0xde, 0xef, 0xbd, 0xf7, 0x7b, 0xe7, 0x43, 0x24, 0xe4, 0xf9,
+	0x71, 0xc2, 0x5d, 0x79, 0x4a, 0x45, 0xe6, 0xbe, 0xdb, 0x9b, 0x33, 0x49, 0xf7, 0xdc, 0x2c, 0xc9,
+	0xa5, 0x53, 0x94, 0x5c, 0x72, 0x3c, 0x06, 0x80, 0xa3, 0x00, 0x8e, 0x06, 0xdc, 0x19, 0xc7, 0x3c,
+	0xe6, 0x0a, 0xe0, 0x36, 0x2b, 0xc0, 0xda, 0x9f, 0x0d, 0x34, 0x3c, 0x4c, 0x72, 0x39, 0x4b, 0x53,
+	0x7e, 0x4a, 0xf3, 0x90, 0xe1, 0x27, 0x68, 0x14, 0xf2, 0x5c, 0x96, 0x34, 0x94, 0x01, 0x8d, 0xa2,
+	0x92, 0x09, 0x61, 0x1a, 0x13, 0x63, 0xda, 0xf3, 0xee, 0xd6, 0x15, 0xb9, 0xbd, 0xa4, 0x59, 0xba,
+	0x6f, 0xff, 0x8e, 0xb0, 0xfd, 0xeb, 0x9b, 0xa3, 0x19, 0x9c, 0xe0, 0x17, 0x68, 0x3b, 0x4d, 0xb2,
+	0x44, 0x0a, 0xb3, 0x3d, 0xe9, 0x4c, 0xfb, 0x0f, 0x89, 0xf3, 0x37, 0x5b, 0x4e, 0x23, 0xfe, 0xbc,
+	0xc1, 0x79, 0x37, 0xcf, 0x2a, 0xd2, 0xaa, 0x2b, 0x32, 0x04, 0x09, 0x68, 0xb6, 0x7d, 0xcd, 0xb2,
+	0xdf, 0xfd, 0xf1, 0x91, 0x18, 0xf6, 0x97, 0x36, 0xea, 0x5d, 0xb4, 0xe0, 0x5d, 0xb4, 0x15, 0xb1,
+	0x9c, 0x67, 0xda, 0xe0, 0xa8, 0xae, 0xc8, 0x00, 0xba, 0xd5, 0xb1, 0xed, 0x43, 0x19, 0x07, 0xa8,
+	0x27, 0xb9, 0xa4, 0x69, 0x10, 0xd2, 0xc2, 0x6c, 0x2b, 0xac, 0xd7, 0xa8, 0x7d, 0xab, 0xc8, 0x6e,
+	0x9c, 0xc8, 0xc5, 0xc9, 0xdc, 0x09, 0x79, 0xe6, 0x86, 0x5c, 0x64, 0x5c, 0xe8, 0xbf, 0x07, 0x22,
+	0x7a, 0xeb, 0xca, 0x65, 0xc1, 0x84, 0xf3, 0x2c, 0x97, 0x75, 0x45, 0x46, 0xc0, 0x7c, 0x41, 0x64,
+	0xfb, 0xd7, 0xd4, 0xfa, 0x80, 0x16, 0x78, 0x8e, 0x50, 0x49, 0x25, 0x0b, 0x94, 0x57, 0xb3, 0xa3,
+	0x14, 0x0e, 0xae, 0xac, 0xb0, 0x03, 0x0a, 0xbf, 0x98, 0x6c, 0xbf, 0xd7, 0x6c, 0x60, 0xd8, 0xc7,
+	0xa8, 0xaf, 0x2a, 0x05, 0x2b, 0x13, 0x1e, 0x99, 0xdd, 0x89, 0x31, 0xed, 0x7a, 0xb7, 0xea, 0x8a,
+	0xe0, 0x4b, 0x6d, 0x50, 0xb4, 0x7d, 0x65, 0xe7, 0xa5, 0xda, 0xe8, 0xe4, 0x3e, 0xe9, 0xe4, 0x5e,
+	0x0b, 0x1a, 0x33, 0x7c, 0xff, 0x5f, 0xb7, 0xfc, 0xe7, 0x45, 0x8e, 0x37, 0x21, 0xab, 0xe0, 0x36,
+	0x91, 0xbe, 0x42, 0x03, 0x48, 0xa2, 0xf9, 0xf0, 0x58, 0xa4, 0x67, 0x76, 0xae, 0x36, 0xb3, 0xdf,
+	0x57, 0x1c, 0x87, 0x8a, 0x02, 0x3b, 0xe8, 0x06, 0xd8, 0x0f, 0x84, 0xa4, 0xa5, 0x0c, 0x16, 0x2c,
+	0x89, 0x17, 0x52, 0x0d, 0xda, 0xf1, 0x77, 0xa0, 0x74, 0xd4, 0x54, 0x9e, 0xaa, 0x02, 0x3e, 0x42,
+	0x43, 0x8d, 0xd7, 0x1e, 0xb6, 0xfe, 0xcb, 0xc3, 0x00, 0x48, 0xc0, 0x04, 0x84, 0xe5, 0xcd, 0xce,
+	0x56, 0x96, 0x71, 0xbe, 0xb2, 0x8c, 0xef, 0x2b, 0xcb, 0xf8, 0xb0, 0xb6, 0x5a, 0xe7, 0x6b, 0xab,
+	0xf5, 0x75, 0x6d, 0xb5, 0xde, 0xdc, 0xbb, 0xc4, 0xca, 0xd3, 0xe8, 0xf8, 0xa4, 0x5c, 0x52, 0x17,
+	0x7e, 0xdf, 0xeb, 0x27, 0xa9, 0xa8, 0xe7, 0xdb, 0xea, 0x81, 0x3d, 0xfa, 0x39, 0x00, 0x0b, 0x2e,
+	0x3e, 0x40, 0xaf, 0x03, 0x00, 0x00,
+}
+
+func (this *MintAllowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintAllowance)
+	if !ok {
+		that2, ok := that.(MintAllowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if len(this.Limits) != len(that1.Limits) {
+		return false
+	}
+	for i := range this.Limits {
+		if !this.Limits[i].Equal(&that1.Limits[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *MintLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintLimit)
+	if !ok {
+		that2, ok := that.(MintLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.TotalCap.Equal(that1.TotalCap) {
+		return false
+	}
+	if !this.RateLimit.Equal(that1.RateLimit) {
+		return false
+	}
+	if this.RatePeriod != that1.RatePeriod {
+		return false
+	}
+	return true
+}
+
+func (this *MintUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintUsage)
+	if !ok {
+		that2, ok := that.(MintUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.TotalMinted.Equal(that1.TotalMinted) {
+		return false
+	}
+	if this.PeriodStartHeight != that1.PeriodStartHeight {
+		return false
+	}
+	if !this.PeriodMinted.Equal(that1.PeriodMinted) {
+		return false
+	}
+	return true
+}
+
+func (m *MintAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RatePeriod != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.RatePeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RateLimit.Size()
+		i -= size
+		if _, err := m.RateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalCap.Size()
+		i -= size
+		if _, err := m.TotalCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PeriodMinted.Size()
+		i -= size
+		if _, err := m.PeriodMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PeriodStartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.PeriodStartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *MintAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.TotalCap.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.RateLimit.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.RatePeriod != 0 {
+		n += 1 + sovMint(uint64(m.RatePeriod))
+	}
+	return n
+}
+
+func (m *MintUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.PeriodStartHeight != 0 {
+		n += 1 + sovMint(uint64(m.PeriodStartHeight))
+	}
+	l = m.PeriodMinted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *MintAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limits = append(m.Limits, MintLimit{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MintLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatePeriod", wireType)
+			}
+			m.RatePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MintUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStartHeight", wireType)
+			}
+			m.PeriodStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipMint(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMint
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMint
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMint
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMint        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMint          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMint = fmt.Errorf("proto: unexpected end of group")
+)
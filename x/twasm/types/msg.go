@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgGrantDelegator  = "grant_delegator"
+	TypeMsgRevokeDelegator = "revoke_delegator"
+)
+
+var (
+	_ sdk.Msg = &MsgGrantDelegator{}
+	_ sdk.Msg = &MsgRevokeDelegator{}
+)
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrantDelegator) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrantDelegator) Type() string { return TypeMsgGrantDelegator }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgGrantDelegator) GetSigners() []sdk.AccAddress {
+	staker, err := sdk.AccAddressFromBech32(msg.Staker)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{staker}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrantDelegator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrantDelegator) ValidateBasic() error {
+	return DelegatorGrant{
+		Staker:          msg.Staker,
+		ContractAddress: msg.ContractAddress,
+		Expiration:      msg.Expiration,
+	}.ValidateBasic()
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevokeDelegator) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevokeDelegator) Type() string { return TypeMsgRevokeDelegator }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevokeDelegator) GetSigners() []sdk.AccAddress {
+	staker, err := sdk.AccAddressFromBech32(msg.Staker)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{staker}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevokeDelegator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeDelegator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Staker); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "staker: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "contract address: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgGrantDelegatorValidateBasic(t *testing.T) {
+	myStaker, myContract := RandomBech32Address(t), RandomBech32Address(t)
+	myTime := time.Unix(1, 0).UTC()
+	specs := map[string]struct {
+		src    MsgGrantDelegator
+		expErr bool
+	}{
+		"all good": {
+			src: MsgGrantDelegator{Staker: myStaker, ContractAddress: myContract},
+		},
+		"with expiration": {
+			src: MsgGrantDelegator{Staker: myStaker, ContractAddress: myContract, Expiration: &myTime},
+		},
+		"invalid staker": {
+			src:    MsgGrantDelegator{Staker: "invalid", ContractAddress: myContract},
+			expErr: true,
+		},
+		"invalid contract": {
+			src:    MsgGrantDelegator{Staker: myStaker, ContractAddress: "invalid"},
+			expErr: true,
+		},
+		"staker is contract": {
+			src:    MsgGrantDelegator{Staker: myStaker, ContractAddress: myStaker},
+			expErr: true,
+		},
+		"expiration not after epoch": {
+			src:    MsgGrantDelegator{Staker: myStaker, ContractAddress: myContract, Expiration: &time.Time{}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, myStaker, spec.src.GetSigners()[0].String())
+		})
+	}
+}
+
+func TestMsgRevokeDelegatorValidateBasic(t *testing.T) {
+	myStaker, myContract := RandomBech32Address(t), RandomBech32Address(t)
+	specs := map[string]struct {
+		src    MsgRevokeDelegator
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRevokeDelegator{Staker: myStaker, ContractAddress: myContract},
+		},
+		"invalid staker": {
+			src:    MsgRevokeDelegator{Staker: "invalid", ContractAddress: myContract},
+			expErr: true,
+		},
+		"invalid contract": {
+			src:    MsgRevokeDelegator{Staker: myStaker, ContractAddress: "invalid"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, myStaker, spec.src.GetSigners()[0].String())
+		})
+	}
+}
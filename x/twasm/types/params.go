@@ -1,12 +1,194 @@
 package types
 
-import wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+import (
+	"fmt"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	yaml "gopkg.in/yaml.v2"
+)
 
 const (
 	// DefaultParamspace for params keeper
 	DefaultParamspace = ModuleName
 )
 
+var (
+	KeyCallbackGasLimits         = []byte("CallbackGasLimits")
+	KeyCallbackFailureThreshold  = []byte("CallbackFailureThreshold")
+	KeyBeginBlockLiveness        = []byte("BeginBlockLiveness")
+	KeyGovProposalTimelock       = []byte("GovProposalTimelock")
+	KeyTimelockedProposalTypes   = []byte("TimelockedProposalTypes")
+	KeyGovProposalVetoContract   = []byte("GovProposalVetoContract")
+	KeyGovProposalTimelockBlocks = []byte("GovProposalTimelockBlocks")
+)
+
+// TimelockTypeConsensusParams is the timelocked proposal type for consensus params updates of privileged contracts
+const TimelockTypeConsensusParams = "ConsensusParams"
+
 func DefaultParams() wasmtypes.Params {
 	return wasmtypes.DefaultParams()
 }
+
+var _ paramtypes.ParamSet = (*TWasmParams)(nil)
+
+// ParamKeyTable for the twasm module. It contains the wasmd params and the twasm specific extensions as both
+// share the same subspace.
+func ParamKeyTable() paramtypes.KeyTable {
+	return wasmtypes.ParamKeyTable().RegisterParamSet(&TWasmParams{})
+}
+
+// DefaultTWasmParams returns a default set of twasm specific parameters.
+// No gas limits are enforced for privileged callbacks by default, failing callbacks are not quarantined and
+// no liveness data is sent to begin block contracts. Gov proposals from contracts are executed without a timelock.
+func DefaultTWasmParams() TWasmParams {
+	return TWasmParams{
+		CallbackGasLimits:         nil,
+		CallbackFailureThreshold:  0,
+		BeginBlockLiveness:        false,
+		GovProposalTimelock:       0,
+		TimelockedProposalTypes:   nil,
+		GovProposalVetoContract:   "",
+		GovProposalTimelockBlocks: 0,
+	}
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *TWasmParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyCallbackGasLimits, &p.CallbackGasLimits, validateCallbackGasLimitsParam),
+		paramtypes.NewParamSetPair(KeyCallbackFailureThreshold, &p.CallbackFailureThreshold, validateCallbackFailureThresholdParam),
+		paramtypes.NewParamSetPair(KeyBeginBlockLiveness, &p.BeginBlockLiveness, validateBeginBlockLivenessParam),
+		paramtypes.NewParamSetPair(KeyGovProposalTimelock, &p.GovProposalTimelock, validateGovProposalTimelockParam),
+		paramtypes.NewParamSetPair(KeyTimelockedProposalTypes, &p.TimelockedProposalTypes, validateTimelockedProposalTypesParam),
+		paramtypes.NewParamSetPair(KeyGovProposalVetoContract, &p.GovProposalVetoContract, validateGovProposalVetoContractParam),
+		paramtypes.NewParamSetPair(KeyGovProposalTimelockBlocks, &p.GovProposalTimelockBlocks, validateGovProposalTimelockBlocksParam),
+	}
+}
+
+// String returns a human-readable string representation of the parameters.
+func (p TWasmParams) String() string {
+	out, err := yaml.Marshal(p)
+	if err != nil {
+		out = []byte(fmt.Sprintf("failed to serialize: %s", err))
+	}
+	return string(out)
+}
+
+// ValidateBasic syntax checks
+func (p TWasmParams) ValidateBasic() error {
+	if err := validateCallbackGasLimits(p.CallbackGasLimits); err != nil {
+		return sdkerrors.Wrap(err, "callback gas limits")
+	}
+	if err := validateGovProposalTimelock(p.GovProposalTimelock); err != nil {
+		return sdkerrors.Wrap(err, "gov proposal timelock")
+	}
+	if err := validateTimelockedProposalTypes(p.TimelockedProposalTypes); err != nil {
+		return sdkerrors.Wrap(err, "timelocked proposal types")
+	}
+	return sdkerrors.Wrap(validateGovProposalVetoContract(p.GovProposalVetoContract), "gov proposal veto contract")
+}
+
+// IsTimelocked returns true when gov proposals of the given type are queued with a timelock.
+// Consensus params updates are queued for TimelockTypeConsensusParams.
+func (p TWasmParams) IsTimelocked(proposalType string) bool {
+	if p.GovProposalTimelock == 0 && p.GovProposalTimelockBlocks == 0 {
+		return false
+	}
+	for _, t := range p.TimelockedProposalTypes {
+		if t == proposalType {
+			return true
+		}
+	}
+	return false
+}
+
+// CallbackGasLimit returns the gas limit for the given privilege type. Returns false when none is set.
+func (p TWasmParams) CallbackGasLimit(privilegeType PrivilegeType) (uint64, bool) {
+	return findCallbackGasLimit(p.CallbackGasLimits, privilegeType)
+}
+
+func validateCallbackGasLimitsParam(i interface{}) error {
+	v, ok := i.([]CallbackGasLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateCallbackGasLimits(v)
+}
+
+func validateCallbackFailureThresholdParam(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBeginBlockLivenessParam(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateGovProposalTimelockParam(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateGovProposalTimelock(v)
+}
+
+func validateGovProposalTimelock(v time.Duration) error {
+	if v < 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "must not be negative")
+	}
+	return nil
+}
+
+func validateGovProposalTimelockBlocksParam(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTimelockedProposalTypesParam(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateTimelockedProposalTypes(v)
+}
+
+func validateTimelockedProposalTypes(v []string) error {
+	unique := make(map[string]struct{}, len(v))
+	for _, t := range v {
+		if len(t) == 0 {
+			return sdkerrors.Wrap(wasmtypes.ErrEmpty, "proposal type")
+		}
+		if _, exists := unique[t]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "proposal type %s", t)
+		}
+		unique[t] = struct{}{}
+	}
+	return nil
+}
+
+func validateGovProposalVetoContractParam(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateGovProposalVetoContract(v)
+}
+
+func validateGovProposalVetoContract(v string) error {
+	if v == "" {
+		return nil
+	}
+	_, err := sdk.AccAddressFromBech32(v)
+	return err
+}
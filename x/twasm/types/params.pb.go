@@ -0,0 +1,709 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: confio/twasm/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TWasmParams defines the twasm specific parameters that extend the wasmd
+// Params
+type TWasmParams struct {
+	// CallbackGasLimits max gas for a single privileged callback by privilege
+	// type. No limit is enforced for types not in the list.
+	CallbackGasLimits []CallbackGasLimit `protobuf:"bytes,1,rep,name=callback_gas_limits,json=callbackGasLimits,proto3" json:"callback_gas_limits" yaml:"callback_gas_limits"`
+	// CallbackFailureThreshold number of consecutive failed callbacks after
+	// which the privilege is released for the contract. 0 disables the
+	// quarantine.
+	CallbackFailureThreshold uint32 `protobuf:"varint,2,opt,name=callback_failure_threshold,json=callbackFailureThreshold,proto3" json:"callback_failure_threshold,omitempty" yaml:"callback_failure_threshold"`
+	// BeginBlockLiveness adds the signers and non-signers of the last commit
+	// and the block proposer to the begin block callback payload.
+	BeginBlockLiveness bool `protobuf:"varint,3,opt,name=begin_block_liveness,json=beginBlockLiveness,proto3" json:"begin_block_liveness,omitempty" yaml:"begin_block_liveness"`
+	// GovProposalTimelock is the delay before a gov proposal of one of the
+	// timelocked proposal types is executed when submitted by a privileged
+	// contract. Combined with GovProposalTimelockBlocks, both must have passed.
+	GovProposalTimelock time.Duration `protobuf:"bytes,4,opt,name=gov_proposal_timelock,json=govProposalTimelock,proto3,stdduration" json:"gov_proposal_timelock" yaml:"gov_proposal_timelock"`
+	// TimelockedProposalTypes are the gov proposal types that are queued when
+	// submitted by a privileged contract. All other types are executed
+	// immediately. `ConsensusParams` queues the consensus params updates.
+	TimelockedProposalTypes []string `protobuf:"bytes,5,rep,name=timelocked_proposal_types,json=timelockedProposalTypes,proto3" json:"timelocked_proposal_types,omitempty" yaml:"timelocked_proposal_types"`
+	// GovProposalVetoContract is the address of the contract that can veto
+	// queued gov proposals. The contract must be privileged to send the veto
+	// message. Empty when vetoes are disabled.
+	GovProposalVetoContract string `protobuf:"bytes,6,opt,name=gov_proposal_veto_contract,json=govProposalVetoContract,proto3" json:"gov_proposal_veto_contract,omitempty" yaml:"gov_proposal_veto_contract"`
+	// GovProposalTimelockBlocks is the number of blocks before a gov proposal of
+	// one of the timelocked proposal types is executed when submitted by a
+	// privileged contract. Combined with GovProposalTimelock, both must have
+	// passed.
+	GovProposalTimelockBlocks uint64 `protobuf:"varint,7,opt,name=gov_proposal_timelock_blocks,json=govProposalTimelockBlocks,proto3" json:"gov_proposal_timelock_blocks,omitempty" yaml:"gov_proposal_timelock_blocks"`
+}
+
+func (m *TWasmParams) Reset()      { *m = TWasmParams{} }
+func (*TWasmParams) ProtoMessage() {}
+func (*TWasmParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_758df640b2d86bed, []int{0}
+}
+
+func (m *TWasmParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TWasmParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TWasmParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TWasmParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TWasmParams.Merge(m, src)
+}
+
+func (m *TWasmParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TWasmParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TWasmParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TWasmParams proto.InternalMessageInfo
+
+func (m *TWasmParams) GetCallbackGasLimits() []CallbackGasLimit {
+	if m != nil {
+		return m.CallbackGasLimits
+	}
+	return nil
+}
+
+func (m *TWasmParams) GetCallbackFailureThreshold() uint32 {
+	if m != nil {
+		return m.CallbackFailureThreshold
+	}
+	return 0
+}
+
+func (m *TWasmParams) GetBeginBlockLiveness() bool {
+	if m != nil {
+		return m.BeginBlockLiveness
+	}
+	return false
+}
+
+func (m *TWasmParams) GetGovProposalTimelock() time.Duration {
+	if m != nil {
+		return m.GovProposalTimelock
+	}
+	return 0
+}
+
+func (m *TWasmParams) GetTimelockedProposalTypes() []string {
+	if m != nil {
+		return m.TimelockedProposalTypes
+	}
+	return nil
+}
+
+func (m *TWasmParams) GetGovProposalVetoContract() string {
+	if m != nil {
+		return m.GovProposalVetoContract
+	}
+	return ""
+}
+
+func (m *TWasmParams) GetGovProposalTimelockBlocks() uint64 {
+	if m != nil {
+		return m.GovProposalTimelockBlocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TWasmParams)(nil), "confio.twasm.v1beta1.TWasmParams")
+}
+
+func init() { proto.RegisterFile("confio/twasm/v1beta1/params.proto", fileDescriptor_758df640b2d86bed) }
+
+var fileDescriptor_758df640b2d86bed = []byte{
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0x73, 0xa4, 0x2d, 0xad, 0x23, 0x06, 0xdc, 0xa0, 0x3a, 0xa1, 0xb2, 0xdd, 0xe3, 0xa5,
+	0x5e, 0xb0, 0xd5, 0xb2, 0x75, 0xc3, 0x45, 0xb0, 0x74, 0x28, 0x56, 0x04, 0x12, 0x8b, 0x39, 0x3b,
+	0x17, 0xc7, 0xea, 0xd9, 0x4f, 0xe4, 0xbb, 0xa4, 0x0d, 0x9f, 0x82, 0xb1, 0x63, 0x3f, 0x4e, 0xc7,
+	0x8e, 0x4c, 0x06, 0x25, 0x0b, 0x73, 0xc4, 0x07, 0x40, 0xb1, 0x2f, 0x21, 0x14, 0xb7, 0x8b, 0x65,
+	0x3f, 0xff, 0xdf, 0xf3, 0x72, 0xcf, 0xdf, 0xa7, 0xec, 0x85, 0x90, 0xf6, 0x62, 0x70, 0xc4, 0x39,
+	0xe1, 0x89, 0x33, 0x3a, 0x08, 0xa8, 0x20, 0x07, 0xce, 0x80, 0x64, 0x24, 0xe1, 0xf6, 0x20, 0x03,
+	0x01, 0x6a, 0xb3, 0x44, 0xec, 0x02, 0xb1, 0x25, 0xd2, 0x6e, 0x46, 0x10, 0x41, 0x01, 0x38, 0xf3,
+	0xb7, 0x92, 0x6d, 0xeb, 0x11, 0x40, 0xc4, 0xa8, 0x53, 0x7c, 0x05, 0xc3, 0x9e, 0xd3, 0x1d, 0x66,
+	0x44, 0xc4, 0x90, 0x4a, 0xfd, 0x55, 0x65, 0xbb, 0x10, 0x52, 0x91, 0x91, 0x50, 0xf8, 0xf4, 0x42,
+	0xd0, 0x94, 0x2f, 0x71, 0xfc, 0x7b, 0x5d, 0x69, 0x74, 0x3e, 0x11, 0x9e, 0x9c, 0x16, 0x03, 0xa9,
+	0x5f, 0x95, 0xed, 0x90, 0x30, 0x16, 0x90, 0xf0, 0xcc, 0x8f, 0x08, 0xf7, 0x59, 0x9c, 0xc4, 0x82,
+	0x6b, 0xc8, 0xac, 0x5b, 0x8d, 0xc3, 0x97, 0x76, 0xd5, 0xa0, 0xf6, 0xb1, 0x4c, 0x78, 0x4f, 0xf8,
+	0xc9, 0x1c, 0x77, 0xf1, 0x75, 0x6e, 0xd4, 0x66, 0xb9, 0xd1, 0x1e, 0x93, 0x84, 0x1d, 0xe1, 0x8a,
+	0x82, 0xd8, 0x7b, 0x1c, 0xde, 0xca, 0xe2, 0x6a, 0xa8, 0xb4, 0x97, 0x68, 0x8f, 0xc4, 0x6c, 0x98,
+	0x51, 0x5f, 0xf4, 0x33, 0xca, 0xfb, 0xc0, 0xba, 0xda, 0x03, 0x13, 0x59, 0x8f, 0xdc, 0x17, 0xb3,
+	0xdc, 0xd8, 0xbb, 0x55, 0xf6, 0x3f, 0x16, 0x7b, 0xda, 0x42, 0x7c, 0x57, 0x6a, 0x9d, 0x85, 0xa4,
+	0x7e, 0x50, 0x9a, 0x01, 0x8d, 0xe2, 0xd4, 0x0f, 0x18, 0x84, 0x67, 0x3e, 0x8b, 0x47, 0x34, 0xa5,
+	0x9c, 0x6b, 0x75, 0x13, 0x59, 0x9b, 0xae, 0x31, 0xcb, 0x8d, 0xa7, 0x65, 0xf9, 0x2a, 0x0a, 0x7b,
+	0x6a, 0x11, 0x76, 0xe7, 0xd1, 0x13, 0x19, 0x54, 0xcf, 0x95, 0x27, 0x11, 0x8c, 0xfc, 0x41, 0x06,
+	0x03, 0xe0, 0x84, 0xf9, 0x22, 0x4e, 0xe8, 0x1c, 0xd0, 0xd6, 0x4c, 0x64, 0x35, 0x0e, 0x5b, 0x76,
+	0x69, 0x99, 0xbd, 0xb0, 0xcc, 0x7e, 0x2b, 0x2d, 0x73, 0x2d, 0xb9, 0xa8, 0xdd, 0xb2, 0x65, 0x65,
+	0x15, 0x7c, 0xf9, 0xc3, 0x40, 0xde, 0x76, 0x04, 0xa3, 0x53, 0x29, 0x75, 0xa4, 0xa2, 0x7e, 0x51,
+	0x5a, 0x0b, 0x8a, 0x76, 0x57, 0x32, 0xc7, 0x03, 0xca, 0xb5, 0x75, 0xb3, 0x6e, 0x6d, 0xb9, 0xcf,
+	0x67, 0xb9, 0x61, 0x96, 0xd5, 0xef, 0x44, 0xb1, 0xb7, 0xf3, 0x57, 0x5b, 0x36, 0x99, 0x2b, 0x6a,
+	0xa0, 0xb4, 0xff, 0x19, 0x6a, 0x44, 0x05, 0xf8, 0x8b, 0x9f, 0x49, 0xdb, 0x30, 0x91, 0xb5, 0xb5,
+	0x6a, 0xc9, 0xdd, 0x2c, 0xf6, 0x76, 0x56, 0x4e, 0xf0, 0x91, 0x0a, 0x38, 0x96, 0x8a, 0xda, 0x57,
+	0x76, 0x2b, 0x0f, 0x5e, 0xee, 0x9e, 0x6b, 0x0f, 0x4d, 0x64, 0xad, 0xb9, 0xfb, 0xb3, 0xdc, 0x78,
+	0x76, 0xcf, 0x9a, 0x24, 0x8d, 0xbd, 0x56, 0xc5, 0xa6, 0x0a, 0xbf, 0xf8, 0xd1, 0xe6, 0xe5, 0x95,
+	0x51, 0xfb, 0x75, 0x65, 0x20, 0xf7, 0xcd, 0xf5, 0x44, 0x47, 0x37, 0x13, 0x1d, 0xfd, 0x9c, 0xe8,
+	0xe8, 0xdb, 0x54, 0xaf, 0xdd, 0x4c, 0xf5, 0xda, 0xf7, 0xa9, 0x5e, 0xfb, 0xbc, 0x1f, 0xc5, 0xa2,
+	0x3f, 0x0c, 0xec, 0x10, 0x12, 0x07, 0x58, 0xb7, 0x37, 0xcc, 0xc6, 0xc4, 0x29, 0x9f, 0x17, 0xf2,
+	0x52, 0x15, 0x4b, 0x0b, 0x36, 0x0a, 0x3b, 0x5f, 0xff, 0x19, 0x00, 0x73, 0x59, 0xb8, 0x3c, 0xe0,
+	0x03, 0x00, 0x00,
+}
+
+func (this *TWasmParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TWasmParams)
+	if !ok {
+		that2, ok := that.(TWasmParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.CallbackGasLimits) != len(that1.CallbackGasLimits) {
+		return false
+	}
+	for i := range this.CallbackGasLimits {
+		if !this.CallbackGasLimits[i].Equal(&that1.CallbackGasLimits[i]) {
+			return false
+		}
+	}
+	if this.CallbackFailureThreshold != that1.CallbackFailureThreshold {
+		return false
+	}
+	if this.BeginBlockLiveness != that1.BeginBlockLiveness {
+		return false
+	}
+	if this.GovProposalTimelock != that1.GovProposalTimelock {
+		return false
+	}
+	if len(this.TimelockedProposalTypes) != len(that1.TimelockedProposalTypes) {
+		return false
+	}
+	for i := range this.TimelockedProposalTypes {
+		if this.TimelockedProposalTypes[i] != that1.TimelockedProposalTypes[i] {
+			return false
+		}
+	}
+	if this.GovProposalVetoContract != that1.GovProposalVetoContract {
+		return false
+	}
+	if this.GovProposalTimelockBlocks != that1.GovProposalTimelockBlocks {
+		return false
+	}
+	return true
+}
+
+func (m *TWasmParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TWasmParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TWasmParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GovProposalTimelockBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GovProposalTimelockBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.GovProposalVetoContract) > 0 {
+		i -= len(m.GovProposalVetoContract)
+		copy(dAtA[i:], m.GovProposalVetoContract)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.GovProposalVetoContract)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TimelockedProposalTypes) > 0 {
+		for iNdEx := len(m.TimelockedProposalTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TimelockedProposalTypes[iNdEx])
+			copy(dAtA[i:], m.TimelockedProposalTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.TimelockedProposalTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.GovProposalTimelock, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.GovProposalTimelock):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.BeginBlockLiveness {
+		i--
+		if m.BeginBlockLiveness {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CallbackFailureThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CallbackFailureThreshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CallbackGasLimits) > 0 {
+		for iNdEx := len(m.CallbackGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *TWasmParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CallbackGasLimits) > 0 {
+		for _, e := range m.CallbackGasLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CallbackFailureThreshold != 0 {
+		n += 1 + sovParams(uint64(m.CallbackFailureThreshold))
+	}
+	if m.BeginBlockLiveness {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.GovProposalTimelock)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.TimelockedProposalTypes) > 0 {
+		for _, s := range m.TimelockedProposalTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.GovProposalVetoContract)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.GovProposalTimelockBlocks != 0 {
+		n += 1 + sovParams(uint64(m.GovProposalTimelockBlocks))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *TWasmParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TWasmParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TWasmParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackGasLimits = append(m.CallbackGasLimits, CallbackGasLimit{})
+			if err := m.CallbackGasLimits[len(m.CallbackGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackFailureThreshold", wireType)
+			}
+			m.CallbackFailureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackFailureThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlockLiveness", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BeginBlockLiveness = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovProposalTimelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.GovProposalTimelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockedProposalTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockedProposalTypes = append(m.TimelockedProposalTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovProposalVetoContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovProposalVetoContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovProposalTimelockBlocks", wireType)
+			}
+			m.GovProposalTimelockBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovProposalTimelockBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
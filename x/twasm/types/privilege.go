@@ -44,6 +44,31 @@ var (
 	// The contract receives a sudo message of type export where the result is stored in genesis. For the import path the json object containing state
 	// is passed to the contract via sudo import method.
 	PrivilegeStateExporterImporter = registerCallbackType(0x8, "state_exporter_importer", false)
+
+	// PrivilegeBankSendHook is called on every bank send before the tokens are transferred. The contract can reject the
+	// transfer by returning an error.
+	// Multiple contracts can register for this callback privilege
+	PrivilegeBankSendHook = registerCallbackType(0x9, "bank_send_hook", false)
+
+	// PrivilegeTxAnteHook is called for every transaction after the signatures were verified. The contract can reject
+	// the transaction by returning an error.
+	// Multiple contracts can register for this callback privilege
+	PrivilegeTxAnteHook = registerCallbackType(0xa, "tx_ante_hook", false)
+
+	// PrivilegeTypeTokenBurner is a permission to burn native tokens owned by the contract.
+	PrivilegeTypeTokenBurner = registerCallbackType(0xb, "token_burner", false)
+
+	// PrivilegeTypeScheduledCallback is a permission to schedule callbacks for a future block height or time.
+	// Pending callbacks are removed when the privilege is released.
+	PrivilegeTypeScheduledCallback = registerCallbackType(0xc, "scheduled_callback", false)
+
+	// PrivilegeTypeValidatorSetListener is called after the validator set was updated with the applied changes.
+	// Multiple contracts can register for this callback privilege
+	PrivilegeTypeValidatorSetListener = registerCallbackType(0xd, "validator_set_listener", false)
+
+	// PrivilegeStatePageExporter lets contracts with the state_exporter_importer privilege export their state page
+	// by page via the `export_page` sudo message instead of a single `export`.
+	PrivilegeStatePageExporter = registerCallbackType(0xe, "state_page_exporter", false)
 )
 
 var (
@@ -72,6 +97,24 @@ func registerCallbackType(i uint8, name string, singleton bool) PrivilegeType {
 	return r
 }
 
+// CustomPrivilegeTypeOffset is the lowest number for privilege types that are registered by the app. Lower numbers
+// are reserved for the privilege types of this module.
+const CustomPrivilegeTypeOffset uint8 = 0x80
+
+// RegisterCustomPrivilegeType registers a privilege type that is defined outside of this module. It must be called
+// on app construction before any state is processed. Registering the same type again is a noop so that multiple app
+// instances can be created in a single process. Panics on conflicts with existing types.
+func RegisterCustomPrivilegeType(i uint8, name string, singleton bool) PrivilegeType {
+	if i < CustomPrivilegeTypeOffset {
+		panic(fmt.Sprintf("custom privilege type must not be lower than %d", CustomPrivilegeTypeOffset))
+	}
+	r := PrivilegeType(i)
+	if existing, ok := callbackTypeToString[r]; ok && existing == name && r.IsSingleton() == singleton {
+		return r
+	}
+	return registerCallbackType(i, name, singleton)
+}
+
 // PrivilegeTypeFrom convert name to type. Returns nil when none matches
 func PrivilegeTypeFrom(name string) *PrivilegeType {
 	for k, v := range callbackTypeToString {
@@ -2,8 +2,10 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -12,22 +14,30 @@ import (
 type ProposalType string
 
 const (
-	ProposalTypePromoteContract ProposalType = "PromoteToPrivilegedContract"
-	ProposalTypeDemoteContract  ProposalType = "DemotePrivilegedContract"
+	ProposalTypePromoteContract  ProposalType = "PromoteToPrivilegedContract"
+	ProposalTypeDemoteContract   ProposalType = "DemotePrivilegedContract"
+	ProposalTypeSetCallbackOrder ProposalType = "SetPrivilegeCallbackOrder"
+	ProposalTypeSetMintAllowance ProposalType = "SetMintAllowance"
 )
 
 // EnableAllProposals contains all twasm gov types as keys.
 var EnableAllProposals = []ProposalType{
 	ProposalTypePromoteContract,
 	ProposalTypeDemoteContract,
+	ProposalTypeSetCallbackOrder,
+	ProposalTypeSetMintAllowance,
 }
 
 func init() { // register new content types with the sdk
 	govtypes.RegisterProposalType(string(ProposalTypePromoteContract))
 	govtypes.RegisterProposalType(string(ProposalTypeDemoteContract))
+	govtypes.RegisterProposalType(string(ProposalTypeSetCallbackOrder))
+	govtypes.RegisterProposalType(string(ProposalTypeSetMintAllowance))
 
 	govtypes.RegisterProposalTypeCodec(&PromoteToPrivilegedContractProposal{}, "twasm/PromoteToPrivilegedContractProposal")
 	govtypes.RegisterProposalTypeCodec(&DemotePrivilegedContractProposal{}, "twasm/DemotePrivilegedContractProposal")
+	govtypes.RegisterProposalTypeCodec(&SetPrivilegeCallbackOrderProposal{}, "twasm/SetPrivilegeCallbackOrderProposal")
+	govtypes.RegisterProposalTypeCodec(&SetMintAllowanceProposal{}, "twasm/SetMintAllowanceProposal")
 }
 
 // ProposalRoute returns the routing key of a parameter change proposal.
@@ -108,6 +118,106 @@ func (p DemotePrivilegedContractProposal) MarshalYAML() (interface{}, error) {
 	return p, nil
 }
 
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p SetPrivilegeCallbackOrderProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *SetPrivilegeCallbackOrderProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p SetPrivilegeCallbackOrderProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p SetPrivilegeCallbackOrderProposal) ProposalType() string {
+	return string(ProposalTypeSetCallbackOrder)
+}
+
+// ValidateBasic validates the proposal
+func (p SetPrivilegeCallbackOrderProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if PrivilegeTypeFrom(p.PrivilegeType) == nil {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "unknown privilege type: %q", p.PrivilegeType)
+	}
+	if len(p.Contracts) == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "contracts")
+	}
+	if len(p.Contracts) > math.MaxUint8 {
+		return sdkerrors.Wrapf(wasmtypes.ErrLimit, "max %d contracts", math.MaxUint8)
+	}
+	uniqueAddrs := make(map[string]struct{}, len(p.Contracts))
+	for _, a := range p.Contracts {
+		if _, err := sdk.AccAddressFromBech32(a); err != nil {
+			return sdkerrors.Wrapf(err, "contract %q", a)
+		}
+		if _, exists := uniqueAddrs[a]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "contract %q", a)
+		}
+		uniqueAddrs[a] = struct{}{}
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SetPrivilegeCallbackOrderProposal) String() string {
+	return fmt.Sprintf(`Set Privilege Callback Order Proposal:
+  Title:          %s
+  Description:    %s
+  Privilege Type: %s
+  Contracts:      %s
+`, p.Title, p.Description, p.PrivilegeType, strings.Join(p.Contracts, ", "))
+}
+
+// MarshalYAML pretty prints the wasm byte code
+func (p SetPrivilegeCallbackOrderProposal) MarshalYAML() (interface{}, error) {
+	return p, nil
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p SetMintAllowanceProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *SetMintAllowanceProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p SetMintAllowanceProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p SetMintAllowanceProposal) ProposalType() string {
+	return string(ProposalTypeSetMintAllowance)
+}
+
+// ValidateBasic validates the proposal
+func (p SetMintAllowanceProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return sdkerrors.Wrap(ValidateMintLimits(p.Limits), "limits")
+}
+
+// String implements the Stringer interface.
+func (p SetMintAllowanceProposal) String() string {
+	limits := make([]string, len(p.Limits))
+	for i, l := range p.Limits {
+		limits[i] = l.String()
+	}
+	return fmt.Sprintf(`Set Mint Allowance Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Limits:      %s
+`, p.Title, p.Description, p.Contract, strings.Join(limits, ", "))
+}
+
+// MarshalYAML pretty prints the wasm byte code
+func (p SetMintAllowanceProposal) MarshalYAML() (interface{}, error) {
+	return p, nil
+}
+
 // common validations
 func validateProposalCommons(title, description string) error {
 	if strings.TrimSpace(title) != title {
@@ -127,9 +127,113 @@ func (m *DemotePrivilegedContractProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DemotePrivilegedContractProposal proto.InternalMessageInfo
 
+// SetPrivilegeCallbackOrderProposal gov proposal content type to set the
+// callback order for all contracts registered for a privilege type
+type SetPrivilegeCallbackOrderProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// PrivilegeType is the name of the privilege type to reorder
+	PrivilegeType string `protobuf:"bytes,3,opt,name=privilege_type,json=privilegeType,proto3" json:"privilege_type,omitempty" yaml:"privilege_type"`
+	// Contracts are the addresses of all contracts registered for the privilege
+	// type in the new callback order
+	Contracts []string `protobuf:"bytes,4,rep,name=contracts,proto3" json:"contracts,omitempty" yaml:"contracts"`
+}
+
+func (m *SetPrivilegeCallbackOrderProposal) Reset()      { *m = SetPrivilegeCallbackOrderProposal{} }
+func (*SetPrivilegeCallbackOrderProposal) ProtoMessage() {}
+func (*SetPrivilegeCallbackOrderProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77ea8b6359ab7726, []int{2}
+}
+
+func (m *SetPrivilegeCallbackOrderProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SetPrivilegeCallbackOrderProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPrivilegeCallbackOrderProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SetPrivilegeCallbackOrderProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPrivilegeCallbackOrderProposal.Merge(m, src)
+}
+
+func (m *SetPrivilegeCallbackOrderProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SetPrivilegeCallbackOrderProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPrivilegeCallbackOrderProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPrivilegeCallbackOrderProposal proto.InternalMessageInfo
+
+// SetMintAllowanceProposal gov proposal content type to set the mint
+// allowance for a contract with the token minter privilege
+type SetMintAllowanceProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// Limits for each denom that the contract can mint. Any existing limits are
+	// replaced. An empty list prevents the contract from minting.
+	Limits []MintLimit `protobuf:"bytes,4,rep,name=limits,proto3" json:"limits" yaml:"limits"`
+}
+
+func (m *SetMintAllowanceProposal) Reset()      { *m = SetMintAllowanceProposal{} }
+func (*SetMintAllowanceProposal) ProtoMessage() {}
+func (*SetMintAllowanceProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77ea8b6359ab7726, []int{3}
+}
+
+func (m *SetMintAllowanceProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SetMintAllowanceProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetMintAllowanceProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SetMintAllowanceProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMintAllowanceProposal.Merge(m, src)
+}
+
+func (m *SetMintAllowanceProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SetMintAllowanceProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMintAllowanceProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMintAllowanceProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*PromoteToPrivilegedContractProposal)(nil), "confio.twasm.v1beta1.PromoteToPrivilegedContractProposal")
 	proto.RegisterType((*DemotePrivilegedContractProposal)(nil), "confio.twasm.v1beta1.DemotePrivilegedContractProposal")
+	proto.RegisterType((*SetPrivilegeCallbackOrderProposal)(nil), "confio.twasm.v1beta1.SetPrivilegeCallbackOrderProposal")
+	proto.RegisterType((*SetMintAllowanceProposal)(nil), "confio.twasm.v1beta1.SetMintAllowanceProposal")
 }
 
 func init() {
@@ -137,29 +241,38 @@ func init() {
 }
 
 var fileDescriptor_77ea8b6359ab7726 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x92, 0x4d, 0x4a, 0xc3, 0x40,
-	0x14, 0x80, 0x33, 0x8a, 0xa2, 0x51, 0x50, 0x62, 0x91, 0x5a, 0x64, 0x5a, 0x52, 0x28, 0xae, 0x32,
-	0x14, 0x37, 0xe2, 0xb2, 0xba, 0x74, 0x51, 0x8a, 0x2b, 0x37, 0x32, 0x49, 0xa7, 0xe3, 0x40, 0x92,
-	0x17, 0x66, 0xa6, 0xd5, 0xde, 0xc2, 0x63, 0x78, 0x01, 0xc1, 0x23, 0x74, 0xd9, 0x65, 0x57, 0xc5,
-	0xa6, 0x37, 0xe8, 0x09, 0xa4, 0x33, 0xd3, 0xd2, 0x2b, 0xb8, 0xcb, 0xcb, 0xf7, 0xbd, 0x9f, 0xe1,
-	0x3d, 0xbf, 0x99, 0x40, 0x3e, 0x10, 0x40, 0xf4, 0x3b, 0x55, 0x19, 0x19, 0xb5, 0x63, 0xa6, 0x69,
-	0x9b, 0x14, 0x12, 0x0a, 0x50, 0x34, 0x8d, 0x0a, 0x09, 0x1a, 0x82, 0x8a, 0x95, 0x22, 0x23, 0x45,
-	0x4e, 0xaa, 0x55, 0x38, 0x70, 0x30, 0x02, 0x59, 0x7f, 0x59, 0xb7, 0x86, 0x13, 0x50, 0x19, 0x28,
-	0x12, 0x53, 0xc5, 0xb6, 0xf5, 0x12, 0x10, 0xb9, 0xe3, 0xd7, 0x6b, 0x6e, 0x9a, 0xb9, 0x8e, 0x44,
-	0x8f, 0x0b, 0xa6, 0x1c, 0xbd, 0xb2, 0xd9, 0xaf, 0xb6, 0xac, 0x0d, 0x36, 0x88, 0x03, 0xf0, 0x94,
-	0x11, 0x13, 0xc5, 0xc3, 0x01, 0xa1, 0xf9, 0xd8, 0xa2, 0xf0, 0x07, 0xf9, 0xcd, 0xae, 0x84, 0x0c,
-	0x34, 0x7b, 0x86, 0xae, 0x14, 0x23, 0x91, 0x32, 0xce, 0xfa, 0x0f, 0x90, 0x6b, 0x49, 0x13, 0xdd,
-	0x75, 0xaf, 0x09, 0x5a, 0xfe, 0x81, 0x16, 0x3a, 0x65, 0x55, 0xd4, 0x40, 0x37, 0xc7, 0x9d, 0xf3,
-	0xd5, 0xbc, 0x7e, 0x3a, 0xa6, 0x59, 0x7a, 0x1f, 0x9a, 0xdf, 0x61, 0xcf, 0xe2, 0xe0, 0xce, 0x3f,
-	0xe9, 0x33, 0x95, 0x48, 0x51, 0x68, 0x01, 0x79, 0x75, 0xcf, 0xd8, 0x97, 0xab, 0x79, 0x3d, 0xb0,
-	0xf6, 0x0e, 0x0c, 0x7b, 0xbb, 0x6a, 0x40, 0xfc, 0xa3, 0xc4, 0x75, 0xad, 0xee, 0x9b, 0xb4, 0x8b,
-	0xd5, 0xbc, 0x7e, 0x66, 0xd3, 0x36, 0x24, 0xec, 0x6d, 0xa5, 0xf0, 0x1b, 0xf9, 0x8d, 0x47, 0xb6,
-	0x9e, 0xfc, 0x5f, 0xcd, 0xdd, 0x79, 0x9a, 0x2c, 0xb0, 0x37, 0x5b, 0x60, 0xef, 0xab, 0xc4, 0x68,
-	0x52, 0x62, 0x34, 0x2d, 0x31, 0xfa, 0x2d, 0x31, 0xfa, 0x5c, 0x62, 0x6f, 0xba, 0xc4, 0xde, 0x6c,
-	0x89, 0xbd, 0x97, 0x16, 0x17, 0xfa, 0x6d, 0x18, 0x47, 0x09, 0x64, 0x64, 0x73, 0x68, 0x5c, 0xd2,
-	0x3e, 0x23, 0x1f, 0xee, 0xe2, 0xcc, 0xf2, 0xe3, 0x43, 0xb3, 0xc7, 0xdb, 0xbf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x6d, 0x82, 0xb6, 0xb6, 0x8e, 0x02, 0x00, 0x00,
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x63, 0x06, 0x13, 0xf5, 0x18, 0x4c, 0xa1, 0x43, 0xdd, 0x84, 0x9c, 0xe2, 0x49, 0xb0,
+	0x53, 0xac, 0x8d, 0x0b, 0xe2, 0x04, 0x1d, 0x47, 0x06, 0x55, 0xb6, 0x13, 0x97, 0xc9, 0x49, 0xdd,
+	0x60, 0xe1, 0xe4, 0x8b, 0x12, 0x77, 0x23, 0x6f, 0xc1, 0x63, 0xf0, 0x02, 0x48, 0x3c, 0x42, 0x8f,
+	0x3b, 0xee, 0x14, 0xb1, 0xf6, 0x0d, 0x72, 0x45, 0x48, 0x28, 0xb1, 0x5b, 0x36, 0x69, 0x67, 0x04,
+	0x97, 0xaa, 0xf6, 0xff, 0xf7, 0x7d, 0xdf, 0xff, 0xaf, 0xe4, 0x0b, 0xde, 0x89, 0x20, 0x1d, 0x4b,
+	0x60, 0xfa, 0x8c, 0x17, 0x09, 0x3b, 0xdd, 0x0b, 0x85, 0xe6, 0x7b, 0x2c, 0xcb, 0x21, 0x83, 0x82,
+	0x2b, 0x3f, 0xcb, 0x41, 0x83, 0xdb, 0x35, 0x90, 0xdf, 0x42, 0xbe, 0x85, 0xb6, 0xbb, 0x31, 0xc4,
+	0xd0, 0x02, 0xac, 0xf9, 0x67, 0xd8, 0x6d, 0x12, 0x41, 0x91, 0x40, 0xc1, 0x42, 0x5e, 0x88, 0x65,
+	0xbf, 0x08, 0x64, 0x6a, 0xf5, 0xc7, 0x8d, 0xde, 0x0e, 0xb3, 0x13, 0x99, 0x2e, 0x33, 0x51, 0x58,
+	0x75, 0xcb, 0x54, 0x9f, 0x98, 0xb6, 0xe6, 0xb0, 0x90, 0x62, 0x80, 0x58, 0x09, 0xd6, 0x9e, 0xc2,
+	0xc9, 0x98, 0xf1, 0xb4, 0xb4, 0x92, 0x77, 0x63, 0x88, 0x44, 0xa6, 0xda, 0x00, 0xf4, 0x3b, 0xc2,
+	0x3b, 0xc3, 0x1c, 0x12, 0xd0, 0xe2, 0x18, 0x86, 0xb9, 0x3c, 0x95, 0x4a, 0xc4, 0x62, 0x74, 0x00,
+	0xa9, 0xce, 0x79, 0xa4, 0x87, 0x36, 0xae, 0xfb, 0x14, 0xdf, 0xd1, 0x52, 0x2b, 0xd1, 0x43, 0x7d,
+	0xb4, 0xdb, 0x19, 0x6c, 0xd4, 0x95, 0x77, 0xaf, 0xe4, 0x89, 0x7a, 0x49, 0xdb, 0x6b, 0x1a, 0x18,
+	0xd9, 0x7d, 0x81, 0xd7, 0x46, 0xa2, 0x88, 0x72, 0x99, 0x69, 0x09, 0x69, 0xef, 0x56, 0x4b, 0x3f,
+	0xaa, 0x2b, 0xcf, 0x35, 0xf4, 0x15, 0x91, 0x06, 0x57, 0x51, 0x97, 0xe1, 0xbb, 0x91, 0x9d, 0xda,
+	0x5b, 0x69, 0xcb, 0x1e, 0xd6, 0x95, 0xf7, 0xc0, 0x94, 0x2d, 0x14, 0x1a, 0x2c, 0x21, 0xfa, 0x0d,
+	0xe1, 0xfe, 0x1b, 0xd1, 0x38, 0xff, 0xbf, 0x7c, 0xff, 0x44, 0xf8, 0xc9, 0x91, 0xd0, 0x4b, 0xd3,
+	0x07, 0x5c, 0xa9, 0x90, 0x47, 0x9f, 0xde, 0xe7, 0x23, 0x91, 0xff, 0x45, 0xe3, 0xaf, 0xf0, 0xfd,
+	0x6c, 0xe1, 0xe1, 0xa4, 0x79, 0xd5, 0xac, 0xfd, 0xad, 0xba, 0xf2, 0x36, 0x4d, 0xf1, 0x75, 0x9d,
+	0x06, 0xeb, 0xcb, 0x8b, 0xe3, 0x32, 0x13, 0xee, 0x3e, 0xee, 0x2c, 0x52, 0x15, 0xbd, 0xdb, 0xfd,
+	0x95, 0xdd, 0xce, 0xa0, 0x5b, 0x57, 0xde, 0xc6, 0xf5, 0xec, 0x05, 0x0d, 0xfe, 0x60, 0xf4, 0x17,
+	0xc2, 0xbd, 0x23, 0xa1, 0x0f, 0x65, 0xaa, 0x5f, 0x2b, 0x05, 0x67, 0x3c, 0x8d, 0xc4, 0x3f, 0xfc,
+	0xb4, 0xdc, 0x77, 0x78, 0x55, 0xc9, 0x44, 0xda, 0x80, 0x6b, 0xfb, 0x9e, 0x7f, 0xd3, 0xca, 0xfb,
+	0x4d, 0x9e, 0xb7, 0x0d, 0x37, 0xd8, 0x9c, 0x56, 0x9e, 0x53, 0x57, 0xde, 0xba, 0xe9, 0x69, 0x8a,
+	0x69, 0x60, 0xbb, 0x0c, 0x0e, 0xa7, 0x97, 0xc4, 0xb9, 0xb8, 0x24, 0xce, 0xd7, 0x19, 0x41, 0xd3,
+	0x19, 0x41, 0xe7, 0x33, 0x82, 0x7e, 0xcc, 0x08, 0xfa, 0x32, 0x27, 0xce, 0xf9, 0x9c, 0x38, 0x17,
+	0x73, 0xe2, 0x7c, 0x78, 0x16, 0x4b, 0xfd, 0x71, 0x12, 0xfa, 0x11, 0x24, 0x0c, 0xd4, 0x68, 0x3c,
+	0xc9, 0x4b, 0xce, 0xcc, 0xef, 0x67, 0xbb, 0xcc, 0xed, 0xc7, 0x21, 0x5c, 0x6d, 0xd7, 0xf8, 0xf9,
+	0xef, 0x01, 0x00, 0xc4, 0x24, 0x16, 0xa4, 0xae, 0x04, 0x00, 0x00,
 }
 
 func (this *PromoteToPrivilegedContractProposal) Equal(that interface{}) bool {
@@ -224,6 +337,84 @@ func (this *DemotePrivilegedContractProposal) Equal(that interface{}) bool {
 	return true
 }
 
+func (this *SetPrivilegeCallbackOrderProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPrivilegeCallbackOrderProposal)
+	if !ok {
+		that2, ok := that.(SetPrivilegeCallbackOrderProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PrivilegeType != that1.PrivilegeType {
+		return false
+	}
+	if len(this.Contracts) != len(that1.Contracts) {
+		return false
+	}
+	for i := range this.Contracts {
+		if this.Contracts[i] != that1.Contracts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *SetMintAllowanceProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetMintAllowanceProposal)
+	if !ok {
+		that2, ok := that.(SetMintAllowanceProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if len(this.Limits) != len(that1.Limits) {
+		return false
+	}
+	for i := range this.Limits {
+		if !this.Limits[i].Equal(&that1.Limits[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (m *PromoteToPrivilegedContractProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -312,6 +503,117 @@ func (m *DemotePrivilegedContractProposal) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *SetPrivilegeCallbackOrderProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPrivilegeCallbackOrderProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPrivilegeCallbackOrderProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PrivilegeType) > 0 {
+		i -= len(m.PrivilegeType)
+		copy(dAtA[i:], m.PrivilegeType)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.PrivilegeType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetMintAllowanceProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMintAllowanceProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMintAllowanceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposal(v)
 	base := offset
@@ -366,6 +668,60 @@ func (m *DemotePrivilegedContractProposal) Size() (n int) {
 	return n
 }
 
+func (m *SetPrivilegeCallbackOrderProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.PrivilegeType)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetMintAllowanceProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -668,6 +1024,366 @@ func (m *DemotePrivilegedContractProposal) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *SetPrivilegeCallbackOrderProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPrivilegeCallbackOrderProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPrivilegeCallbackOrderProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SetMintAllowanceProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetMintAllowanceProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetMintAllowanceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limits = append(m.Limits, MintLimit{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
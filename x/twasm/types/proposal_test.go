@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -90,6 +91,141 @@ func TestValidateDemotePrivilegedContractProposal(t *testing.T) {
 	}
 }
 
+func TestValidateSetPrivilegeCallbackOrderProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *SetPrivilegeCallbackOrderProposal
+		expErr bool
+	}{
+		"all good": {
+			src: SetPrivilegeCallbackOrderProposalFixture(),
+		},
+		"single contract": {
+			src: SetPrivilegeCallbackOrderProposalFixture(func(p *SetPrivilegeCallbackOrderProposal) {
+				p.Contracts = p.Contracts[0:1]
+			}),
+		},
+		"empty contracts": {
+			src: SetPrivilegeCallbackOrderProposalFixture(func(p *SetPrivilegeCallbackOrderProposal) {
+				p.Contracts = nil
+			}),
+			expErr: true,
+		},
+		"duplicate contracts": {
+			src: SetPrivilegeCallbackOrderProposalFixture(func(p *SetPrivilegeCallbackOrderProposal) {
+				p.Contracts = append(p.Contracts, p.Contracts[0])
+			}),
+			expErr: true,
+		},
+		"invalid contract address": {
+			src: SetPrivilegeCallbackOrderProposalFixture(func(p *SetPrivilegeCallbackOrderProposal) {
+				p.Contracts = append(p.Contracts, "invalid address")
+			}),
+			expErr: true,
+		},
+		"unknown privilege type": {
+			src: SetPrivilegeCallbackOrderProposalFixture(func(p *SetPrivilegeCallbackOrderProposal) {
+				p.PrivilegeType = "unknown"
+			}),
+			expErr: true,
+		},
+		"empty privilege type": {
+			src: SetPrivilegeCallbackOrderProposalFixture(func(p *SetPrivilegeCallbackOrderProposal) {
+				p.PrivilegeType = ""
+			}),
+			expErr: true,
+		},
+		"base data missing": {
+			src: SetPrivilegeCallbackOrderProposalFixture(func(p *SetPrivilegeCallbackOrderProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetMintAllowanceProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *SetMintAllowanceProposal
+		expErr bool
+	}{
+		"all good": {
+			src: SetMintAllowanceProposalFixture(),
+		},
+		"empty limits": {
+			src: SetMintAllowanceProposalFixture(func(p *SetMintAllowanceProposal) {
+				p.Limits = nil
+			}),
+		},
+		"unlimited denom": {
+			src: SetMintAllowanceProposalFixture(func(p *SetMintAllowanceProposal) {
+				p.Limits = []MintLimit{{Denom: "ufury"}}
+			}),
+		},
+		"duplicate denom": {
+			src: SetMintAllowanceProposalFixture(func(p *SetMintAllowanceProposal) {
+				p.Limits = append(p.Limits, p.Limits[0])
+			}),
+			expErr: true,
+		},
+		"invalid denom": {
+			src: SetMintAllowanceProposalFixture(func(p *SetMintAllowanceProposal) {
+				p.Limits[0].Denom = "&&&"
+			}),
+			expErr: true,
+		},
+		"negative total cap": {
+			src: SetMintAllowanceProposalFixture(func(p *SetMintAllowanceProposal) {
+				p.Limits[0].TotalCap = sdk.NewInt(-1)
+			}),
+			expErr: true,
+		},
+		"negative rate limit": {
+			src: SetMintAllowanceProposalFixture(func(p *SetMintAllowanceProposal) {
+				p.Limits[0].RateLimit = sdk.NewInt(-1)
+			}),
+			expErr: true,
+		},
+		"rate limit without period": {
+			src: SetMintAllowanceProposalFixture(func(p *SetMintAllowanceProposal) {
+				p.Limits[0].RatePeriod = 0
+			}),
+			expErr: true,
+		},
+		"invalid contract address": {
+			src: SetMintAllowanceProposalFixture(func(p *SetMintAllowanceProposal) {
+				p.Contract = "invalid address"
+			}),
+			expErr: true,
+		},
+		"base data missing": {
+			src: SetMintAllowanceProposalFixture(func(p *SetMintAllowanceProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestProposalYaml(t *testing.T) {
 	specs := map[string]struct {
 		src govtypes.Content
@@ -107,6 +243,28 @@ contract: cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du
 			exp: `title: Foo
 description: Bar
 contract: cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du
+`,
+		},
+		"set privilege callback order proposal": {
+			src: SetPrivilegeCallbackOrderProposalFixture(),
+			exp: `title: Foo
+description: Bar
+privilege_type: begin_blocker
+contracts:
+- cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du
+- cosmos1qgpqyqszqgpqyqszqgpqyqszqgpqyqszrh8mx2
+`,
+		},
+		"set mint allowance proposal": {
+			src: SetMintAllowanceProposalFixture(),
+			exp: `title: Foo
+description: Bar
+contract: cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du
+limits:
+- denom: ufury
+  total_cap: "1000000"
+  rate_limit: "1000"
+  rate_period: 100
 `,
 		},
 	}
@@ -11,7 +11,7 @@ import (
 	math_bits "math/bits"
 
 	_ "github.com/CosmWasm/wasmd/x/wasm/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -37,7 +37,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // QueryPrivilegedContractsResponse is the request type for the
 // Query/PrivilegedContracts RPC method
-type QueryPrivilegedContractsRequest struct{}
+type QueryPrivilegedContractsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
 
 func (m *QueryPrivilegedContractsRequest) Reset()         { *m = QueryPrivilegedContractsRequest{} }
 func (m *QueryPrivilegedContractsRequest) String() string { return proto.CompactTextString(m) }
@@ -77,11 +80,20 @@ func (m *QueryPrivilegedContractsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryPrivilegedContractsRequest proto.InternalMessageInfo
 
+func (m *QueryPrivilegedContractsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryPrivilegedContractsResponse is the response type for the
 // Query/PrivilegedContracts RPC method
 type QueryPrivilegedContractsResponse struct {
 	// contracts are a set of contract addresses
 	Contracts []string `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryPrivilegedContractsResponse) Reset()         { *m = QueryPrivilegedContractsResponse{} }
@@ -129,10 +141,19 @@ func (m *QueryPrivilegedContractsResponse) GetContracts() []string {
 	return nil
 }
 
+func (m *QueryPrivilegedContractsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryContractsByPrivilegeTypeRequest is the request type for the
 // Query/ContractsByPrivilegeType RPC method
 type QueryContractsByPrivilegeTypeRequest struct {
 	PrivilegeType string `protobuf:"bytes,1,opt,name=privilege_type,json=privilegeType,proto3" json:"privilege_type,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryContractsByPrivilegeTypeRequest) Reset()         { *m = QueryContractsByPrivilegeTypeRequest{} }
@@ -180,11 +201,23 @@ func (m *QueryContractsByPrivilegeTypeRequest) GetPrivilegeType() string {
 	return ""
 }
 
+func (m *QueryContractsByPrivilegeTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryContractsByPrivilegeTypeResponse is the response type for the
 // Query/ContractsByPrivilegeType RPC method
 type QueryContractsByPrivilegeTypeResponse struct {
-	// contracts are a set of contract addresses
+	// contracts are a set of contract addresses, ordered by position
 	Contracts []string `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// positions are the contract addresses with their position, ordered by
+	// position
+	Positions []PrivilegedContractPosition `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
 }
 
 func (m *QueryContractsByPrivilegeTypeResponse) Reset()         { *m = QueryContractsByPrivilegeTypeResponse{} }
@@ -232,357 +265,4569 @@ func (m *QueryContractsByPrivilegeTypeResponse) GetContracts() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryPrivilegedContractsRequest)(nil), "confio.twasm.v1beta1.QueryPrivilegedContractsRequest")
-	proto.RegisterType((*QueryPrivilegedContractsResponse)(nil), "confio.twasm.v1beta1.QueryPrivilegedContractsResponse")
-	proto.RegisterType((*QueryContractsByPrivilegeTypeRequest)(nil), "confio.twasm.v1beta1.QueryContractsByPrivilegeTypeRequest")
-	proto.RegisterType((*QueryContractsByPrivilegeTypeResponse)(nil), "confio.twasm.v1beta1.QueryContractsByPrivilegeTypeResponse")
+func (m *QueryContractsByPrivilegeTypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("confio/twasm/v1beta1/query.proto", fileDescriptor_1dcfe179625ad95e) }
+func (m *QueryContractsByPrivilegeTypeResponse) GetPositions() []PrivilegedContractPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
 
-var fileDescriptor_1dcfe179625ad95e = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0xcf, 0x4b,
-	0xcb, 0xcc, 0xd7, 0x2f, 0x29, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0xa8,
-	0xd0, 0x03, 0xab, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd0, 0x07,
-	0xb1, 0x20, 0x6a, 0xa5, 0x64, 0x92, 0xf3, 0x8b, 0x73, 0xc1, 0x26, 0x41, 0x8d, 0xd3, 0x2f, 0xa9,
-	0x2c, 0x48, 0x2d, 0x86, 0xc9, 0xa6, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea,
-	0x27, 0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0xc1, 0x64, 0xb5, 0x40, 0x7a, 0xf3,
-	0x8b, 0xf5, 0x93, 0x12, 0x8b, 0x53, 0x21, 0x0e, 0x80, 0x3b, 0xa7, 0x20, 0x31, 0x3d, 0x33, 0x0f,
-	0xac, 0x18, 0xa2, 0x56, 0x49, 0x91, 0x4b, 0x3e, 0x10, 0xa4, 0x22, 0xa0, 0x28, 0xb3, 0x2c, 0x33,
-	0x27, 0x35, 0x3d, 0x35, 0xc5, 0x39, 0x3f, 0xaf, 0xa4, 0x28, 0x31, 0xb9, 0xa4, 0x38, 0x28, 0xb5,
-	0xb0, 0x34, 0xb5, 0xb8, 0x44, 0xc9, 0x81, 0x4b, 0x01, 0xb7, 0x92, 0xe2, 0x82, 0xfc, 0xbc, 0xe2,
-	0x54, 0x21, 0x19, 0x2e, 0xce, 0x64, 0x98, 0xa0, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0x67, 0x10, 0x42,
-	0x40, 0xc9, 0x97, 0x4b, 0x05, 0x6c, 0x02, 0x5c, 0x9f, 0x13, 0xc2, 0xb0, 0x90, 0xca, 0x82, 0x54,
-	0xa8, 0x4d, 0x42, 0xaa, 0x5c, 0x7c, 0x05, 0x30, 0xf1, 0x78, 0x90, 0x7f, 0x25, 0x18, 0x15, 0x18,
-	0x35, 0x38, 0x83, 0x78, 0x0b, 0x90, 0x55, 0x2b, 0xb9, 0x72, 0xa9, 0x12, 0x30, 0x8e, 0x18, 0x57,
-	0x19, 0xcd, 0x61, 0xe6, 0x62, 0x05, 0x9b, 0x23, 0xb4, 0x83, 0x91, 0x4b, 0x18, 0x8b, 0xef, 0x84,
-	0x4c, 0xf5, 0xb0, 0xc5, 0x98, 0x1e, 0x81, 0x00, 0x93, 0x32, 0x23, 0x55, 0x1b, 0xc4, 0xb9, 0x4a,
-	0x46, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0xd2, 0x11, 0xd2, 0xd2, 0x2f, 0x49, 0x2f, 0x4a, 0x4c, 0x49,
-	0x45, 0x4b, 0x4a, 0x70, 0x97, 0xeb, 0xc3, 0x83, 0x23, 0x45, 0xe8, 0x3a, 0x23, 0x97, 0x04, 0xae,
-	0x70, 0x10, 0xb2, 0xc2, 0xe3, 0x10, 0x02, 0x71, 0x21, 0x65, 0x4d, 0x96, 0x5e, 0xa8, 0x4f, 0x9c,
-	0xc0, 0x3e, 0xb1, 0x11, 0xb2, 0x22, 0xda, 0x27, 0xfa, 0xd5, 0xa8, 0x31, 0x5f, 0xeb, 0xe4, 0x70,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xb0, 0x4c, 0x07, 0xb1, 0xa6, 0x02, 0x6a, 0x11, 0x38, 0xaf,
-	0x24, 0xb1, 0x81, 0x93, 0xb8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x07, 0x61, 0xc0, 0x9a,
-	0x03, 0x00, 0x00,
+// PrivilegedContractPosition is a contract registered for a privilege type
+type PrivilegedContractPosition struct {
+	// Address is the contract address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Position is the position of the registration. Callbacks are executed in
+	// ascending order
+	Position uint32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *PrivilegedContractPosition) Reset()         { *m = PrivilegedContractPosition{} }
+func (m *PrivilegedContractPosition) String() string { return proto.CompactTextString(m) }
+func (*PrivilegedContractPosition) ProtoMessage()    {}
+func (*PrivilegedContractPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{4}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *PrivilegedContractPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// PrivilegedContracts returns all privileged contracts
-	PrivilegedContracts(ctx context.Context, in *QueryPrivilegedContractsRequest, opts ...grpc.CallOption) (*QueryPrivilegedContractsResponse, error)
-	// ContractsByPrivilegeType returns all contracts that have registered for the
-	// privilege type
-	ContractsByPrivilegeType(ctx context.Context, in *QueryContractsByPrivilegeTypeRequest, opts ...grpc.CallOption) (*QueryContractsByPrivilegeTypeResponse, error)
+func (m *PrivilegedContractPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivilegedContractPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *PrivilegedContractPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivilegedContractPosition.Merge(m, src)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *PrivilegedContractPosition) XXX_Size() int {
+	return m.Size()
 }
 
-func (c *queryClient) PrivilegedContracts(ctx context.Context, in *QueryPrivilegedContractsRequest, opts ...grpc.CallOption) (*QueryPrivilegedContractsResponse, error) {
-	out := new(QueryPrivilegedContractsResponse)
-	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/PrivilegedContracts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PrivilegedContractPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivilegedContractPosition.DiscardUnknown(m)
 }
 
-func (c *queryClient) ContractsByPrivilegeType(ctx context.Context, in *QueryContractsByPrivilegeTypeRequest, opts ...grpc.CallOption) (*QueryContractsByPrivilegeTypeResponse, error) {
-	out := new(QueryContractsByPrivilegeTypeResponse)
-	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/ContractsByPrivilegeType", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PrivilegedContractPosition proto.InternalMessageInfo
+
+func (m *PrivilegedContractPosition) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// PrivilegedContracts returns all privileged contracts
-	PrivilegedContracts(context.Context, *QueryPrivilegedContractsRequest) (*QueryPrivilegedContractsResponse, error)
-	// ContractsByPrivilegeType returns all contracts that have registered for the
-	// privilege type
-	ContractsByPrivilegeType(context.Context, *QueryContractsByPrivilegeTypeRequest) (*QueryContractsByPrivilegeTypeResponse, error)
+func (m *PrivilegedContractPosition) GetPosition() uint32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct{}
+// QueryCallbackFailuresRequest is the request type for the
+// Query/CallbackFailures RPC method
+type QueryCallbackFailuresRequest struct{}
 
-func (*UnimplementedQueryServer) PrivilegedContracts(ctx context.Context, req *QueryPrivilegedContractsRequest) (*QueryPrivilegedContractsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PrivilegedContracts not implemented")
+func (m *QueryCallbackFailuresRequest) Reset()         { *m = QueryCallbackFailuresRequest{} }
+func (m *QueryCallbackFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbackFailuresRequest) ProtoMessage()    {}
+func (*QueryCallbackFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{5}
 }
 
-func (*UnimplementedQueryServer) ContractsByPrivilegeType(ctx context.Context, req *QueryContractsByPrivilegeTypeRequest) (*QueryContractsByPrivilegeTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractsByPrivilegeType not implemented")
+func (m *QueryCallbackFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryCallbackFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbackFailuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-func _Query_PrivilegedContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPrivilegedContractsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PrivilegedContracts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.twasm.v1beta1.Query/PrivilegedContracts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PrivilegedContracts(ctx, req.(*QueryPrivilegedContractsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryCallbackFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbackFailuresRequest.Merge(m, src)
 }
 
-func _Query_ContractsByPrivilegeType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContractsByPrivilegeTypeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractsByPrivilegeType(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.twasm.v1beta1.Query/ContractsByPrivilegeType",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractsByPrivilegeType(ctx, req.(*QueryContractsByPrivilegeTypeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryCallbackFailuresRequest) XXX_Size() int {
+	return m.Size()
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "confio.twasm.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PrivilegedContracts",
-			Handler:    _Query_PrivilegedContracts_Handler,
-		},
-		{
-			MethodName: "ContractsByPrivilegeType",
-			Handler:    _Query_ContractsByPrivilegeType_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "confio/twasm/v1beta1/query.proto",
+func (m *QueryCallbackFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbackFailuresRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPrivilegedContractsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_QueryCallbackFailuresRequest proto.InternalMessageInfo
+
+// QueryCallbackFailuresResponse is the response type for the
+// Query/CallbackFailures RPC method
+type QueryCallbackFailuresResponse struct {
+	// failures are the counters by contract and privilege type
+	Failures []CallbackFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures"`
 }
 
-func (m *QueryPrivilegedContractsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCallbackFailuresResponse) Reset()         { *m = QueryCallbackFailuresResponse{} }
+func (m *QueryCallbackFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbackFailuresResponse) ProtoMessage()    {}
+func (*QueryCallbackFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{6}
 }
 
-func (m *QueryPrivilegedContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCallbackFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QueryPrivilegedContractsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCallbackFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbackFailuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *QueryPrivilegedContractsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCallbackFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbackFailuresResponse.Merge(m, src)
 }
 
-func (m *QueryPrivilegedContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contracts) > 0 {
-		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Contracts[iNdEx])
-			copy(dAtA[i:], m.Contracts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Contracts[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *QueryCallbackFailuresResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryContractsByPrivilegeTypeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCallbackFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbackFailuresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCallbackFailuresResponse proto.InternalMessageInfo
+
+func (m *QueryCallbackFailuresResponse) GetFailures() []CallbackFailure {
+	if m != nil {
+		return m.Failures
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryContractsByPrivilegeTypeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CallbackFailure number of consecutive failed callbacks for a contract and
+// privilege type
+type CallbackFailure struct {
+	// ContractAddress is the address of the privileged contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// PrivilegeType is the privilege type of the failed callback
+	PrivilegeType string `protobuf:"bytes,2,opt,name=privilege_type,json=privilegeType,proto3" json:"privilege_type,omitempty"`
+	// Failures is the number of consecutive failed callbacks
+	Failures uint32 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
 }
 
-func (m *QueryContractsByPrivilegeTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PrivilegeType) > 0 {
-		i -= len(m.PrivilegeType)
-		copy(dAtA[i:], m.PrivilegeType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrivilegeType)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *CallbackFailure) Reset()         { *m = CallbackFailure{} }
+func (m *CallbackFailure) String() string { return proto.CompactTextString(m) }
+func (*CallbackFailure) ProtoMessage()    {}
+func (*CallbackFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{7}
 }
 
-func (m *QueryContractsByPrivilegeTypeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CallbackFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CallbackFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *QueryContractsByPrivilegeTypeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CallbackFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackFailure.Merge(m, src)
 }
 
-func (m *QueryContractsByPrivilegeTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contracts) > 0 {
-		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Contracts[iNdEx])
-			copy(dAtA[i:], m.Contracts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Contracts[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *CallbackFailure) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CallbackFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackFailure proto.InternalMessageInfo
+
+func (m *CallbackFailure) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *CallbackFailure) GetPrivilegeType() string {
+	if m != nil {
+		return m.PrivilegeType
+	}
+	return ""
+}
+
+func (m *CallbackFailure) GetFailures() uint32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+// QueryContractPrivilegesRequest is the request type for the
+// Query/ContractPrivileges RPC method
+type QueryContractPrivilegesRequest struct {
+	// address is the address of the contract to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryContractPrivilegesRequest) Reset()         { *m = QueryContractPrivilegesRequest{} }
+func (m *QueryContractPrivilegesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractPrivilegesRequest) ProtoMessage()    {}
+func (*QueryContractPrivilegesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{8}
+}
+
+func (m *QueryContractPrivilegesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryContractPrivilegesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractPrivilegesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryContractPrivilegesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractPrivilegesRequest.Merge(m, src)
+}
+
+func (m *QueryContractPrivilegesRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryContractPrivilegesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractPrivilegesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractPrivilegesRequest proto.InternalMessageInfo
+
+func (m *QueryContractPrivilegesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
 
-func (m *QueryPrivilegedContractsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryContractPrivilegesResponse is the response type for the
+// Query/ContractPrivileges RPC method
+type QueryContractPrivilegesResponse struct {
+	// privileged is true when the privileged flag is set for the contract
+	Privileged bool `protobuf:"varint,1,opt,name=privileged,proto3" json:"privileged,omitempty"`
+	// pinned is true when the contract code is pinned to the wasm cache
+	Pinned bool `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// privileges are the privileges registered by the contract
+	Privileges []ContractPrivilege `protobuf:"bytes,3,rep,name=privileges,proto3" json:"privileges"`
+}
+
+func (m *QueryContractPrivilegesResponse) Reset()         { *m = QueryContractPrivilegesResponse{} }
+func (m *QueryContractPrivilegesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractPrivilegesResponse) ProtoMessage()    {}
+func (*QueryContractPrivilegesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{9}
+}
+
+func (m *QueryContractPrivilegesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryContractPrivilegesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractPrivilegesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
 }
 
-func (m *QueryPrivilegedContractsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryContractPrivilegesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractPrivilegesResponse.Merge(m, src)
+}
+
+func (m *QueryContractPrivilegesResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryContractPrivilegesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractPrivilegesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractPrivilegesResponse proto.InternalMessageInfo
+
+func (m *QueryContractPrivilegesResponse) GetPrivileged() bool {
+	if m != nil {
+		return m.Privileged
 	}
-	var l int
-	_ = l
-	if len(m.Contracts) > 0 {
-		for _, s := range m.Contracts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *QueryContractPrivilegesResponse) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+func (m *QueryContractPrivilegesResponse) GetPrivileges() []ContractPrivilege {
+	if m != nil {
+		return m.Privileges
+	}
+	return nil
+}
+
+// ContractPrivilege is a privilege registered by a contract
+type ContractPrivilege struct {
+	// PrivilegeType is the name of the privilege
+	PrivilegeType string `protobuf:"bytes,1,opt,name=privilege_type,json=privilegeType,proto3" json:"privilege_type,omitempty"`
+	// Position is the stored position of the registration
+	Position uint32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// CallbackOrder is the 1-based order in which the contract is called among
+	// all contracts registered for the privilege type
+	CallbackOrder uint32 `protobuf:"varint,3,opt,name=callback_order,json=callbackOrder,proto3" json:"callback_order,omitempty"`
+}
+
+func (m *ContractPrivilege) Reset()         { *m = ContractPrivilege{} }
+func (m *ContractPrivilege) String() string { return proto.CompactTextString(m) }
+func (*ContractPrivilege) ProtoMessage()    {}
+func (*ContractPrivilege) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{10}
+}
+
+func (m *ContractPrivilege) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractPrivilege) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractPrivilege.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ContractPrivilege) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractPrivilege.Merge(m, src)
+}
+
+func (m *ContractPrivilege) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractPrivilege) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractPrivilege.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractPrivilege proto.InternalMessageInfo
+
+func (m *ContractPrivilege) GetPrivilegeType() string {
+	if m != nil {
+		return m.PrivilegeType
+	}
+	return ""
+}
+
+func (m *ContractPrivilege) GetPosition() uint32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *ContractPrivilege) GetCallbackOrder() uint32 {
+	if m != nil {
+		return m.CallbackOrder
+	}
+	return 0
+}
+
+// QueryMintAllowanceRequest is the request type for the Query/MintAllowance
+// RPC method
+type QueryMintAllowanceRequest struct {
+	// address is the address of the contract to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryMintAllowanceRequest) Reset()         { *m = QueryMintAllowanceRequest{} }
+func (m *QueryMintAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintAllowanceRequest) ProtoMessage()    {}
+func (*QueryMintAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{11}
+}
+
+func (m *QueryMintAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryMintAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryMintAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintAllowanceRequest.Merge(m, src)
+}
+
+func (m *QueryMintAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryMintAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintAllowanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryMintAllowanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryMintAllowanceResponse is the response type for the Query/MintAllowance
+// RPC method
+type QueryMintAllowanceResponse struct {
+	// allowance is not set when the contract is not restricted
+	Allowance *MintAllowance `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// usages are the tokens minted by the contract for each denom
+	Usages []MintUsage `protobuf:"bytes,2,rep,name=usages,proto3" json:"usages"`
+}
+
+func (m *QueryMintAllowanceResponse) Reset()         { *m = QueryMintAllowanceResponse{} }
+func (m *QueryMintAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintAllowanceResponse) ProtoMessage()    {}
+func (*QueryMintAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{12}
+}
+
+func (m *QueryMintAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryMintAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryMintAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintAllowanceResponse.Merge(m, src)
+}
+
+func (m *QueryMintAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryMintAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryMintAllowanceResponse) GetAllowance() *MintAllowance {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+func (m *QueryMintAllowanceResponse) GetUsages() []MintUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
+// QueryScheduledCallbacksRequest is the request type for the
+// Query/ScheduledCallbacks RPC method
+type QueryScheduledCallbacksRequest struct {
+	// address is an optional contract address to filter by
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledCallbacksRequest) Reset()         { *m = QueryScheduledCallbacksRequest{} }
+func (m *QueryScheduledCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallbacksRequest) ProtoMessage()    {}
+func (*QueryScheduledCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{13}
+}
+
+func (m *QueryScheduledCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryScheduledCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryScheduledCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallbacksRequest.Merge(m, src)
+}
+
+func (m *QueryScheduledCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryScheduledCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallbacksRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledCallbacksRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryScheduledCallbacksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduledCallbacksResponse is the response type for the
+// Query/ScheduledCallbacks RPC method
+type QueryScheduledCallbacksResponse struct {
+	// callbacks are the pending scheduled callbacks
+	Callbacks []ScheduledCallback `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledCallbacksResponse) Reset()         { *m = QueryScheduledCallbacksResponse{} }
+func (m *QueryScheduledCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallbacksResponse) ProtoMessage()    {}
+func (*QueryScheduledCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{14}
+}
+
+func (m *QueryScheduledCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryScheduledCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryScheduledCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallbacksResponse.Merge(m, src)
+}
+
+func (m *QueryScheduledCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryScheduledCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallbacksResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledCallbacksResponse) GetCallbacks() []ScheduledCallback {
+	if m != nil {
+		return m.Callbacks
+	}
+	return nil
+}
+
+func (m *QueryScheduledCallbacksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTimelockedGovProposalsRequest is the request type for the
+// Query/TimelockedGovProposals RPC method
+type QueryTimelockedGovProposalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTimelockedGovProposalsRequest) Reset()         { *m = QueryTimelockedGovProposalsRequest{} }
+func (m *QueryTimelockedGovProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockedGovProposalsRequest) ProtoMessage()    {}
+func (*QueryTimelockedGovProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{15}
+}
+
+func (m *QueryTimelockedGovProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTimelockedGovProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockedGovProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTimelockedGovProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockedGovProposalsRequest.Merge(m, src)
+}
+
+func (m *QueryTimelockedGovProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTimelockedGovProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockedGovProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimelockedGovProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryTimelockedGovProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTimelockedGovProposalsResponse is the response type for the
+// Query/TimelockedGovProposals RPC method
+type QueryTimelockedGovProposalsResponse struct {
+	// proposals are the queued gov proposals
+	Proposals []TimelockedGovProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTimelockedGovProposalsResponse) Reset()         { *m = QueryTimelockedGovProposalsResponse{} }
+func (m *QueryTimelockedGovProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockedGovProposalsResponse) ProtoMessage()    {}
+func (*QueryTimelockedGovProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{16}
+}
+
+func (m *QueryTimelockedGovProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTimelockedGovProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockedGovProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTimelockedGovProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockedGovProposalsResponse.Merge(m, src)
+}
+
+func (m *QueryTimelockedGovProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTimelockedGovProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockedGovProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimelockedGovProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryTimelockedGovProposalsResponse) GetProposals() []TimelockedGovProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryTimelockedGovProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTimelockedGovProposalRequest is the request type for the
+// Query/TimelockedGovProposal RPC method
+type QueryTimelockedGovProposalRequest struct {
+	// id is the identifier of the queued proposal
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryTimelockedGovProposalRequest) Reset()         { *m = QueryTimelockedGovProposalRequest{} }
+func (m *QueryTimelockedGovProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockedGovProposalRequest) ProtoMessage()    {}
+func (*QueryTimelockedGovProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{17}
+}
+
+func (m *QueryTimelockedGovProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTimelockedGovProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockedGovProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTimelockedGovProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockedGovProposalRequest.Merge(m, src)
+}
+
+func (m *QueryTimelockedGovProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTimelockedGovProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockedGovProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimelockedGovProposalRequest proto.InternalMessageInfo
+
+func (m *QueryTimelockedGovProposalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryTimelockedGovProposalResponse is the response type for the
+// Query/TimelockedGovProposal RPC method
+type QueryTimelockedGovProposalResponse struct {
+	// proposal is the queued gov proposal
+	Proposal TimelockedGovProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+}
+
+func (m *QueryTimelockedGovProposalResponse) Reset()         { *m = QueryTimelockedGovProposalResponse{} }
+func (m *QueryTimelockedGovProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockedGovProposalResponse) ProtoMessage()    {}
+func (*QueryTimelockedGovProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{18}
+}
+
+func (m *QueryTimelockedGovProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTimelockedGovProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockedGovProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTimelockedGovProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockedGovProposalResponse.Merge(m, src)
+}
+
+func (m *QueryTimelockedGovProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTimelockedGovProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockedGovProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimelockedGovProposalResponse proto.InternalMessageInfo
+
+func (m *QueryTimelockedGovProposalResponse) GetProposal() TimelockedGovProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return TimelockedGovProposal{}
+}
+
+// QueryDelegatorGrantsRequest is the request type for the
+// Query/DelegatorGrants RPC method
+type QueryDelegatorGrantsRequest struct {
+	// staker is an optional staker address to filter by
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorGrantsRequest) Reset()         { *m = QueryDelegatorGrantsRequest{} }
+func (m *QueryDelegatorGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorGrantsRequest) ProtoMessage()    {}
+func (*QueryDelegatorGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{19}
+}
+
+func (m *QueryDelegatorGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryDelegatorGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryDelegatorGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorGrantsRequest.Merge(m, src)
+}
+
+func (m *QueryDelegatorGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryDelegatorGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorGrantsRequest) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *QueryDelegatorGrantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorGrantsResponse is the response type for the
+// Query/DelegatorGrants RPC method
+type QueryDelegatorGrantsResponse struct {
+	// grants are the delegator grants
+	Grants []DelegatorGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorGrantsResponse) Reset()         { *m = QueryDelegatorGrantsResponse{} }
+func (m *QueryDelegatorGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorGrantsResponse) ProtoMessage()    {}
+func (*QueryDelegatorGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{20}
+}
+
+func (m *QueryDelegatorGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryDelegatorGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryDelegatorGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorGrantsResponse.Merge(m, src)
+}
+
+func (m *QueryDelegatorGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryDelegatorGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorGrantsResponse) GetGrants() []DelegatorGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *QueryDelegatorGrantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPrivilegedContractsRequest)(nil), "confio.twasm.v1beta1.QueryPrivilegedContractsRequest")
+	proto.RegisterType((*QueryPrivilegedContractsResponse)(nil), "confio.twasm.v1beta1.QueryPrivilegedContractsResponse")
+	proto.RegisterType((*QueryContractsByPrivilegeTypeRequest)(nil), "confio.twasm.v1beta1.QueryContractsByPrivilegeTypeRequest")
+	proto.RegisterType((*QueryContractsByPrivilegeTypeResponse)(nil), "confio.twasm.v1beta1.QueryContractsByPrivilegeTypeResponse")
+	proto.RegisterType((*PrivilegedContractPosition)(nil), "confio.twasm.v1beta1.PrivilegedContractPosition")
+	proto.RegisterType((*QueryCallbackFailuresRequest)(nil), "confio.twasm.v1beta1.QueryCallbackFailuresRequest")
+	proto.RegisterType((*QueryCallbackFailuresResponse)(nil), "confio.twasm.v1beta1.QueryCallbackFailuresResponse")
+	proto.RegisterType((*CallbackFailure)(nil), "confio.twasm.v1beta1.CallbackFailure")
+	proto.RegisterType((*QueryContractPrivilegesRequest)(nil), "confio.twasm.v1beta1.QueryContractPrivilegesRequest")
+	proto.RegisterType((*QueryContractPrivilegesResponse)(nil), "confio.twasm.v1beta1.QueryContractPrivilegesResponse")
+	proto.RegisterType((*ContractPrivilege)(nil), "confio.twasm.v1beta1.ContractPrivilege")
+	proto.RegisterType((*QueryMintAllowanceRequest)(nil), "confio.twasm.v1beta1.QueryMintAllowanceRequest")
+	proto.RegisterType((*QueryMintAllowanceResponse)(nil), "confio.twasm.v1beta1.QueryMintAllowanceResponse")
+	proto.RegisterType((*QueryScheduledCallbacksRequest)(nil), "confio.twasm.v1beta1.QueryScheduledCallbacksRequest")
+	proto.RegisterType((*QueryScheduledCallbacksResponse)(nil), "confio.twasm.v1beta1.QueryScheduledCallbacksResponse")
+	proto.RegisterType((*QueryTimelockedGovProposalsRequest)(nil), "confio.twasm.v1beta1.QueryTimelockedGovProposalsRequest")
+	proto.RegisterType((*QueryTimelockedGovProposalsResponse)(nil), "confio.twasm.v1beta1.QueryTimelockedGovProposalsResponse")
+	proto.RegisterType((*QueryTimelockedGovProposalRequest)(nil), "confio.twasm.v1beta1.QueryTimelockedGovProposalRequest")
+	proto.RegisterType((*QueryTimelockedGovProposalResponse)(nil), "confio.twasm.v1beta1.QueryTimelockedGovProposalResponse")
+	proto.RegisterType((*QueryDelegatorGrantsRequest)(nil), "confio.twasm.v1beta1.QueryDelegatorGrantsRequest")
+	proto.RegisterType((*QueryDelegatorGrantsResponse)(nil), "confio.twasm.v1beta1.QueryDelegatorGrantsResponse")
+}
+
+func init() { proto.RegisterFile("confio/twasm/v1beta1/query.proto", fileDescriptor_1dcfe179625ad95e) }
+
+var fileDescriptor_1dcfe179625ad95e = []byte{
+	// 1207 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x4f, 0x24, 0x45,
+	0x14, 0xa6, 0x86, 0x15, 0x99, 0xb7, 0x61, 0x77, 0x2d, 0xd7, 0x0d, 0xb6, 0x38, 0x60, 0xb3, 0x08,
+	0xec, 0xc6, 0x69, 0x18, 0x16, 0x64, 0xd9, 0x68, 0x02, 0x1a, 0x38, 0x18, 0xb2, 0x38, 0xe2, 0xc5,
+	0xcb, 0xa4, 0x98, 0x2e, 0x86, 0x0e, 0x4d, 0x57, 0x6f, 0x57, 0x0f, 0x2b, 0x21, 0xc4, 0x64, 0x6f,
+	0xde, 0x4c, 0x8c, 0x57, 0x8f, 0x6a, 0xa2, 0x27, 0x3d, 0xec, 0xc9, 0x68, 0x3c, 0xed, 0x71, 0x13,
+	0x13, 0xe3, 0xc9, 0x18, 0xd8, 0x3f, 0xc4, 0x74, 0x75, 0x55, 0xcf, 0xaf, 0xea, 0x9e, 0x19, 0x32,
+	0xf1, 0x42, 0x98, 0x9a, 0xf7, 0xbd, 0xfa, 0xbe, 0xaf, 0x5e, 0xf5, 0x7b, 0xd3, 0x30, 0x55, 0x65,
+	0xde, 0xbe, 0xc3, 0xac, 0xf0, 0x31, 0xe1, 0x47, 0xd6, 0xf1, 0xe2, 0x1e, 0x0d, 0xc9, 0xa2, 0xf5,
+	0xa8, 0x4e, 0x83, 0x93, 0xa2, 0x1f, 0xb0, 0x90, 0xe1, 0x9b, 0x71, 0x44, 0x51, 0x44, 0x14, 0x65,
+	0x84, 0x71, 0xb3, 0xc6, 0x6a, 0x4c, 0x04, 0x58, 0xd1, 0x7f, 0x71, 0xac, 0x31, 0x51, 0x65, 0xfc,
+	0x48, 0x64, 0x92, 0xe9, 0xac, 0xf0, 0xc4, 0xa7, 0x5c, 0x7d, 0x5b, 0x63, 0xac, 0xe6, 0x52, 0x8b,
+	0xf8, 0x8e, 0x45, 0x3c, 0x8f, 0x85, 0x24, 0x74, 0x98, 0xa7, 0xbe, 0xbd, 0x13, 0x61, 0x19, 0xb7,
+	0xf6, 0x08, 0xa7, 0x31, 0x81, 0x84, 0x8e, 0x4f, 0x6a, 0x8e, 0x27, 0x82, 0x65, 0xec, 0xa4, 0x96,
+	0xf5, 0x91, 0xe3, 0x85, 0x32, 0x60, 0x5a, 0x1b, 0xc0, 0xab, 0x07, 0xd4, 0xae, 0xbb, 0x34, 0x33,
+	0x28, 0x74, 0x8e, 0xa8, 0xcb, 0xaa, 0x87, 0x0d, 0x5a, 0x9a, 0x20, 0x9b, 0xba, 0xb4, 0x46, 0x42,
+	0x16, 0x54, 0x6a, 0x01, 0x51, 0xbb, 0x9a, 0x0e, 0x4c, 0x7e, 0x1c, 0x11, 0xdf, 0x09, 0x9c, 0x63,
+	0xc7, 0xa5, 0x35, 0x6a, 0x7f, 0xc0, 0xbc, 0x30, 0x20, 0xd5, 0x90, 0x97, 0xe9, 0xa3, 0x3a, 0xe5,
+	0x21, 0xde, 0x04, 0x68, 0xa8, 0x19, 0x47, 0x53, 0x68, 0xee, 0x6a, 0xe9, 0xed, 0x62, 0x2c, 0xbd,
+	0x18, 0x49, 0x2f, 0xc6, 0xde, 0xcb, 0x8d, 0x8a, 0x3b, 0xa4, 0x46, 0x25, 0xb6, 0xdc, 0x84, 0x34,
+	0xbf, 0x44, 0x30, 0x95, 0xbe, 0x17, 0xf7, 0x99, 0xc7, 0x29, 0x9e, 0x80, 0x7c, 0x55, 0x2d, 0x8e,
+	0xa3, 0xa9, 0xe1, 0xb9, 0x7c, 0xb9, 0xb1, 0x80, 0xb7, 0x5a, 0xa8, 0xe4, 0x04, 0x95, 0xd9, 0xae,
+	0x54, 0xe2, 0xd4, 0x2d, 0x5c, 0xbe, 0x41, 0x70, 0x5b, 0x70, 0x49, 0x18, 0x6c, 0x34, 0x68, 0xed,
+	0x9e, 0xf8, 0x4a, 0x00, 0x9e, 0x81, 0x6b, 0xbe, 0x5a, 0xaf, 0x44, 0x95, 0x21, 0x0c, 0xc8, 0x97,
+	0xc7, 0xfc, 0xe6, 0x68, 0xbc, 0xa9, 0x21, 0x76, 0x19, 0x8f, 0x5e, 0x20, 0x98, 0xe9, 0xc2, 0xeb,
+	0x7f, 0x35, 0x0a, 0xef, 0x42, 0xde, 0x67, 0xdc, 0x89, 0xfe, 0xe7, 0xe3, 0xc3, 0x53, 0xc3, 0x73,
+	0x57, 0x4b, 0x0b, 0x45, 0xdd, 0xf5, 0x2a, 0x76, 0x9e, 0xea, 0x8e, 0x04, 0x6e, 0x5c, 0x79, 0xf6,
+	0xcf, 0xe4, 0x50, 0xb9, 0x91, 0xc8, 0x2c, 0x83, 0x91, 0x1e, 0x8e, 0xc7, 0xe1, 0x65, 0x62, 0xdb,
+	0x01, 0xe5, 0x5c, 0x9a, 0xad, 0x3e, 0x62, 0x03, 0x46, 0x55, 0x12, 0x21, 0x6a, 0xac, 0x9c, 0x7c,
+	0x36, 0x0b, 0x30, 0x11, 0x3b, 0x47, 0x5c, 0x77, 0x8f, 0x54, 0x0f, 0x37, 0x89, 0xe3, 0xd6, 0x03,
+	0xaa, 0xca, 0xd8, 0x3c, 0x80, 0x37, 0x53, 0xbe, 0x97, 0x8e, 0x6e, 0xc1, 0xe8, 0xbe, 0x5c, 0x13,
+	0x86, 0x5e, 0x2d, 0xcd, 0xe8, 0x95, 0xb6, 0x65, 0x90, 0xf2, 0x12, 0xb0, 0xf9, 0x05, 0x5c, 0x6f,
+	0x0b, 0xc1, 0xf3, 0x70, 0x43, 0x1d, 0x4e, 0xa5, 0x55, 0xdb, 0x75, 0xb5, 0xbe, 0x2e, 0x35, 0x76,
+	0x56, 0x5c, 0x4e, 0x57, 0x71, 0x46, 0x13, 0xdb, 0xe1, 0xd8, 0x8a, 0x84, 0xc0, 0x1a, 0x14, 0x5a,
+	0x8a, 0x28, 0xf1, 0x3a, 0xb9, 0xd3, 0xa9, 0x16, 0x9b, 0x3f, 0x20, 0x98, 0x4c, 0x05, 0x4b, 0xa7,
+	0x0a, 0x00, 0x09, 0x19, 0x5b, 0x24, 0x18, 0x2d, 0x37, 0xad, 0xe0, 0x5b, 0x30, 0xe2, 0x3b, 0x9e,
+	0x47, 0x6d, 0x41, 0x7d, 0xb4, 0x2c, 0x3f, 0xe1, 0xed, 0x26, 0x9c, 0xaa, 0xa6, 0xd9, 0x14, 0x8f,
+	0xdb, 0x77, 0x97, 0x2e, 0x37, 0x25, 0x30, 0xcf, 0xe0, 0x95, 0x8e, 0xb0, 0x5e, 0x2f, 0x6c, 0x46,
+	0x25, 0x45, 0x29, 0xaa, 0xf2, 0xfc, 0x2a, 0x2c, 0xb0, 0x69, 0x20, 0x0d, 0x1e, 0x53, 0xab, 0x0f,
+	0xa3, 0x45, 0x73, 0x19, 0x5e, 0x17, 0x46, 0x6d, 0x3b, 0x5e, 0xb8, 0xee, 0xba, 0xec, 0x31, 0xf1,
+	0xaa, 0xb4, 0xbb, 0xc1, 0xdf, 0x22, 0x30, 0x74, 0x38, 0xe9, 0xed, 0x3a, 0xe4, 0x89, 0x5a, 0x94,
+	0x0f, 0xdb, 0x69, 0xbd, 0x45, 0xad, 0xf8, 0x06, 0x0a, 0xbf, 0x07, 0x23, 0x75, 0x4e, 0x22, 0x8b,
+	0x73, 0xc2, 0xe2, 0xc9, 0x74, 0xfc, 0xa7, 0x9c, 0x24, 0xd6, 0x4a, 0x90, 0xf9, 0x04, 0xc9, 0xf2,
+	0xf9, 0x44, 0xf6, 0x1e, 0x5b, 0x55, 0x73, 0xf7, 0xf2, 0x19, 0xd8, 0x83, 0xf0, 0xa9, 0x2a, 0x43,
+	0x1d, 0x09, 0x69, 0xd5, 0x47, 0x90, 0x57, 0x27, 0xa2, 0x6e, 0x6c, 0x4a, 0x35, 0x75, 0x24, 0x51,
+	0x8f, 0xa4, 0x04, 0x3f, 0xb8, 0xd6, 0xe2, 0x82, 0x29, 0x88, 0xef, 0xca, 0xa6, 0x4c, 0xed, 0x2d,
+	0x76, 0xbc, 0x13, 0x30, 0x9f, 0x71, 0xe2, 0x0e, 0xbc, 0xa9, 0xfe, 0x86, 0x60, 0x3a, 0x73, 0x3b,
+	0xe9, 0xd5, 0x43, 0xc8, 0xfb, 0x6a, 0x51, 0x7a, 0x75, 0x57, 0xef, 0x95, 0x36, 0x51, 0xf2, 0x08,
+	0x57, 0x39, 0x06, 0xe7, 0xd7, 0x12, 0xbc, 0x95, 0x2e, 0x40, 0xd9, 0x75, 0x0d, 0x72, 0x4e, 0xfc,
+	0xa4, 0xb9, 0x52, 0xce, 0x39, 0xb6, 0xc9, 0xb3, 0x4c, 0x4e, 0x44, 0x6f, 0xc3, 0xa8, 0x22, 0x2c,
+	0x2d, 0xbe, 0x84, 0xe6, 0x24, 0x85, 0x79, 0x06, 0x6f, 0x88, 0x4d, 0x3f, 0x54, 0x93, 0xd4, 0x56,
+	0x34, 0x48, 0x25, 0x47, 0x7a, 0x0b, 0x46, 0x78, 0x48, 0x0e, 0x69, 0x20, 0xef, 0x84, 0xfc, 0x34,
+	0xb0, 0x2b, 0xf1, 0x23, 0x82, 0x09, 0xfd, 0xfe, 0x52, 0xee, 0x06, 0x8c, 0x88, 0xd1, 0x4e, 0x1d,
+	0xf0, 0x6d, 0xbd, 0xd8, 0x56, 0xb8, 0xba, 0xfc, 0x31, 0x72, 0x60, 0xc7, 0x5a, 0xfa, 0x6e, 0x0c,
+	0x5e, 0x12, 0x6c, 0xf1, 0x53, 0x04, 0xaf, 0x6a, 0x46, 0x3e, 0xbc, 0xac, 0xa7, 0xd7, 0x65, 0x1c,
+	0x35, 0x56, 0xfa, 0x85, 0xc5, 0xe4, 0xcc, 0xc5, 0x27, 0x7f, 0xbe, 0xf8, 0x3a, 0x77, 0x17, 0xcf,
+	0x5b, 0xfb, 0xf5, 0xe0, 0x84, 0xb4, 0x4d, 0xc7, 0xc9, 0xe8, 0x64, 0x35, 0xf5, 0xb1, 0xbf, 0x10,
+	0x8c, 0xa7, 0x0d, 0x62, 0x78, 0x2d, 0x83, 0x47, 0x97, 0xa9, 0xd2, 0x78, 0x70, 0x29, 0xac, 0x14,
+	0xb2, 0x2e, 0x84, 0x3c, 0xc0, 0xf7, 0x7b, 0x15, 0x62, 0x9d, 0xb6, 0x76, 0xc4, 0x33, 0xfc, 0x13,
+	0x82, 0x1b, 0xed, 0x73, 0x10, 0x2e, 0x65, 0x91, 0xd2, 0x0f, 0x55, 0xc6, 0x52, 0x5f, 0x18, 0x29,
+	0xc0, 0x12, 0x02, 0xe6, 0xf1, 0xac, 0x5e, 0x80, 0x84, 0x71, 0x4b, 0xcd, 0x33, 0xf8, 0x57, 0x04,
+	0xb8, 0x73, 0x1c, 0xc1, 0xf7, 0x7a, 0x70, 0xb1, 0x63, 0xf4, 0x31, 0x96, 0xfb, 0x44, 0x49, 0xd2,
+	0x6b, 0x82, 0xf4, 0x3d, 0x5c, 0xca, 0x74, 0xdd, 0x3a, 0x95, 0x8d, 0xf0, 0xac, 0x61, 0x3f, 0xc7,
+	0x3f, 0x23, 0x18, 0x6b, 0xe9, 0xd6, 0xd8, 0xca, 0x20, 0xa1, 0x9b, 0x27, 0x8c, 0x85, 0xde, 0x01,
+	0x92, 0xf0, 0xfb, 0x82, 0xf0, 0x2a, 0x5e, 0xe9, 0x95, 0x70, 0xf4, 0x5b, 0xb4, 0xd2, 0x98, 0x22,
+	0x7e, 0x41, 0x80, 0x3b, 0x9b, 0x6f, 0xa6, 0xe9, 0xa9, 0x03, 0x83, 0xb1, 0xdc, 0x27, 0x4a, 0x6a,
+	0x58, 0x10, 0x1a, 0xee, 0xe0, 0xb9, 0x2e, 0x95, 0xa2, 0x7e, 0x25, 0xdb, 0xf8, 0x77, 0x04, 0xb7,
+	0xf4, 0xad, 0x10, 0xaf, 0x66, 0x70, 0xc8, 0x6c, 0xd6, 0xc6, 0xfd, 0x4b, 0x20, 0x7b, 0x7a, 0xea,
+	0xd4, 0xd8, 0x71, 0x25, 0x69, 0xa9, 0xd1, 0x1b, 0x84, 0x3a, 0xb5, 0xf1, 0x1f, 0x08, 0x5e, 0xd3,
+	0x66, 0xc5, 0xef, 0xf6, 0xcb, 0x43, 0x09, 0x58, 0xed, 0x1f, 0x28, 0xf9, 0xaf, 0x08, 0xfe, 0x0b,
+	0xb8, 0xd8, 0x33, 0x7f, 0xeb, 0xd4, 0xb1, 0xcf, 0xf0, 0xf7, 0x08, 0xae, 0xb7, 0xf5, 0x29, 0xbc,
+	0x98, 0xc1, 0x42, 0xdf, 0x53, 0x8d, 0x52, 0x3f, 0x10, 0x49, 0xf9, 0x1d, 0x41, 0x79, 0x16, 0xcf,
+	0x68, 0x29, 0xb7, 0xbd, 0x06, 0xe1, 0x1b, 0xeb, 0xcf, 0xce, 0x0b, 0xe8, 0xf9, 0x79, 0x01, 0xfd,
+	0x7b, 0x5e, 0x40, 0x5f, 0x5d, 0x14, 0x86, 0x9e, 0x5f, 0x14, 0x86, 0xfe, 0xbe, 0x28, 0x0c, 0x7d,
+	0x36, 0x5b, 0x73, 0xc2, 0x83, 0xfa, 0x5e, 0xb1, 0xca, 0x8e, 0x2c, 0xe6, 0xda, 0x71, 0xb6, 0xf8,
+	0xef, 0xe7, 0x32, 0xab, 0x78, 0x57, 0xb4, 0x37, 0x22, 0xde, 0xa5, 0x2c, 0xfd, 0x37, 0x00, 0x35,
+	0x44, 0x70, 0x19, 0x9a, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// PrivilegedContracts returns all privileged contracts
+	PrivilegedContracts(ctx context.Context, in *QueryPrivilegedContractsRequest, opts ...grpc.CallOption) (*QueryPrivilegedContractsResponse, error)
+	// ContractsByPrivilegeType returns all contracts that have registered for the
+	// privilege type
+	ContractsByPrivilegeType(ctx context.Context, in *QueryContractsByPrivilegeTypeRequest, opts ...grpc.CallOption) (*QueryContractsByPrivilegeTypeResponse, error)
+	// CallbackFailures returns the consecutive failure counters of privileged
+	// contract callbacks
+	CallbackFailures(ctx context.Context, in *QueryCallbackFailuresRequest, opts ...grpc.CallOption) (*QueryCallbackFailuresResponse, error)
+	// ContractPrivileges returns the privilege details of a single contract
+	ContractPrivileges(ctx context.Context, in *QueryContractPrivilegesRequest, opts ...grpc.CallOption) (*QueryContractPrivilegesResponse, error)
+	// MintAllowance returns the mint allowance and the minted tokens of a
+	// contract
+	MintAllowance(ctx context.Context, in *QueryMintAllowanceRequest, opts ...grpc.CallOption) (*QueryMintAllowanceResponse, error)
+	// ScheduledCallbacks returns the pending scheduled callbacks in the order of
+	// execution
+	ScheduledCallbacks(ctx context.Context, in *QueryScheduledCallbacksRequest, opts ...grpc.CallOption) (*QueryScheduledCallbacksResponse, error)
+	// TimelockedGovProposals returns the queued gov proposals in the order of
+	// execution
+	TimelockedGovProposals(ctx context.Context, in *QueryTimelockedGovProposalsRequest, opts ...grpc.CallOption) (*QueryTimelockedGovProposalsResponse, error)
+	// TimelockedGovProposal returns a single queued gov proposal
+	TimelockedGovProposal(ctx context.Context, in *QueryTimelockedGovProposalRequest, opts ...grpc.CallOption) (*QueryTimelockedGovProposalResponse, error)
+	// DelegatorGrants returns the delegator grants of all stakers or of a single
+	// staker
+	DelegatorGrants(ctx context.Context, in *QueryDelegatorGrantsRequest, opts ...grpc.CallOption) (*QueryDelegatorGrantsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PrivilegedContracts(ctx context.Context, in *QueryPrivilegedContractsRequest, opts ...grpc.CallOption) (*QueryPrivilegedContractsResponse, error) {
+	out := new(QueryPrivilegedContractsResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/PrivilegedContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractsByPrivilegeType(ctx context.Context, in *QueryContractsByPrivilegeTypeRequest, opts ...grpc.CallOption) (*QueryContractsByPrivilegeTypeResponse, error) {
+	out := new(QueryContractsByPrivilegeTypeResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/ContractsByPrivilegeType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CallbackFailures(ctx context.Context, in *QueryCallbackFailuresRequest, opts ...grpc.CallOption) (*QueryCallbackFailuresResponse, error) {
+	out := new(QueryCallbackFailuresResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/CallbackFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractPrivileges(ctx context.Context, in *QueryContractPrivilegesRequest, opts ...grpc.CallOption) (*QueryContractPrivilegesResponse, error) {
+	out := new(QueryContractPrivilegesResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/ContractPrivileges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintAllowance(ctx context.Context, in *QueryMintAllowanceRequest, opts ...grpc.CallOption) (*QueryMintAllowanceResponse, error) {
+	out := new(QueryMintAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/MintAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledCallbacks(ctx context.Context, in *QueryScheduledCallbacksRequest, opts ...grpc.CallOption) (*QueryScheduledCallbacksResponse, error) {
+	out := new(QueryScheduledCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/ScheduledCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockedGovProposals(ctx context.Context, in *QueryTimelockedGovProposalsRequest, opts ...grpc.CallOption) (*QueryTimelockedGovProposalsResponse, error) {
+	out := new(QueryTimelockedGovProposalsResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/TimelockedGovProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockedGovProposal(ctx context.Context, in *QueryTimelockedGovProposalRequest, opts ...grpc.CallOption) (*QueryTimelockedGovProposalResponse, error) {
+	out := new(QueryTimelockedGovProposalResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/TimelockedGovProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorGrants(ctx context.Context, in *QueryDelegatorGrantsRequest, opts ...grpc.CallOption) (*QueryDelegatorGrantsResponse, error) {
+	out := new(QueryDelegatorGrantsResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/DelegatorGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// PrivilegedContracts returns all privileged contracts
+	PrivilegedContracts(context.Context, *QueryPrivilegedContractsRequest) (*QueryPrivilegedContractsResponse, error)
+	// ContractsByPrivilegeType returns all contracts that have registered for the
+	// privilege type
+	ContractsByPrivilegeType(context.Context, *QueryContractsByPrivilegeTypeRequest) (*QueryContractsByPrivilegeTypeResponse, error)
+	// CallbackFailures returns the consecutive failure counters of privileged
+	// contract callbacks
+	CallbackFailures(context.Context, *QueryCallbackFailuresRequest) (*QueryCallbackFailuresResponse, error)
+	// ContractPrivileges returns the privilege details of a single contract
+	ContractPrivileges(context.Context, *QueryContractPrivilegesRequest) (*QueryContractPrivilegesResponse, error)
+	// MintAllowance returns the mint allowance and the minted tokens of a
+	// contract
+	MintAllowance(context.Context, *QueryMintAllowanceRequest) (*QueryMintAllowanceResponse, error)
+	// ScheduledCallbacks returns the pending scheduled callbacks in the order of
+	// execution
+	ScheduledCallbacks(context.Context, *QueryScheduledCallbacksRequest) (*QueryScheduledCallbacksResponse, error)
+	// TimelockedGovProposals returns the queued gov proposals in the order of
+	// execution
+	TimelockedGovProposals(context.Context, *QueryTimelockedGovProposalsRequest) (*QueryTimelockedGovProposalsResponse, error)
+	// TimelockedGovProposal returns a single queued gov proposal
+	TimelockedGovProposal(context.Context, *QueryTimelockedGovProposalRequest) (*QueryTimelockedGovProposalResponse, error)
+	// DelegatorGrants returns the delegator grants of all stakers or of a single
+	// staker
+	DelegatorGrants(context.Context, *QueryDelegatorGrantsRequest) (*QueryDelegatorGrantsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) PrivilegedContracts(ctx context.Context, req *QueryPrivilegedContractsRequest) (*QueryPrivilegedContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrivilegedContracts not implemented")
+}
+
+func (*UnimplementedQueryServer) ContractsByPrivilegeType(ctx context.Context, req *QueryContractsByPrivilegeTypeRequest) (*QueryContractsByPrivilegeTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByPrivilegeType not implemented")
+}
+
+func (*UnimplementedQueryServer) CallbackFailures(ctx context.Context, req *QueryCallbackFailuresRequest) (*QueryCallbackFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallbackFailures not implemented")
+}
+
+func (*UnimplementedQueryServer) ContractPrivileges(ctx context.Context, req *QueryContractPrivilegesRequest) (*QueryContractPrivilegesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractPrivileges not implemented")
+}
+
+func (*UnimplementedQueryServer) MintAllowance(ctx context.Context, req *QueryMintAllowanceRequest) (*QueryMintAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintAllowance not implemented")
+}
+
+func (*UnimplementedQueryServer) ScheduledCallbacks(ctx context.Context, req *QueryScheduledCallbacksRequest) (*QueryScheduledCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledCallbacks not implemented")
+}
+
+func (*UnimplementedQueryServer) TimelockedGovProposals(ctx context.Context, req *QueryTimelockedGovProposalsRequest) (*QueryTimelockedGovProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockedGovProposals not implemented")
+}
+
+func (*UnimplementedQueryServer) TimelockedGovProposal(ctx context.Context, req *QueryTimelockedGovProposalRequest) (*QueryTimelockedGovProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockedGovProposal not implemented")
+}
+
+func (*UnimplementedQueryServer) DelegatorGrants(ctx context.Context, req *QueryDelegatorGrantsRequest) (*QueryDelegatorGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorGrants not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PrivilegedContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrivilegedContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrivilegedContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/PrivilegedContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrivilegedContracts(ctx, req.(*QueryPrivilegedContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractsByPrivilegeType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByPrivilegeTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByPrivilegeType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/ContractsByPrivilegeType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByPrivilegeType(ctx, req.(*QueryContractsByPrivilegeTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CallbackFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCallbackFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CallbackFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/CallbackFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CallbackFailures(ctx, req.(*QueryCallbackFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractPrivileges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractPrivilegesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractPrivileges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/ContractPrivileges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractPrivileges(ctx, req.(*QueryContractPrivilegesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/MintAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintAllowance(ctx, req.(*QueryMintAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/ScheduledCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledCallbacks(ctx, req.(*QueryScheduledCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockedGovProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockedGovProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockedGovProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/TimelockedGovProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockedGovProposals(ctx, req.(*QueryTimelockedGovProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockedGovProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockedGovProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockedGovProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/TimelockedGovProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockedGovProposal(ctx, req.(*QueryTimelockedGovProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/DelegatorGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorGrants(ctx, req.(*QueryDelegatorGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "confio.twasm.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PrivilegedContracts",
+			Handler:    _Query_PrivilegedContracts_Handler,
+		},
+		{
+			MethodName: "ContractsByPrivilegeType",
+			Handler:    _Query_ContractsByPrivilegeType_Handler,
+		},
+		{
+			MethodName: "CallbackFailures",
+			Handler:    _Query_CallbackFailures_Handler,
+		},
+		{
+			MethodName: "ContractPrivileges",
+			Handler:    _Query_ContractPrivileges_Handler,
+		},
+		{
+			MethodName: "MintAllowance",
+			Handler:    _Query_MintAllowance_Handler,
+		},
+		{
+			MethodName: "ScheduledCallbacks",
+			Handler:    _Query_ScheduledCallbacks_Handler,
+		},
+		{
+			MethodName: "TimelockedGovProposals",
+			Handler:    _Query_TimelockedGovProposals_Handler,
+		},
+		{
+			MethodName: "TimelockedGovProposal",
+			Handler:    _Query_TimelockedGovProposal_Handler,
+		},
+		{
+			MethodName: "DelegatorGrants",
+			Handler:    _Query_DelegatorGrants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "confio/twasm/v1beta1/query.proto",
+}
+
+func (m *QueryPrivilegedContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrivilegedContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrivilegedContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrivilegedContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrivilegedContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrivilegedContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByPrivilegeTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByPrivilegeTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByPrivilegeTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PrivilegeType) > 0 {
+		i -= len(m.PrivilegeType)
+		copy(dAtA[i:], m.PrivilegeType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrivilegeType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByPrivilegeTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByPrivilegeTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByPrivilegeTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrivilegedContractPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrivilegedContractPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivilegedContractPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbackFailuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbackFailuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbackFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbackFailuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbackFailuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbackFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PrivilegeType) > 0 {
+		i -= len(m.PrivilegeType)
+		copy(dAtA[i:], m.PrivilegeType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrivilegeType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractPrivilegesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractPrivilegesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractPrivilegesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractPrivilegesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractPrivilegesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractPrivilegesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Privileges) > 0 {
+		for iNdEx := len(m.Privileges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Privileges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pinned {
+		i--
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Privileged {
+		i--
+		if m.Privileged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractPrivilege) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractPrivilege) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractPrivilege) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CallbackOrder != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CallbackOrder))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PrivilegeType) > 0 {
+		i -= len(m.PrivilegeType)
+		copy(dAtA[i:], m.PrivilegeType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrivilegeType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockedGovProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockedGovProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockedGovProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockedGovProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockedGovProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockedGovProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockedGovProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockedGovProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockedGovProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockedGovProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockedGovProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockedGovProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryPrivilegedContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrivilegedContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByPrivilegeTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivilegeType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByPrivilegeTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrivilegedContractPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	return n
+}
+
+func (m *QueryCallbackFailuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCallbackFailuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CallbackFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PrivilegeType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovQuery(uint64(m.Failures))
+	}
+	return n
+}
+
+func (m *QueryContractPrivilegesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractPrivilegesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Privileged {
+		n += 2
+	}
+	if m.Pinned {
+		n += 2
+	}
+	if len(m.Privileges) > 0 {
+		for _, e := range m.Privileges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractPrivilege) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivilegeType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	if m.CallbackOrder != 0 {
+		n += 1 + sovQuery(uint64(m.CallbackOrder))
+	}
+	return n
+}
+
+func (m *QueryMintAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduledCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTimelockedGovProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTimelockedGovProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTimelockedGovProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryTimelockedGovProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryPrivilegedContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrivilegedContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrivilegedContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryPrivilegedContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrivilegedContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrivilegedContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryContractsByPrivilegeTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryContractsByPrivilegeTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, PrivilegedContractPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PrivilegedContractPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrivilegedContractPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrivilegedContractPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryCallbackFailuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbackFailuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbackFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryCallbackFailuresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbackFailuresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbackFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, CallbackFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CallbackFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryContractPrivilegesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractPrivilegesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractPrivilegesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryContractPrivilegesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractPrivilegesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractPrivilegesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Privileged = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Privileges = append(m.Privileges, ContractPrivilege{})
+			if err := m.Privileges[len(m.Privileges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractPrivilege) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractPrivilege: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractPrivilege: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackOrder", wireType)
+			}
+			m.CallbackOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackOrder |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryMintAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryMintAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &MintAllowance{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, MintUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryScheduledCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryScheduledCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, ScheduledCallback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTimelockedGovProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimelockedGovProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimelockedGovProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryContractsByPrivilegeTypeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PrivilegeType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
 
-func (m *QueryContractsByPrivilegeTypeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Contracts) > 0 {
-		for _, s := range m.Contracts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTimelockedGovProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimelockedGovProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimelockedGovProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, TimelockedGovProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 
-func (m *QueryPrivilegedContractsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTimelockedGovProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -605,12 +4850,31 @@ func (m *QueryPrivilegedContractsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPrivilegedContractsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimelockedGovProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPrivilegedContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimelockedGovProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -633,7 +4897,7 @@ func (m *QueryPrivilegedContractsRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryPrivilegedContractsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTimelockedGovProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -656,17 +4920,17 @@ func (m *QueryPrivilegedContractsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPrivilegedContractsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimelockedGovProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPrivilegedContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimelockedGovProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -676,23 +4940,24 @@ func (m *QueryPrivilegedContractsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -716,7 +4981,7 @@ func (m *QueryPrivilegedContractsResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryContractsByPrivilegeTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorGrantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -739,15 +5004,15 @@ func (m *QueryContractsByPrivilegeTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorGrantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -775,7 +5040,43 @@ func (m *QueryContractsByPrivilegeTypeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -799,7 +5100,7 @@ func (m *QueryContractsByPrivilegeTypeRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryContractsByPrivilegeTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -822,17 +5123,17 @@ func (m *QueryContractsByPrivilegeTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -842,23 +5143,61 @@ func (m *QueryContractsByPrivilegeTypeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			m.Grants = append(m.Grants, DelegatorGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
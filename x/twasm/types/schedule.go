@@ -0,0 +1,42 @@
+package types
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxScheduledCallbackIDLength is the max length of a scheduled callback ID
+const MaxScheduledCallbackIDLength = 64
+
+// ValidateBasic validates the scheduled callback
+func (c ScheduledCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(c.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if len(c.ID) == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "id")
+	}
+	if len(c.ID) > MaxScheduledCallbackIDLength {
+		return sdkerrors.Wrapf(wasmtypes.ErrLimit, "id must not be longer than %d", MaxScheduledCallbackIDLength)
+	}
+	switch {
+	case c.Height < 0:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "height must not be negative")
+	case c.Height == 0 && c.Time == nil:
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "height or time")
+	case c.Height != 0 && c.Time != nil:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "height and time must not be set both")
+	case c.Time != nil && c.Time.UnixNano() <= 0:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "time must be after unix epoch")
+	}
+	return nil
+}
+
+// IsDue returns true when the callback height or time is reached by the block
+func (c ScheduledCallback) IsDue(ctx sdk.Context) bool {
+	if c.Time != nil {
+		return !c.Time.After(ctx.BlockTime())
+	}
+	return c.Height <= ctx.BlockHeight()
+}
@@ -3,12 +3,15 @@ package types
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/rand"
 )
 
@@ -38,6 +41,42 @@ func DemoteProposalFixture(mutators ...func(proposal *DemotePrivilegedContractPr
 	return p
 }
 
+func SetPrivilegeCallbackOrderProposalFixture(mutators ...func(proposal *SetPrivilegeCallbackOrderProposal)) *SetPrivilegeCallbackOrderProposal {
+	const (
+		anyAddress   = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+		otherAddress = "cosmos1qgpqyqszqgpqyqszqgpqyqszqgpqyqszrh8mx2"
+	)
+	p := &SetPrivilegeCallbackOrderProposal{
+		Title:         "Foo",
+		Description:   "Bar",
+		PrivilegeType: "begin_blocker",
+		Contracts:     []string{anyAddress, otherAddress},
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func SetMintAllowanceProposalFixture(mutators ...func(proposal *SetMintAllowanceProposal)) *SetMintAllowanceProposal {
+	const anyAddress = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+	p := &SetMintAllowanceProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    anyAddress,
+		Limits: []MintLimit{{
+			Denom:      "ufury",
+			TotalCap:   sdk.NewInt(1_000_000),
+			RateLimit:  sdk.NewInt(1_000),
+			RatePeriod: 100,
+		}},
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
 // DeterministicGenesisStateFixture is the same as GenesisStateFixture but with deterministic addresses and codes
 func DeterministicGenesisStateFixture(t *testing.T, mutators ...func(*GenesisState)) GenesisState {
 	genesisState := GenesisStateFixture(t)
@@ -89,6 +128,7 @@ func GenesisStateFixture(t *testing.T, mutators ...func(*GenesisState)) GenesisS
 		Sequences:                   wasmState.Sequences,
 		GenMsgs:                     wasmState.GenMsgs,
 		PrivilegedContractAddresses: []string{anyContractAddr},
+		TWasmParams:                 DefaultTWasmParams(),
 	}
 	for _, m := range mutators {
 		m(&genesisState)
@@ -125,6 +165,19 @@ func PetriContractDetailsFixture(t *testing.T, mutators ...func(d *PetriContract
 	return d
 }
 
+// TimelockedGovProposalFixture test data factory with a text proposal as content
+func TimelockedGovProposalFixture(t *testing.T, mutators ...func(p *TimelockedGovProposal)) TimelockedGovProposal {
+	t.Helper()
+	sender := sdk.AccAddress(bytes.Repeat([]byte{1}, address.Len))
+	content := &govtypes.TextProposal{Title: "foo", Description: "bar"}
+	p, err := NewTimelockedGovProposal(1, sender, content, 1, time.Unix(1, 0).UTC(), 0)
+	require.NoError(t, err)
+	for _, m := range mutators {
+		m(&p)
+	}
+	return p
+}
+
 func RandomAddress(_ *testing.T) sdk.AccAddress {
 	return rand.Bytes(address.Len)
 }
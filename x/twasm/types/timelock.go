@@ -0,0 +1,117 @@
+package types
+
+import (
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// NewTimelockedGovProposal constructor
+func NewTimelockedGovProposal(id uint64, sender sdk.AccAddress, content govtypes.Content, submitHeight int64, executeTime time.Time, executeHeight int64) (TimelockedGovProposal, error) {
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return TimelockedGovProposal{}, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", content)
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return TimelockedGovProposal{}, err
+	}
+	return TimelockedGovProposal{
+		ID:            id,
+		Sender:        sender.String(),
+		Content:       any,
+		SubmitHeight:  submitHeight,
+		ExecuteTime:   executeTime,
+		ExecuteHeight: executeHeight,
+	}, nil
+}
+
+// NewTimelockedConsensusParamsUpdate constructor for a queued consensus params update. The update is the json
+// encoded consensus_params contract message.
+func NewTimelockedConsensusParamsUpdate(id uint64, sender sdk.AccAddress, update []byte, submitHeight int64, executeTime time.Time, executeHeight int64) TimelockedGovProposal {
+	return TimelockedGovProposal{
+		ID:              id,
+		Sender:          sender.String(),
+		ConsensusParams: update,
+		SubmitHeight:    submitHeight,
+		ExecuteTime:     executeTime,
+		ExecuteHeight:   executeHeight,
+	}
+}
+
+// ProposalType returns the gov proposal type of the content or TimelockTypeConsensusParams for consensus params
+// updates. Returns an empty string when the content is not unpacked.
+func (p TimelockedGovProposal) ProposalType() string {
+	if len(p.ConsensusParams) != 0 {
+		return TimelockTypeConsensusParams
+	}
+	content := p.ProposalContent()
+	if content == nil {
+		return ""
+	}
+	return content.ProposalType()
+}
+
+// IsDue returns true when the execute time and height are reached
+func (p TimelockedGovProposal) IsDue(blockTime time.Time, height int64) bool {
+	return !blockTime.Before(p.ExecuteTime) && height >= p.ExecuteHeight
+}
+
+// ProposalContent returns the unpacked gov proposal content. Returns nil when not unpacked.
+func (p TimelockedGovProposal) ProposalContent() govtypes.Content {
+	if p.Content == nil {
+		return nil
+	}
+	content, ok := p.Content.GetCachedValue().(govtypes.Content)
+	if !ok {
+		return nil
+	}
+	return content
+}
+
+// ValidateBasic validates the queued proposal
+func (p TimelockedGovProposal) ValidateBasic() error {
+	if p.ID == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "id")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	switch {
+	case len(p.ConsensusParams) != 0:
+		if p.Content != nil {
+			return sdkerrors.Wrap(wasmtypes.ErrInvalid, "content must be empty for consensus params")
+		}
+	default:
+		content := p.ProposalContent()
+		if content == nil {
+			return sdkerrors.Wrap(wasmtypes.ErrEmpty, "content")
+		}
+		if err := content.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "content")
+		}
+	}
+	if p.ExecuteHeight < 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "execute height must not be negative")
+	}
+	if p.ExecuteTime.UnixNano() <= 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "execute time must be after unix epoch")
+	}
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = TimelockedGovProposal{}
+
+// UnpackInterfaces implements codectypes.UnpackInterfaces
+func (p TimelockedGovProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if p.Content == nil {
+		return nil
+	}
+	var content govtypes.Content
+	return unpacker.UnpackAny(p.Content, &content)
+}
@@ -0,0 +1,633 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: confio/twasm/v1beta1/timelock.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TimelockedGovProposal is a gov proposal or consensus params update
+// submitted by a privileged contract that is executed in the end blocker when
+// the timelock has passed. It can be vetoed by the veto contract until then.
+type TimelockedGovProposal struct {
+	// ID is the unique identifier of the queued proposal
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Sender is the address of the contract that submitted the proposal
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Content is the gov proposal content to execute. Empty for consensus params
+	// updates.
+	Content *types.Any `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// SubmitHeight is the block height when the proposal was queued
+	SubmitHeight int64 `protobuf:"varint,4,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// ExecuteTime is the earliest block time to execute the proposal at
+	ExecuteTime time.Time `protobuf:"bytes,5,opt,name=execute_time,json=executeTime,proto3,stdtime" json:"execute_time"`
+	// ConsensusParams is the json encoded consensus params update to apply.
+	// Empty for gov proposals.
+	ConsensusParams []byte `protobuf:"bytes,6,opt,name=consensus_params,json=consensusParams,proto3" json:"consensus_params,omitempty"`
+	// ExecuteHeight is the earliest block height to execute the proposal at
+	ExecuteHeight int64 `protobuf:"varint,7,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+}
+
+func (m *TimelockedGovProposal) Reset()         { *m = TimelockedGovProposal{} }
+func (m *TimelockedGovProposal) String() string { return proto.CompactTextString(m) }
+func (*TimelockedGovProposal) ProtoMessage()    {}
+func (*TimelockedGovProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c78e400dc6b042ce, []int{0}
+}
+
+func (m *TimelockedGovProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TimelockedGovProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockedGovProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TimelockedGovProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedGovProposal.Merge(m, src)
+}
+
+func (m *TimelockedGovProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TimelockedGovProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedGovProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockedGovProposal proto.InternalMessageInfo
+
+func (m *TimelockedGovProposal) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *TimelockedGovProposal) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *TimelockedGovProposal) GetContent() *types.Any {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *TimelockedGovProposal) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *TimelockedGovProposal) GetExecuteTime() time.Time {
+	if m != nil {
+		return m.ExecuteTime
+	}
+	return time.Time{}
+}
+
+func (m *TimelockedGovProposal) GetConsensusParams() []byte {
+	if m != nil {
+		return m.ConsensusParams
+	}
+	return nil
+}
+
+func (m *TimelockedGovProposal) GetExecuteHeight() int64 {
+	if m != nil {
+		return m.ExecuteHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TimelockedGovProposal)(nil), "confio.twasm.v1beta1.TimelockedGovProposal")
+}
+
+func init() {
+	proto.RegisterFile("confio/twasm/v1beta1/timelock.proto", fileDescriptor_c78e400dc6b042ce)
+}
+
+var fileDescriptor_c78e400dc6b042ce = []byte{
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xeb, 0x6c, 0x74, 0xe0, 0x75, 0x80, 0xac, 0x32, 0x85, 0x1e, 0xd2, 0x88, 0x09, 0x11,
+	0x0e, 0xd8, 0x1a, 0x3c, 0xc1, 0x0a, 0xd2, 0xe0, 0x82, 0xa6, 0x68, 0x27, 0x2e, 0x95, 0x93, 0xb8,
+	0x69, 0x44, 0xe3, 0x7f, 0x14, 0x3b, 0x65, 0x79, 0x8b, 0x3d, 0x0c, 0x0f, 0x31, 0x71, 0xda, 0x0d,
+	0x4e, 0x03, 0xa5, 0x2f, 0x82, 0x62, 0x3b, 0x1c, 0xd8, 0x25, 0xca, 0xff, 0xfb, 0xbe, 0xbf, 0xbf,
+	0x9f, 0xa3, 0xe0, 0x93, 0x14, 0xe4, 0xaa, 0x00, 0xa6, 0xbf, 0x71, 0x55, 0xb2, 0xed, 0x69, 0x22,
+	0x34, 0x3f, 0x65, 0xba, 0x28, 0xc5, 0x06, 0xd2, 0xaf, 0xb4, 0xaa, 0x41, 0x03, 0x99, 0xda, 0x10,
+	0x35, 0x21, 0xea, 0x42, 0xb3, 0x69, 0x0e, 0x39, 0x98, 0x00, 0xeb, 0xdf, 0x6c, 0x76, 0xf6, 0x3c,
+	0x07, 0xc8, 0x37, 0x82, 0x99, 0x29, 0x69, 0x56, 0x8c, 0xcb, 0xd6, 0x59, 0xf3, 0xff, 0xad, 0xbe,
+	0x46, 0x69, 0x5e, 0x56, 0xc3, 0x6e, 0x0a, 0xaa, 0x04, 0xb5, 0xb4, 0x87, 0xda, 0xc1, 0x5a, 0x2f,
+	0x7e, 0x7a, 0xf8, 0xd9, 0xa5, 0xa3, 0x12, 0xd9, 0x39, 0x6c, 0x2f, 0x6a, 0xa8, 0x40, 0xf1, 0x0d,
+	0x39, 0xc6, 0x5e, 0x91, 0xf9, 0x28, 0x44, 0xd1, 0xfe, 0x62, 0xdc, 0xdd, 0xcd, 0xbd, 0x4f, 0x1f,
+	0x62, 0xaf, 0xc8, 0xc8, 0x31, 0x1e, 0x2b, 0x21, 0x33, 0x51, 0xfb, 0x5e, 0x88, 0xa2, 0x47, 0xb1,
+	0x9b, 0xc8, 0x67, 0x7c, 0x90, 0x82, 0xd4, 0x42, 0x6a, 0x7f, 0x2f, 0x44, 0xd1, 0xe1, 0xdb, 0x29,
+	0xb5, 0x5c, 0x74, 0xe0, 0xa2, 0x67, 0xb2, 0x5d, 0x04, 0x3f, 0xbe, 0xbf, 0x99, 0x39, 0x84, 0x1c,
+	0xb6, 0xc3, 0xad, 0xe9, 0x7b, 0xbb, 0x1b, 0x0f, 0x87, 0x90, 0x13, 0x7c, 0xa4, 0x9a, 0xa4, 0x2c,
+	0xf4, 0x72, 0x2d, 0x8a, 0x7c, 0xad, 0xfd, 0xfd, 0x10, 0x45, 0x7b, 0xf1, 0xc4, 0x8a, 0x1f, 0x8d,
+	0x46, 0xce, 0xf1, 0x44, 0x5c, 0x89, 0xb4, 0xd1, 0x62, 0xd9, 0x5f, 0xda, 0x7f, 0x60, 0x9a, 0x67,
+	0xf7, 0x9a, 0x2f, 0x87, 0x2f, 0xb2, 0x78, 0x78, 0x73, 0x37, 0x1f, 0x5d, 0xff, 0x9e, 0xa3, 0xf8,
+	0xd0, 0x6d, 0xf6, 0x1e, 0x79, 0x8d, 0x9f, 0xa6, 0x20, 0x95, 0x90, 0xaa, 0x51, 0xcb, 0x8a, 0xd7,
+	0xbc, 0x54, 0xfe, 0x38, 0x44, 0xd1, 0x24, 0x7e, 0xf2, 0x4f, 0xbf, 0x30, 0x32, 0x79, 0x89, 0x1f,
+	0x0f, 0x9d, 0x8e, 0xec, 0xc0, 0x90, 0x1d, 0x39, 0xd5, 0xa2, 0x2d, 0xce, 0x6e, 0xba, 0x00, 0xdd,
+	0x76, 0x01, 0xfa, 0xd3, 0x05, 0xe8, 0x7a, 0x17, 0x8c, 0x6e, 0x77, 0xc1, 0xe8, 0xd7, 0x2e, 0x18,
+	0x7d, 0x79, 0x95, 0x17, 0x7a, 0xdd, 0x24, 0x34, 0x85, 0x92, 0xc1, 0x26, 0x5b, 0x35, 0x75, 0xcb,
+	0x99, 0x7d, 0x5e, 0xb9, 0x1f, 0x46, 0xb7, 0x95, 0x50, 0xc9, 0xd8, 0xf0, 0xbf, 0xfb, 0x3b, 0x00,
+	0xec, 0x9d, 0xe6, 0xd6, 0x4d, 0x02, 0x00, 0x00,
+}
+
+func (m *TimelockedGovProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockedGovProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockedGovProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ConsensusParams) > 0 {
+		i -= len(m.ConsensusParams)
+		copy(dAtA[i:], m.ConsensusParams)
+		i = encodeVarintTimelock(dAtA, i, uint64(len(m.ConsensusParams)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExecuteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecuteTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTimelock(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.SubmitHeight != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Content != nil {
+		{
+			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTimelock(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTimelock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTimelock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *TimelockedGovProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTimelock(uint64(m.ID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovTimelock(uint64(m.SubmitHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecuteTime)
+	n += 1 + l + sovTimelock(uint64(l))
+	l = len(m.ConsensusParams)
+	if l > 0 {
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovTimelock(uint64(m.ExecuteHeight))
+	}
+	return n
+}
+
+func sovTimelock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTimelock(x uint64) (n int) {
+	return sovTimelock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *TimelockedGovProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockedGovProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockedGovProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExecuteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParams", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusParams = append(m.ConsensusParams[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsensusParams == nil {
+				m.ConsensusParams = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
+			}
+			m.ExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimelock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipTimelock(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTimelock
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTimelock
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTimelock
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTimelock        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTimelock          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTimelock = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1065 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: confio/twasm/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgGrantDelegator creates or updates a delegator grant for a contract
+type MsgGrantDelegator struct {
+	// Staker is the address of the account that owns the tokens
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// ContractAddress is the address of the contract to authorize
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Expiration is the block time when the grant ends. Empty for no expiration.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *MsgGrantDelegator) Reset()         { *m = MsgGrantDelegator{} }
+func (m *MsgGrantDelegator) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantDelegator) ProtoMessage()    {}
+func (*MsgGrantDelegator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb55fecec07edfa, []int{0}
+}
+
+func (m *MsgGrantDelegator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgGrantDelegator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantDelegator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgGrantDelegator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantDelegator.Merge(m, src)
+}
+
+func (m *MsgGrantDelegator) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgGrantDelegator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantDelegator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantDelegator proto.InternalMessageInfo
+
+func (m *MsgGrantDelegator) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *MsgGrantDelegator) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgGrantDelegator) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// MsgGrantDelegatorResponse defines the MsgGrantDelegator response type.
+type MsgGrantDelegatorResponse struct{}
+
+func (m *MsgGrantDelegatorResponse) Reset()         { *m = MsgGrantDelegatorResponse{} }
+func (m *MsgGrantDelegatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantDelegatorResponse) ProtoMessage()    {}
+func (*MsgGrantDelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb55fecec07edfa, []int{1}
+}
+
+func (m *MsgGrantDelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgGrantDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantDelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgGrantDelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantDelegatorResponse.Merge(m, src)
+}
+
+func (m *MsgGrantDelegatorResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgGrantDelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantDelegatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantDelegatorResponse proto.InternalMessageInfo
+
+// MsgRevokeDelegator removes a delegator grant of a contract
+type MsgRevokeDelegator struct {
+	// Staker is the address of the account that owns the tokens
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// ContractAddress is the address of the authorized contract
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgRevokeDelegator) Reset()         { *m = MsgRevokeDelegator{} }
+func (m *MsgRevokeDelegator) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeDelegator) ProtoMessage()    {}
+func (*MsgRevokeDelegator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb55fecec07edfa, []int{2}
+}
+
+func (m *MsgRevokeDelegator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgRevokeDelegator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeDelegator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgRevokeDelegator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeDelegator.Merge(m, src)
+}
+
+func (m *MsgRevokeDelegator) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgRevokeDelegator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeDelegator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeDelegator proto.InternalMessageInfo
+
+func (m *MsgRevokeDelegator) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *MsgRevokeDelegator) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgRevokeDelegatorResponse defines the MsgRevokeDelegator response type.
+type MsgRevokeDelegatorResponse struct{}
+
+func (m *MsgRevokeDelegatorResponse) Reset()         { *m = MsgRevokeDelegatorResponse{} }
+func (m *MsgRevokeDelegatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeDelegatorResponse) ProtoMessage()    {}
+func (*MsgRevokeDelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb55fecec07edfa, []int{3}
+}
+
+func (m *MsgRevokeDelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgRevokeDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeDelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgRevokeDelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeDelegatorResponse.Merge(m, src)
+}
+
+func (m *MsgRevokeDelegatorResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgRevokeDelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeDelegatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeDelegatorResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgGrantDelegator)(nil), "confio.twasm.v1beta1.MsgGrantDelegator")
+	proto.RegisterType((*MsgGrantDelegatorResponse)(nil), "confio.twasm.v1beta1.MsgGrantDelegatorResponse")
+	proto.RegisterType((*MsgRevokeDelegator)(nil), "confio.twasm.v1beta1.MsgRevokeDelegator")
+	proto.RegisterType((*MsgRevokeDelegatorResponse)(nil), "confio.twasm.v1beta1.MsgRevokeDelegatorResponse")
+}
+
+func init() { proto.RegisterFile("confio/twasm/v1beta1/tx.proto", fileDescriptor_6bb55fecec07edfa) }
+
+var fileDescriptor_6bb55fecec07edfa = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x41, 0x4f, 0xea, 0x40,
+	0x10, 0xc7, 0xd9, 0xc7, 0x0b, 0xc9, 0xdb, 0x97, 0x3c, 0x9e, 0x0d, 0x31, 0x58, 0xb5, 0x90, 0x5e,
+	0xc0, 0xcb, 0xae, 0xe0, 0x17, 0x10, 0x62, 0xe2, 0x89, 0x4b, 0x63, 0x62, 0xe2, 0xc5, 0x6c, 0xcb,
+	0xb2, 0x56, 0x68, 0xa7, 0xd9, 0x1d, 0x10, 0xbe, 0x05, 0x47, 0x3f, 0x92, 0x47, 0x8e, 0xde, 0x24,
+	0xf0, 0x45, 0x8c, 0x2d, 0x4d, 0x0c, 0x68, 0xc2, 0xc1, 0x4b, 0xd3, 0x99, 0xf9, 0xed, 0xfc, 0xff,
+	0xb3, 0xb3, 0xf4, 0x34, 0x80, 0x78, 0x10, 0x02, 0xc7, 0x27, 0x61, 0x22, 0x3e, 0x69, 0xf9, 0x12,
+	0x45, 0x8b, 0xe3, 0x94, 0x25, 0x1a, 0x10, 0xac, 0x4a, 0x56, 0x66, 0x69, 0x99, 0x6d, 0xca, 0x76,
+	0x45, 0x81, 0x82, 0x14, 0xe0, 0x1f, 0x7f, 0x19, 0x6b, 0xd7, 0x14, 0x80, 0x1a, 0x49, 0x9e, 0x46,
+	0xfe, 0x78, 0xc0, 0x31, 0x8c, 0xa4, 0x41, 0x11, 0x25, 0x19, 0xe0, 0x3e, 0x13, 0x7a, 0xd0, 0x33,
+	0xea, 0x5a, 0x8b, 0x18, 0xaf, 0xe4, 0x48, 0x2a, 0x81, 0xa0, 0xad, 0x43, 0x5a, 0x32, 0x28, 0x86,
+	0x52, 0x57, 0x49, 0x9d, 0x34, 0xff, 0x78, 0x9b, 0xc8, 0x3a, 0xa3, 0xff, 0x03, 0x88, 0x51, 0x8b,
+	0x00, 0xef, 0x45, 0xbf, 0xaf, 0xa5, 0x31, 0xd5, 0x5f, 0x29, 0x51, 0xce, 0xf3, 0x9d, 0x2c, 0x6d,
+	0x5d, 0x52, 0x2a, 0xa7, 0x49, 0xa8, 0x05, 0x86, 0x10, 0x57, 0x8b, 0x75, 0xd2, 0xfc, 0xdb, 0xb6,
+	0x59, 0x66, 0x87, 0xe5, 0x76, 0xd8, 0x4d, 0x6e, 0xa7, 0xfb, 0x7b, 0xfe, 0x56, 0x23, 0xde, 0xa7,
+	0x33, 0xee, 0x31, 0x3d, 0xda, 0x71, 0xe6, 0x49, 0x93, 0x40, 0x6c, 0xa4, 0x7b, 0x4b, 0xad, 0x9e,
+	0x51, 0x9e, 0x9c, 0xc0, 0x50, 0xfe, 0xa4, 0x6f, 0xf7, 0x84, 0xda, 0xbb, 0x8d, 0x73, 0xd9, 0xf6,
+	0x92, 0xd0, 0x62, 0xcf, 0x28, 0xeb, 0x91, 0xfe, 0xdb, 0xba, 0xb2, 0x06, 0xfb, 0x6a, 0x2d, 0x6c,
+	0x67, 0x02, 0x9b, 0xef, 0x09, 0xe6, 0x9a, 0x56, 0x44, 0xcb, 0xdb, 0x73, 0x36, 0xbf, 0xed, 0xb1,
+	0x45, 0xda, 0xe7, 0xfb, 0x92, 0xb9, 0x5c, 0xb7, 0xf3, 0xb2, 0x72, 0xc8, 0x62, 0xe5, 0x90, 0xe5,
+	0xca, 0x21, 0xf3, 0xb5, 0x53, 0x58, 0xac, 0x9d, 0xc2, 0xeb, 0xda, 0x29, 0xdc, 0x35, 0x54, 0x88,
+	0x0f, 0x63, 0x9f, 0x05, 0x10, 0x71, 0x18, 0xf5, 0x07, 0x63, 0x3d, 0x13, 0x3c, 0xfb, 0x4e, 0x37,
+	0x8f, 0x15, 0x67, 0x89, 0x34, 0x7e, 0x29, 0xdd, 0xef, 0xc5, 0xfb, 0x00, 0x4f, 0xaf, 0x60, 0x28,
+	0xc9, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// GrantDelegator authorizes a contract to delegate the staker's tokens
+	GrantDelegator(ctx context.Context, in *MsgGrantDelegator, opts ...grpc.CallOption) (*MsgGrantDelegatorResponse, error)
+	// RevokeDelegator removes the authorization of a contract to delegate the
+	// staker's tokens
+	RevokeDelegator(ctx context.Context, in *MsgRevokeDelegator, opts ...grpc.CallOption) (*MsgRevokeDelegatorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) GrantDelegator(ctx context.Context, in *MsgGrantDelegator, opts ...grpc.CallOption) (*MsgGrantDelegatorResponse, error) {
+	out := new(MsgGrantDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Msg/GrantDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeDelegator(ctx context.Context, in *MsgRevokeDelegator, opts ...grpc.CallOption) (*MsgRevokeDelegatorResponse, error) {
+	out := new(MsgRevokeDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Msg/RevokeDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// GrantDelegator authorizes a contract to delegate the staker's tokens
+	GrantDelegator(context.Context, *MsgGrantDelegator) (*MsgGrantDelegatorResponse, error)
+	// RevokeDelegator removes the authorization of a contract to delegate the
+	// staker's tokens
+	RevokeDelegator(context.Context, *MsgRevokeDelegator) (*MsgRevokeDelegatorResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct{}
+
+func (*UnimplementedMsgServer) GrantDelegator(ctx context.Context, req *MsgGrantDelegator) (*MsgGrantDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantDelegator not implemented")
+}
+
+func (*UnimplementedMsgServer) RevokeDelegator(ctx context.Context, req *MsgRevokeDelegator) (*MsgRevokeDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDelegator not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_GrantDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantDelegator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Msg/GrantDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantDelegator(ctx, req.(*MsgGrantDelegator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeDelegator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Msg/RevokeDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeDelegator(ctx, req.(*MsgRevokeDelegator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "confio.twasm.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GrantDelegator",
+			Handler:    _Msg_GrantDelegator_Handler,
+		},
+		{
+			MethodName: "RevokeDelegator",
+			Handler:    _Msg_RevokeDelegator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "confio/twasm/v1beta1/tx.proto",
+}
+
+func (m *MsgGrantDelegator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantDelegator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantDelegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeDelegator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeDelegator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeDelegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *MsgGrantDelegator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeDelegator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *MsgGrantDelegator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantDelegator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantDelegator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgGrantDelegatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantDelegatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgRevokeDelegator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeDelegator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeDelegator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgRevokeDelegatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeDelegatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)